@@ -53,10 +53,25 @@ var Descriptions = map[string]string{
 	"ymq_secret_key": "Yandex Cloud Message Queue service secret key, which is used when a YMQ queue resource doesn't have a secret key explicitly specified.\n" +
 		"This can also be specified using environment variable `YC_MESSAGE_QUEUE_SECRET_KEY`.",
 
-	"shared_credentials_file": "Shared credentials file path.\nSupported keys: `storage_access_key` and `storage_secret_key`.\n\n" +
+	"shared_credentials_file": "Shared credentials file path. It is either the [yc CLI](https://yandex.cloud/docs/cli/) config file (`.yaml` or `.yml`) or the INI file with keys `storage_access_key` and `storage_secret_key`.\n\n" +
 		"~> The `storage_access_key` and `storage_secret_key` attributes from the shared credentials file are used only when the provider and a storage data/resource do not have an access/secret keys explicitly specified.\n",
 
-	"profile": "Profile name to use in the shared credentials file. Default value is `default`.",
+	"profile": "Profile name to use in the shared credentials file. For the yc CLI config the current profile of yc CLI is used by default, for the INI file default value is `default`.\n" +
+		"If `shared_credentials_file` is not specified, the profile is read from the default yc CLI config `~/.config/yandex-cloud/config.yaml`.",
 
 	"organization_id": "The ID of the [Cloud Organization](https://yandex.cloud/docs/organization/quickstart) to operate under.",
+
+	"impersonate_service_account_id": "The ID of the service account to impersonate. The provider issues short-lived IAM tokens of the service account with its credentials and uses them for all requests, including Object Storage and Message Queue. " +
+		"The credentials must have the `iam.serviceAccounts.tokenCreator` role on the service account.\n" +
+		"This can also be specified using environment variable `YC_IMPERSONATE_SERVICE_ACCOUNT_ID`.",
+
+	"workload_identity": "Authenticate with [workload identity federation](https://yandex.cloud/docs/iam/concepts/workload-identity) by exchanging the JWT of a CI job, e.g. a GitHub Actions or GitLab CI/CD job, for an IAM token of the service account. " +
+		"The service account must have a federated credential for the JWT issuer and subject, see `yandex_iam_workload_identity_federated_credential`.\n\n" +
+		"~> Only one of `token`, `service_account_key_file` or `workload_identity` must be specified.",
+
+	"workload_identity_service_account_id": "The ID of the service account to get IAM token for.",
+
+	"workload_identity_jwt_file": "Path to the file with the JWT of the CI job. The file is read on every token exchange, so it can be updated during the job. Only one of `jwt_file` or `jwt_env` must be specified.",
+
+	"workload_identity_jwt_env": "Name of the environment variable with the JWT of the CI job, e.g. the variable declared in `id_tokens` of GitLab CI/CD job. Only one of `jwt_file` or `jwt_env` must be specified.",
 }
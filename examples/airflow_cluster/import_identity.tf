@@ -0,0 +1,6 @@
+import {
+  to = yandex_airflow_cluster.my_airflow_cluster
+  identity = {
+    id = "enphq**********cjsw4"
+  }
+}
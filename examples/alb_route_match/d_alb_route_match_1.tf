@@ -0,0 +1,55 @@
+//
+// Check which route of a virtual host serves a request
+//
+locals {
+  vhost_routes = [
+    {
+      name   = "api-users"
+      prefix = "/api/users"
+    },
+    {
+      name   = "api"
+      prefix = "/api/"
+    },
+  ]
+}
+
+data "yandex_alb_route_match" "api_users" {
+  authority = ["example.com"]
+
+  dynamic "route" {
+    for_each = local.vhost_routes
+    content {
+      name = route.value.name
+      http_route {
+        http_match {
+          path {
+            prefix = route.value.prefix
+          }
+        }
+        http_route_action {
+          backend_group_id = yandex_alb_backend_group.my-bg.id
+          prefix_rewrite   = "/"
+        }
+      }
+    }
+  }
+
+  request {
+    authority = "example.com"
+    method    = "GET"
+    path      = "/api/users/42?details=true"
+  }
+}
+
+check "api_users_route" {
+  assert {
+    condition     = data.yandex_alb_route_match.api_users.route_name == "api-users"
+    error_message = "Requests to /api/users are served by the ${data.yandex_alb_route_match.api_users.route_name} route."
+  }
+
+  assert {
+    condition     = length(data.yandex_alb_route_match.api_users.shadowed_routes) == 0
+    error_message = "Some routes of the virtual host are shadowed by earlier routes."
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = yandex_alb_virtual_host.my_vhost
+  identity = {
+    http_router_id = "ds7ph**********hm4in"
+    name           = "route-7565bde...6ddd6-1"
+  }
+}
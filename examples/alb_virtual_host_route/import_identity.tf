@@ -0,0 +1,8 @@
+import {
+  to = yandex_alb_virtual_host_route.api
+  identity = {
+    http_router_id    = "ds7ph**********hm4in"
+    virtual_host_name = "my-vhost"
+    name              = "api"
+  }
+}
@@ -0,0 +1,39 @@
+//
+// Create a new ALB Virtual Host with routes managed separately
+//
+resource "yandex_alb_virtual_host" "my-vhost" {
+  name                   = "my-vhost"
+  http_router_id         = yandex_alb_http_router.my-router.id
+  ignore_external_routes = true
+
+  route {
+    name = "default"
+    http_route {
+      http_route_action {
+        backend_group_id = yandex_alb_backend_group.default-bg.id
+      }
+    }
+  }
+}
+
+//
+// Add a route owned by another team before the default route
+//
+resource "yandex_alb_virtual_host_route" "api" {
+  http_router_id    = yandex_alb_virtual_host.my-vhost.http_router_id
+  virtual_host_name = yandex_alb_virtual_host.my-vhost.name
+  name              = "api"
+  before            = "default"
+
+  http_route {
+    http_match {
+      path {
+        prefix = "/api/"
+      }
+    }
+    http_route_action {
+      backend_group_id = yandex_alb_backend_group.api-bg.id
+      timeout          = "3s"
+    }
+  }
+}
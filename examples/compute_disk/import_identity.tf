@@ -0,0 +1,6 @@
+import {
+  to = yandex_compute_disk.my_disk
+  identity = {
+    id = "fhmrm**********90r5f"
+  }
+}
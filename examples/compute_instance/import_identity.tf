@@ -0,0 +1,6 @@
+import {
+  to = yandex_compute_instance.my_vm1
+  identity = {
+    id = "fhmur**********j51ah"
+  }
+}
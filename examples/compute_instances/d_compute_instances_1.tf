@@ -0,0 +1,13 @@
+//
+// Get information about running instances labeled with env = "prod".
+//
+data "yandex_compute_instances" "prod" {
+  filter = "status=\"RUNNING\""
+  labels = {
+    env = "prod"
+  }
+}
+
+output "prod_instance_ips" {
+  value = [for i in data.yandex_compute_instances.prod.instances : i.network_interface[0].ip_address]
+}
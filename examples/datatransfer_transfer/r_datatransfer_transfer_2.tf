@@ -0,0 +1,17 @@
+//
+// Create a Data Transfer which is kept active and wait for its initial snapshot.
+//
+resource "yandex_datatransfer_transfer" "pgpg_transfer" {
+  folder_id         = "some_folder_id"
+  name              = "pgpg"
+  source_id         = yandex_datatransfer_endpoint.pg_source.id
+  target_id         = yandex_datatransfer_endpoint.pg_target.id
+  type              = "SNAPSHOT_AND_INCREMENT"
+  desired_state     = "active" // Set to "paused" to deactivate the transfer.
+  wait_for_snapshot = true
+
+  timeouts {
+    create = "2h"
+    update = "2h"
+  }
+}
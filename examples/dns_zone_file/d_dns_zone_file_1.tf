@@ -0,0 +1,27 @@
+//
+// Create DNS record sets from a zone file.
+//
+resource "yandex_dns_zone" "zone1" {
+  name   = "example-zone"
+  zone   = "example.com."
+  public = true
+}
+
+data "yandex_dns_zone_file" "import" {
+  origin  = yandex_dns_zone.zone1.zone
+  content = file("${path.module}/example.com.zone")
+}
+
+resource "yandex_dns_recordset" "imported" {
+  // SOA and NS records of the zone apex are managed by Cloud DNS.
+  for_each = {
+    for rs in data.yandex_dns_zone_file.import.recordsets : "${rs.name} ${rs.type}" => rs
+    if !(rs.name == yandex_dns_zone.zone1.zone && contains(["SOA", "NS"], rs.type))
+  }
+
+  zone_id = yandex_dns_zone.zone1.id
+  name    = each.value.name
+  type    = each.value.type
+  ttl     = each.value.ttl
+  data    = each.value.data
+}
@@ -0,0 +1,10 @@
+//
+// Export the records of a DNS Zone as a zone file.
+//
+data "yandex_dns_zone_file" "export" {
+  dns_zone_id = yandex_dns_zone.zone1.id
+}
+
+output "zone_file" {
+  value = data.yandex_dns_zone_file.export.content
+}
@@ -0,0 +1,33 @@
+//
+// Manage all DNS Records of a DNS Zone.
+//
+resource "yandex_dns_zone" "zone1" {
+  name   = "my-public-zone"
+  zone   = "example.com."
+  public = true
+}
+
+resource "yandex_dns_zone_records" "records" {
+  zone_id = yandex_dns_zone.zone1.id
+
+  recordset {
+    name = "@"
+    type = "MX"
+    ttl  = 3600
+    data = ["10 mail.example.com."]
+  }
+
+  recordset {
+    name = "srv"
+    type = "A"
+    ttl  = 200
+    data = ["10.1.0.1", "10.1.0.2"]
+  }
+
+  recordset {
+    name = "www.example.com."
+    type = "CNAME"
+    ttl  = 600
+    data = ["srv.example.com."]
+  }
+}
@@ -0,0 +1,6 @@
+//
+// Convert size of the disk snapshot to gigabytes.
+//
+output "snapshot_size_gb" {
+  value = provider::yandex::bytes_to_gb(10737418240)
+}
@@ -0,0 +1,7 @@
+//
+// Set bucket max size in bytes from size in gigabytes.
+//
+resource "yandex_storage_bucket" "my_bucket" {
+  bucket   = "my-bucket"
+  max_size = provider::yandex::gb_to_bytes(10)
+}
@@ -0,0 +1,6 @@
+//
+// Get cluster ID and user name from the MongoDB user ID.
+//
+output "mongodb_cluster_id" {
+  value = provider::yandex::parse_cluster_resource_id(yandex_mdb_mongodb_user.my_user.id).cluster_id
+}
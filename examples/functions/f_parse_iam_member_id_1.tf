@@ -0,0 +1,6 @@
+//
+// Get folder ID from the folder IAM member ID.
+//
+output "folder_id" {
+  value = provider::yandex::parse_iam_member_id(yandex_resourcemanager_folder_iam_member.editor.id).resource_id
+}
@@ -0,0 +1,6 @@
+//
+// Get HTTP router ID from the ALB virtual host ID.
+//
+output "http_router_id" {
+  value = provider::yandex::parse_virtual_host_id(yandex_alb_virtual_host.my_host.id).http_router_id
+}
@@ -0,0 +1,6 @@
+//
+// Get region of the subnet availability zone.
+//
+output "region" {
+  value = provider::yandex::zone_to_region(yandex_vpc_subnet.my_subnet.zone)
+}
@@ -0,0 +1,6 @@
+//
+// Get information about service account by name.
+//
+data "yandex_iam_service_accounts" "builder" {
+  filter = "name=\"builder\""
+}
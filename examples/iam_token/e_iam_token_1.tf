@@ -0,0 +1,10 @@
+//
+// Configure the Kubernetes provider with an IAM token that is never stored in state.
+//
+ephemeral "yandex_iam_token" "current" {}
+
+provider "kubernetes" {
+  host                   = yandex_kubernetes_cluster.my_cluster.master[0].external_v4_endpoint
+  cluster_ca_certificate = yandex_kubernetes_cluster.my_cluster.master[0].cluster_ca_certificate
+  token                  = ephemeral.yandex_iam_token.current.iam_token
+}
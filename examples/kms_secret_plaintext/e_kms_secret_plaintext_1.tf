@@ -0,0 +1,8 @@
+//
+// Decrypt a ciphertext produced by yandex_kms_secret_ciphertext.
+//
+ephemeral "yandex_kms_secret_plaintext" "password" {
+  key_id      = yandex_kms_symmetric_key.example.id
+  ciphertext  = yandex_kms_secret_ciphertext.password.ciphertext
+  aad_context = "additional authenticated data"
+}
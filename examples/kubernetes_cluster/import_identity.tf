@@ -0,0 +1,6 @@
+import {
+  to = yandex_kubernetes_cluster.regional_cluster
+  identity = {
+    id = "..."
+  }
+}
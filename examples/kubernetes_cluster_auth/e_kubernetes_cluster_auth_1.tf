@@ -0,0 +1,20 @@
+//
+// Configure the Kubernetes and Helm providers with credentials that are never stored in state.
+//
+ephemeral "yandex_kubernetes_cluster_auth" "main" {
+  cluster_id = yandex_kubernetes_cluster.my_cluster.id
+}
+
+provider "kubernetes" {
+  host                   = ephemeral.yandex_kubernetes_cluster_auth.main.endpoint
+  cluster_ca_certificate = ephemeral.yandex_kubernetes_cluster_auth.main.cluster_ca_certificate
+  token                  = ephemeral.yandex_kubernetes_cluster_auth.main.token
+}
+
+provider "helm" {
+  kubernetes = {
+    host                   = ephemeral.yandex_kubernetes_cluster_auth.main.endpoint
+    cluster_ca_certificate = ephemeral.yandex_kubernetes_cluster_auth.main.cluster_ca_certificate
+    token                  = ephemeral.yandex_kubernetes_cluster_auth.main.token
+  }
+}
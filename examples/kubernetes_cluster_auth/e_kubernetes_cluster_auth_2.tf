@@ -0,0 +1,18 @@
+//
+// Run kubectl through the internal endpoint with a kubeconfig which requests tokens with the yc CLI.
+//
+ephemeral "yandex_kubernetes_cluster_auth" "internal" {
+  cluster_id    = yandex_kubernetes_cluster.my_cluster.id
+  endpoint_type = "internal"
+  auth_mode     = "exec"
+}
+
+resource "terraform_data" "manifests" {
+  provisioner "local-exec" {
+    interpreter = ["/bin/bash", "-c"]
+    command     = "kubectl --kubeconfig <(printf '%s' \"$KUBECONFIG_DATA\") apply -f manifests/"
+    environment = {
+      KUBECONFIG_DATA = ephemeral.yandex_kubernetes_cluster_auth.internal.kubeconfig
+    }
+  }
+}
@@ -0,0 +1,12 @@
+//
+// Pass a Lockbox secret to a provider configuration without storing it in state.
+//
+ephemeral "yandex_lockbox_secret_version" "db_credentials" {
+  secret_id = "some-secret-id"
+}
+
+provider "postgresql" {
+  host     = "c-some-cluster-id.rw.mdb.yandexcloud.net"
+  username = "admin"
+  password = ephemeral.yandex_lockbox_secret_version.db_credentials.entries_map["password"]
+}
@@ -0,0 +1,11 @@
+//
+// Find ClickHouse clusters of the folder that are not managed by Terraform yet.
+// Run with `terraform query`, add `-generate-config-out=generated.tf` to generate import blocks and configuration.
+//
+list "yandex_mdb_clickhouse_cluster" "all" {
+  provider = yandex
+
+  config {
+    folder_id = "b1g**********"
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = yandex_mdb_kafka_topic.events
+  identity = {
+    cluster_id = "..."
+    name       = "events"
+  }
+}
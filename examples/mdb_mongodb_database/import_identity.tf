@@ -0,0 +1,7 @@
+import {
+  to = yandex_mdb_mongodb_database.my_db
+  identity = {
+    cluster_id = "..."
+    name       = "my_db"
+  }
+}
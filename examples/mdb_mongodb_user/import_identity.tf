@@ -0,0 +1,7 @@
+import {
+  to = yandex_mdb_mongodb_user.my_user
+  identity = {
+    cluster_id = "..."
+    name       = "my_user"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = yandex_mdb_mysql_cluster.my_cluster
+  identity = {
+    id = "..."
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = yandex_mdb_mysql_cluster_v2.my_v2_cluster
+  identity = {
+    id = "..."
+  }
+}
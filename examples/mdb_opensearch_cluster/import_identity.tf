@@ -0,0 +1,6 @@
+import {
+  to = yandex_mdb_opensearch_cluster.my_cluster
+  identity = {
+    id = "..."
+  }
+}
@@ -0,0 +1,10 @@
+//
+// Get information about PostgreSQL clusters in the folder.
+//
+data "yandex_mdb_postgresql_clusters" "all" {
+  folder_id = "my-folder-id"
+}
+
+output "postgresql_cluster_ids" {
+  value = data.yandex_mdb_postgresql_clusters.all.clusters[*].id
+}
@@ -0,0 +1,14 @@
+//
+// Create a new MDB PostgreSQL database User with write-only password
+// read from the Lockbox secret. The password is never stored in the state.
+//
+ephemeral "yandex_lockbox_secret_version" "pg_password" {
+  secret_id = "e6q2ad0j9b55tvnaj0gn"
+}
+
+resource "yandex_mdb_postgresql_user" "my_user" {
+  cluster_id          = yandex_mdb_postgresql_cluster.my_cluster.id
+  name                = "alice"
+  password_wo         = ephemeral.yandex_lockbox_secret_version.pg_password.entries_map["password"]
+  password_wo_version = 1
+}
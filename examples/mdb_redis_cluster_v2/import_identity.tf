@@ -0,0 +1,6 @@
+import {
+  to = yandex_mdb_redis_cluster_v2.my_cluster
+  identity = {
+    id = "cluster_id"
+  }
+}
@@ -0,0 +1,13 @@
+//
+// Authenticate in CI with workload identity federation.
+// The JWT of the GitLab CI/CD job is declared with `id_tokens: { YC_JWT: { aud: ... } }`.
+//
+provider "yandex" {
+  cloud_id  = "cloud_id_here"
+  folder_id = "folder_id_here"
+
+  workload_identity {
+    service_account_id = "service_account_id_here"
+    jwt_env            = "YC_JWT"
+  }
+}
@@ -0,0 +1,6 @@
+//
+// Configure the Yandex Cloud Provider with the yc CLI profile
+//
+provider "yandex" {
+  profile = "prod"
+}
@@ -0,0 +1,9 @@
+//
+// Manage production resources as a dedicated service account.
+//
+provider "yandex" {
+  alias                          = "prod"
+  service_account_key_file       = "path_to_service_account_key_file"
+  impersonate_service_account_id = "prod_service_account_id_here"
+  folder_id                      = "prod_folder_id_here"
+}
@@ -0,0 +1,6 @@
+import {
+  to = yandex_spark_cluster.my_spark_cluster
+  identity = {
+    id = "..."
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = yandex_storage_bucket.test_bucket
+  identity = {
+    id = "..."
+  }
+}
@@ -0,0 +1,18 @@
+//
+// Manage the CORS configuration of a Storage Bucket.
+//
+resource "yandex_storage_bucket" "b" {
+  bucket = "s3-website-test.hashicorp.com"
+}
+
+resource "yandex_storage_bucket_cors_configuration" "b" {
+  bucket = yandex_storage_bucket.b.bucket
+
+  cors_rule {
+    allowed_headers = ["*"]
+    allowed_methods = ["PUT", "POST"]
+    allowed_origins = ["https://s3-website-test.hashicorp.com"]
+    expose_headers  = ["ETag"]
+    max_age_seconds = 3000
+  }
+}
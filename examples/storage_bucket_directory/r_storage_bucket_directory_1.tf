@@ -0,0 +1,29 @@
+//
+// Deploy a static website to a Storage Bucket.
+//
+resource "yandex_storage_bucket" "site" {
+  bucket = "my-site"
+
+  website {
+    index_document = "index.html"
+    error_document = "404.html"
+  }
+}
+
+resource "yandex_storage_bucket_directory" "site" {
+  bucket     = yandex_storage_bucket.site.bucket
+  source_dir = "${path.module}/public"
+  acl        = "public-read"
+
+  exclude         = ["**/*.map", "**/.DS_Store"]
+  delete_orphaned = true
+
+  cache_control {
+    pattern = "assets/**"
+    value   = "public, max-age=31536000, immutable"
+  }
+  cache_control {
+    pattern = "**"
+    value   = "no-cache"
+  }
+}
@@ -0,0 +1,2 @@
+# terraform import yandex_storage_bucket_grant.<resource Name> <bucket name>
+terraform import yandex_storage_bucket_grant.example my-bucket
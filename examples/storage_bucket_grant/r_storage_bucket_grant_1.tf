@@ -0,0 +1,22 @@
+//
+// Manage the ACL grants of a Storage Bucket.
+//
+resource "yandex_storage_bucket" "b" {
+  bucket = "my-tf-test-bucket"
+}
+
+resource "yandex_storage_bucket_grant" "b" {
+  bucket = yandex_storage_bucket.b.bucket
+
+  grant {
+    id          = "myuser"
+    type        = "CanonicalUser"
+    permissions = ["FULL_CONTROL"]
+  }
+
+  grant {
+    type        = "Group"
+    permissions = ["READ"]
+    uri         = "http://acs.amazonaws.com/groups/global/AllUsers"
+  }
+}
@@ -0,0 +1,2 @@
+# terraform import yandex_storage_bucket_lifecycle_configuration.<resource Name> <bucket name>
+terraform import yandex_storage_bucket_lifecycle_configuration.example my-bucket
@@ -0,0 +1,34 @@
+//
+// Manage the lifecycle configuration of a Storage Bucket.
+//
+resource "yandex_storage_bucket" "b" {
+  bucket = "my-tf-log-bucket"
+}
+
+resource "yandex_storage_bucket_lifecycle_configuration" "b" {
+  bucket = yandex_storage_bucket.b.bucket
+
+  lifecycle_rule {
+    id      = "log"
+    enabled = true
+
+    filter {
+      prefix = "log/"
+    }
+
+    transition {
+      days          = 30
+      storage_class = "COLD"
+    }
+
+    expiration {
+      days = 90
+    }
+  }
+
+  lifecycle_rule {
+    id                                     = "abortmultiparts"
+    enabled                                = true
+    abort_incomplete_multipart_upload_days = 7
+  }
+}
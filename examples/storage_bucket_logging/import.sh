@@ -0,0 +1,2 @@
+# terraform import yandex_storage_bucket_logging.<resource Name> <bucket name>
+terraform import yandex_storage_bucket_logging.example my-bucket
@@ -0,0 +1,19 @@
+//
+// Manage the action logging of a Storage Bucket.
+//
+resource "yandex_storage_bucket" "log_bucket" {
+  bucket = "my-tf-log-bucket"
+}
+
+resource "yandex_storage_bucket" "b" {
+  bucket = "my-tf-test-bucket"
+}
+
+resource "yandex_storage_bucket_logging" "b" {
+  bucket = yandex_storage_bucket.b.bucket
+
+  logging {
+    target_bucket = yandex_storage_bucket.log_bucket.bucket
+    target_prefix = "log/"
+  }
+}
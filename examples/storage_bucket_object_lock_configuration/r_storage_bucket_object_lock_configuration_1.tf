@@ -0,0 +1,28 @@
+//
+// Manage the object lock of a Storage Bucket.
+//
+resource "yandex_storage_bucket" "b" {
+  bucket = "my-tf-test-bucket"
+}
+
+resource "yandex_storage_bucket_versioning" "b" {
+  bucket = yandex_storage_bucket.b.bucket
+
+  versioning {
+    enabled = true
+  }
+}
+
+resource "yandex_storage_bucket_object_lock_configuration" "b" {
+  bucket = yandex_storage_bucket_versioning.b.bucket
+
+  object_lock_configuration {
+    object_lock_enabled = "Enabled"
+    rule {
+      default_retention {
+        mode = "GOVERNANCE"
+        days = 1
+      }
+    }
+  }
+}
@@ -0,0 +1,2 @@
+# terraform import yandex_storage_bucket_policy.<resource Name> <bucket name>
+terraform import yandex_storage_bucket_policy.example my-bucket
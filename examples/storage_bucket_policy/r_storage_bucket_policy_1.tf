@@ -0,0 +1,26 @@
+//
+// Manage the policy of a Storage Bucket.
+//
+resource "yandex_storage_bucket" "b" {
+  bucket = "my-policy-bucket"
+}
+
+resource "yandex_storage_bucket_policy" "b" {
+  bucket = yandex_storage_bucket.b.bucket
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": "*",
+      "Action": "s3:GetObject",
+      "Resource": [
+        "arn:aws:s3:::my-policy-bucket/*"
+      ]
+    }
+  ]
+}
+POLICY
+}
@@ -0,0 +1,26 @@
+//
+// Manage the default encryption of a Storage Bucket.
+//
+resource "yandex_kms_symmetric_key" "key-a" {
+  name              = "example-symetric-key"
+  description       = "description for key"
+  default_algorithm = "AES_128"
+  rotation_period   = "8760h" // equal to 1 year
+}
+
+resource "yandex_storage_bucket" "b" {
+  bucket = "my-tf-test-bucket"
+}
+
+resource "yandex_storage_bucket_server_side_encryption_configuration" "b" {
+  bucket = yandex_storage_bucket.b.bucket
+
+  server_side_encryption_configuration {
+    rule {
+      apply_server_side_encryption_by_default {
+        kms_master_key_id = yandex_kms_symmetric_key.key-a.id
+        sse_algorithm     = "aws:kms"
+      }
+    }
+  }
+}
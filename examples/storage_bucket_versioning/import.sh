@@ -0,0 +1,2 @@
+# terraform import yandex_storage_bucket_versioning.<resource Name> <bucket name>
+terraform import yandex_storage_bucket_versioning.example my-bucket
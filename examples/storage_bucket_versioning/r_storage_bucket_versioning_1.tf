@@ -0,0 +1,14 @@
+//
+// Manage the versioning of a Storage Bucket.
+//
+resource "yandex_storage_bucket" "b" {
+  bucket = "my-tf-test-bucket"
+}
+
+resource "yandex_storage_bucket_versioning" "b" {
+  bucket = yandex_storage_bucket.b.bucket
+
+  versioning {
+    enabled = true
+  }
+}
@@ -0,0 +1,16 @@
+//
+// Manage the static website hosting of a Storage Bucket.
+//
+resource "yandex_storage_bucket" "b" {
+  bucket = "storage-website-test.hashicorp.com"
+  acl    = "public-read"
+}
+
+resource "yandex_storage_bucket_website_configuration" "b" {
+  bucket = yandex_storage_bucket.b.bucket
+
+  website {
+    index_document = "index.html"
+    error_document = "error.html"
+  }
+}
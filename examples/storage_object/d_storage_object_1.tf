@@ -0,0 +1,11 @@
+//
+// Read the content of an existing Storage Object.
+//
+data "yandex_storage_object" "config" {
+  bucket = "my-bucket"
+  key    = "config/app.json"
+}
+
+output "app_config" {
+  value = jsondecode(data.yandex_storage_object.config.body)
+}
@@ -0,0 +1,16 @@
+//
+// List objects in a Storage Bucket.
+//
+data "yandex_storage_objects" "logs" {
+  bucket    = "my-bucket"
+  prefix    = "logs/"
+  delimiter = "/"
+}
+
+output "log_keys" {
+  value = data.yandex_storage_objects.logs.keys
+}
+
+output "log_directories" {
+  value = data.yandex_storage_objects.logs.common_prefixes
+}
@@ -0,0 +1,6 @@
+import {
+  to = yandex_vpc_network.my_net
+  identity = {
+    id = "..."
+  }
+}
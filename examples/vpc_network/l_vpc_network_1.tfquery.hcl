@@ -0,0 +1,11 @@
+//
+// Find VPC networks of the folder that are not managed by Terraform yet.
+// Run with `terraform query`, add `-generate-config-out=generated.tf` to generate import blocks and configuration.
+//
+list "yandex_vpc_network" "all" {
+  provider = yandex
+
+  config {
+    folder_id = "b1g**********"
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = yandex_vpc_security_group_rule.myrule
+  identity = {
+    security_group_id = "enphq**********cjsw4"
+    rule_id           = "enp2h**********7akj7"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = yandex_vpc_subnet.my_subnet
+  identity = {
+    id = "..."
+  }
+}
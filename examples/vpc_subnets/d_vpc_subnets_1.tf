@@ -0,0 +1,12 @@
+//
+// Get information about subnets labeled with role = "private".
+//
+data "yandex_vpc_subnets" "private" {
+  labels = {
+    role = "private"
+  }
+}
+
+output "private_subnet_ids" {
+  value = { for s in data.yandex_vpc_subnets.private.subnets : s.zone => s.id }
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"flag"
+	"log"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
@@ -15,10 +16,13 @@ import (
 
 func NewMuxProviderServer(ctx context.Context) (func() tfprotov6.ProviderServer, error) {
 
-	upgradedSdkProvider, _ := tf5to6server.UpgradeServer(
-		context.Background(),
+	upgradedSdkProvider, err := tf5to6server.UpgradeServer(
+		ctx,
 		yandex.NewSDKProvider().GRPCProvider,
 	)
+	if err != nil {
+		return nil, err
+	}
 
 	providers := []func() tfprotov6.ProviderServer{
 		providerserver.NewProtocol6(yandex_framework.NewFrameworkProvider()),
@@ -44,7 +48,7 @@ func main() {
 	muxServerFactory, err := NewMuxProviderServer(ctx)
 
 	if err != nil {
-		return
+		log.Fatal(err)
 	}
 
 	var serveOpts []tf6server.ServeOpt
@@ -60,6 +64,6 @@ func main() {
 	)
 
 	if err != nil {
-		return
+		log.Fatal(err)
 	}
 }
@@ -0,0 +1,81 @@
+package iamtoken
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// IAMTokenClient issues IAM tokens. It is implemented by the IAM token service client of the SDK.
+type IAMTokenClient interface {
+	Create(ctx context.Context, in *iam.CreateIamTokenRequest, opts ...grpc.CallOption) (*iam.CreateIamTokenResponse, error)
+	CreateForServiceAccount(ctx context.Context, in *iam.CreateIamTokenForServiceAccountRequest, opts ...grpc.CallOption) (*iam.CreateIamTokenResponse, error)
+}
+
+// ImpersonatedCredentials authenticate the SDK as the target service account. Its short-lived IAM tokens
+// are issued with the base credentials, which need the `iam.serviceAccounts.tokenCreator` role
+// on the target service account.
+type ImpersonatedCredentials struct {
+	base             ycsdk.Credentials
+	client           func() IAMTokenClient
+	serviceAccountID string
+}
+
+var _ ycsdk.NonExchangeableCredentials = &ImpersonatedCredentials{}
+
+// NewImpersonatedCredentials returns credentials of the service account serviceAccountID impersonated
+// by the base credentials. The client is requested on every token refresh, so it may be the client
+// of the SDK built with the returned credentials.
+func NewImpersonatedCredentials(base ycsdk.Credentials, serviceAccountID string, client func() IAMTokenClient) *ImpersonatedCredentials {
+	return &ImpersonatedCredentials{
+		base:             base,
+		client:           client,
+		serviceAccountID: serviceAccountID,
+	}
+}
+
+func (c *ImpersonatedCredentials) YandexCloudAPICredentials() {}
+
+func (c *ImpersonatedCredentials) IAMToken(ctx context.Context) (*iam.CreateIamTokenResponse, error) {
+	token, err := c.baseIAMToken(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get IAM token to impersonate service account %s: %w", c.serviceAccountID, err)
+	}
+
+	// Requests to the IAM token service are not authenticated by the SDK, so the token is passed explicitly.
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+	resp, err := c.client().CreateForServiceAccount(ctx, &iam.CreateIamTokenForServiceAccountRequest{
+		ServiceAccountId: c.serviceAccountID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to impersonate service account %s: %w", c.serviceAccountID, err)
+	}
+	return resp, nil
+}
+
+func (c *ImpersonatedCredentials) baseIAMToken(ctx context.Context) (string, error) {
+	switch base := c.base.(type) {
+	case ycsdk.NonExchangeableCredentials:
+		resp, err := base.IAMToken(ctx)
+		if err != nil {
+			return "", err
+		}
+		return resp.GetIamToken(), nil
+	case ycsdk.ExchangeableCredentials:
+		req, err := base.IAMTokenRequest()
+		if err != nil {
+			return "", err
+		}
+		resp, err := c.client().Create(ctx, req)
+		if err != nil {
+			return "", err
+		}
+		return resp.GetIamToken(), nil
+	default:
+		return "", fmt.Errorf("unsupported credentials type %T", c.base)
+	}
+}
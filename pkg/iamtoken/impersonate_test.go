@@ -0,0 +1,111 @@
+package iamtoken
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakeIAMTokenService issues tokens of service accounts to the callers with the base token.
+type fakeIAMTokenService struct {
+	iam.UnimplementedIamTokenServiceServer
+
+	mu              sync.Mutex
+	createRequests  []*iam.CreateIamTokenRequest
+	serviceAccounts []string
+	authorization   []string
+	err             error
+}
+
+func (s *fakeIAMTokenService) Create(_ context.Context, req *iam.CreateIamTokenRequest) (*iam.CreateIamTokenResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.createRequests = append(s.createRequests, req)
+	return &iam.CreateIamTokenResponse{IamToken: "t1.base"}, nil
+}
+
+func (s *fakeIAMTokenService) CreateForServiceAccount(ctx context.Context, req *iam.CreateIamTokenForServiceAccountRequest) (*iam.CreateIamTokenResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.authorization = append(s.authorization, md.Get("authorization")...)
+	s.serviceAccounts = append(s.serviceAccounts, req.GetServiceAccountId())
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &iam.CreateIamTokenResponse{IamToken: "t1.impersonated." + req.GetServiceAccountId()}, nil
+}
+
+func newFakeIAMTokenClient(t *testing.T, service *fakeIAMTokenService) IAMTokenClient {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	iam.RegisterIamTokenServiceServer(server, service)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return iam.NewIamTokenServiceClient(conn)
+}
+
+func TestImpersonatedCredentialsNonExchangeableBase(t *testing.T) {
+	service := &fakeIAMTokenService{}
+	client := newFakeIAMTokenClient(t, service)
+
+	credentials := NewImpersonatedCredentials(ycsdk.NewIAMTokenCredentials("t1.base"), "sa-id", func() IAMTokenClient { return client })
+	resp, err := credentials.IAMToken(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, "t1.impersonated.sa-id", resp.GetIamToken())
+	assert.Equal(t, []string{"sa-id"}, service.serviceAccounts)
+	assert.Equal(t, []string{"Bearer t1.base"}, service.authorization)
+	assert.Empty(t, service.createRequests)
+}
+
+func TestImpersonatedCredentialsExchangeableBase(t *testing.T) {
+	service := &fakeIAMTokenService{}
+	client := newFakeIAMTokenClient(t, service)
+
+	credentials := NewImpersonatedCredentials(ycsdk.OAuthToken("oauth-token"), "sa-id", func() IAMTokenClient { return client })
+	resp, err := credentials.IAMToken(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, "t1.impersonated.sa-id", resp.GetIamToken())
+	require.Len(t, service.createRequests, 1)
+	assert.Equal(t, "oauth-token", service.createRequests[0].GetYandexPassportOauthToken())
+	assert.Equal(t, []string{"sa-id"}, service.serviceAccounts)
+	assert.Equal(t, []string{"Bearer t1.base"}, service.authorization)
+}
+
+func TestImpersonatedCredentialsError(t *testing.T) {
+	service := &fakeIAMTokenService{err: status.Error(codes.PermissionDenied, "no tokenCreator role")}
+	client := newFakeIAMTokenClient(t, service)
+
+	credentials := NewImpersonatedCredentials(ycsdk.NewIAMTokenCredentials("t1.base"), "sa-id", func() IAMTokenClient { return client })
+	_, err := credentials.IAMToken(context.Background())
+	require.Error(t, err)
+
+	assert.Contains(t, err.Error(), "failed to impersonate service account sa-id")
+	assert.Contains(t, err.Error(), "no tokenCreator role")
+	grpcStatus, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, grpcStatus.Code())
+}
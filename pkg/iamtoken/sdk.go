@@ -0,0 +1,24 @@
+package iamtoken
+
+import (
+	"context"
+	"time"
+
+	ycsdk "github.com/yandex-cloud/go-sdk"
+)
+
+// NewSDKSource returns token source issuing tokens for the credentials of the SDK.
+func NewSDKSource(sdk *ycsdk.SDK) *Source {
+	return NewSource(func(ctx context.Context) (string, time.Time, error) {
+		resp, err := sdk.CreateIAMToken(ctx)
+		if err != nil {
+			return "", time.Time{}, err
+		}
+
+		var expiresAt time.Time
+		if resp.ExpiresAt != nil && resp.ExpiresAt.IsValid() {
+			expiresAt = resp.ExpiresAt.AsTime()
+		}
+		return resp.IamToken, expiresAt, nil
+	}, DefaultRefreshMargin)
+}
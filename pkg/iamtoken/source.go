@@ -0,0 +1,98 @@
+package iamtoken
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultRefreshMargin is the time before the token expiration when the token is refreshed in background.
+	// IAM tokens live for 12 hours, so the token is refreshed long before requests may fail with it.
+	DefaultRefreshMargin = time.Hour
+
+	// expiryMargin is the time before the token expiration when the token is no longer used
+	// and requests wait for a new one.
+	expiryMargin = time.Minute
+
+	// defaultLifetime is assumed for tokens issued without expiration time.
+	defaultLifetime = time.Hour
+
+	refreshTimeout = time.Minute
+)
+
+// CreateFunc issues a new IAM token and returns it with its expiration time.
+type CreateFunc func(ctx context.Context) (token string, expiresAt time.Time, err error)
+
+// Source caches the IAM token of the provider credentials and refreshes it before expiration.
+// It is safe for concurrent use, so a single source is shared by all resources of the provider.
+type Source struct {
+	create        CreateFunc
+	refreshMargin time.Duration
+	now           func() time.Time
+
+	mu         sync.Mutex
+	token      string
+	expiresAt  time.Time
+	refreshing bool
+}
+
+// NewSource returns token source that refreshes the token in background when it expires
+// in less than refreshMargin.
+func NewSource(create CreateFunc, refreshMargin time.Duration) *Source {
+	return &Source{
+		create:        create,
+		refreshMargin: refreshMargin,
+		now:           time.Now,
+	}
+}
+
+// Token returns the cached IAM token. If the token is about to expire, it starts refreshing it in background
+// and returns the cached one. If there is no token or it has expired, it waits for a new token.
+func (s *Source) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if s.token != "" && now.Before(s.expiresAt.Add(-expiryMargin)) {
+		if !s.refreshing && !now.Before(s.expiresAt.Add(-s.refreshMargin)) {
+			s.refreshing = true
+			go s.refresh(context.WithoutCancel(ctx))
+		}
+		return s.token, nil
+	}
+
+	token, expiresAt, err := s.create(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get IAM token: %w", err)
+	}
+	s.set(token, expiresAt)
+	return s.token, nil
+}
+
+func (s *Source) refresh(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, refreshTimeout)
+	defer cancel()
+
+	token, expiresAt, err := s.create(ctx)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.refreshing = false
+	if err != nil {
+		// The cached token is still valid, next call of Token retries the refresh.
+		log.Printf("[WARN] Failed to refresh IAM token: %s", err)
+		return
+	}
+	s.set(token, expiresAt)
+}
+
+func (s *Source) set(token string, expiresAt time.Time) {
+	if expiresAt.IsZero() {
+		expiresAt = s.now().Add(defaultLifetime)
+	}
+	s.token = token
+	s.expiresAt = expiresAt
+}
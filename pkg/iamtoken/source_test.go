@@ -0,0 +1,144 @@
+package iamtoken
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeIAM struct {
+	mu       sync.Mutex
+	now      time.Time
+	lifetime time.Duration
+	calls    atomic.Int32
+	err      error
+}
+
+func (f *fakeIAM) create(context.Context) (string, time.Time, error) {
+	n := f.calls.Add(1)
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return "", time.Time{}, f.err
+	}
+	return "t1.token" + string(rune('0'+n)), f.now.Add(f.lifetime), nil
+}
+
+func (f *fakeIAM) clock() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+func (f *fakeIAM) advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = f.now.Add(d)
+}
+
+func newTestSource(f *fakeIAM) *Source {
+	s := NewSource(f.create, DefaultRefreshMargin)
+	s.now = f.clock
+	return s
+}
+
+func TestSourceCachesToken(t *testing.T) {
+	f := &fakeIAM{now: time.Now(), lifetime: 12 * time.Hour}
+	s := newTestSource(f)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			token, err := s.Token(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, "t1.token1", token)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), f.calls.Load())
+}
+
+func TestSourceRefreshesInBackground(t *testing.T) {
+	f := &fakeIAM{now: time.Now(), lifetime: 12 * time.Hour}
+	s := newTestSource(f)
+
+	_, err := s.Token(context.Background())
+	require.NoError(t, err)
+
+	f.advance(11*time.Hour + 30*time.Minute)
+	token, err := s.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "t1.token1", token, "token about to expire must be returned while it is refreshed")
+
+	require.Eventually(t, func() bool {
+		token, err := s.Token(context.Background())
+		return err == nil && token == "t1.token2"
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, int32(2), f.calls.Load())
+}
+
+func TestSourceWaitsForExpiredToken(t *testing.T) {
+	f := &fakeIAM{now: time.Now(), lifetime: 12 * time.Hour}
+	s := newTestSource(f)
+
+	_, err := s.Token(context.Background())
+	require.NoError(t, err)
+
+	f.advance(12 * time.Hour)
+	token, err := s.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "t1.token2", token)
+}
+
+func TestSourceKeepsTokenOnRefreshError(t *testing.T) {
+	f := &fakeIAM{now: time.Now(), lifetime: 12 * time.Hour}
+	s := newTestSource(f)
+
+	_, err := s.Token(context.Background())
+	require.NoError(t, err)
+
+	f.mu.Lock()
+	f.err = errors.New("unavailable")
+	f.mu.Unlock()
+	f.advance(11*time.Hour + 30*time.Minute)
+
+	token, err := s.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "t1.token1", token)
+
+	f.advance(30 * time.Minute)
+	_, err = s.Token(context.Background())
+	assert.EqualError(t, err, "failed to get IAM token: unavailable")
+}
+
+func TestTransportSetsToken(t *testing.T) {
+	f := &fakeIAM{now: time.Now(), lifetime: 12 * time.Hour}
+	s := newTestSource(f)
+
+	var header string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Get(SubjectTokenHeader)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewTransport(s.Token)}
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, "t1.token1", header)
+	assert.Empty(t, req.Header.Get(SubjectTokenHeader), "request of the caller must not be modified")
+}
@@ -0,0 +1,37 @@
+package iamtoken
+
+import (
+	"context"
+	"net/http"
+)
+
+// SubjectTokenHeader is the header to pass IAM token to S3 compatible APIs, e.g. Object Storage and Message Queue.
+const SubjectTokenHeader = "X-YaCloud-SubjectToken"
+
+// Transport sets the IAM token header of the requests. The token is requested for every request,
+// so long-running applies keep working after the token has been refreshed.
+type Transport struct {
+	Base   http.RoundTripper
+	Header string
+	Token  func(ctx context.Context) (string, error)
+}
+
+func NewTransport(token func(ctx context.Context) (string, error)) *Transport {
+	return &Transport{
+		Base:   http.DefaultTransport,
+		Header: SubjectTokenHeader,
+		Token:  token,
+	}
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.Token(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	// RoundTripper must not modify the request.
+	req = req.Clone(req.Context())
+	req.Header.Set(t.Header, token)
+	return t.Base.RoundTrip(req)
+}
@@ -0,0 +1,146 @@
+// Package kubeconfig renders kubeconfig files for Managed Service for Kubernetes clusters.
+package kubeconfig
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// AuthModeToken embeds a bearer token into the kubeconfig.
+	AuthModeToken = "token"
+	// AuthModeExec makes kubectl request a token with the yc CLI on each call.
+	AuthModeExec = "exec"
+
+	execAPIVersion = "client.authentication.k8s.io/v1beta1"
+)
+
+// Cluster holds the connection details of a cluster.
+type Cluster struct {
+	ID            string
+	Name          string
+	Endpoint      string
+	CACertificate string
+}
+
+// Options control the user section of the kubeconfig.
+type Options struct {
+	// AuthMode is either AuthModeToken or AuthModeExec.
+	AuthMode string
+	// Token is used with AuthModeToken.
+	Token string
+	// Profile is the yc CLI profile used with AuthModeExec. The active profile is used if empty.
+	Profile string
+}
+
+type config struct {
+	APIVersion     string         `yaml:"apiVersion"`
+	Kind           string         `yaml:"kind"`
+	Clusters       []namedCluster `yaml:"clusters"`
+	Contexts       []namedContext `yaml:"contexts"`
+	CurrentContext string         `yaml:"current-context"`
+	Users          []namedUser    `yaml:"users"`
+}
+
+type namedCluster struct {
+	Name    string  `yaml:"name"`
+	Cluster cluster `yaml:"cluster"`
+}
+
+type cluster struct {
+	Server                   string `yaml:"server"`
+	CertificateAuthorityData string `yaml:"certificate-authority-data,omitempty"`
+}
+
+type namedContext struct {
+	Name    string      `yaml:"name"`
+	Context kubeContext `yaml:"context"`
+}
+
+type kubeContext struct {
+	Cluster string `yaml:"cluster"`
+	User    string `yaml:"user"`
+}
+
+type namedUser struct {
+	Name string `yaml:"name"`
+	User user   `yaml:"user"`
+}
+
+type user struct {
+	Token string      `yaml:"token,omitempty"`
+	Exec  *execConfig `yaml:"exec,omitempty"`
+}
+
+type execConfig struct {
+	APIVersion         string   `yaml:"apiVersion"`
+	Command            string   `yaml:"command"`
+	Args               []string `yaml:"args"`
+	InteractiveMode    string   `yaml:"interactiveMode"`
+	ProvideClusterInfo bool     `yaml:"provideClusterInfo"`
+}
+
+// Render renders a kubeconfig with a single cluster, user and context. Names follow the ones
+// used by `yc managed-kubernetes cluster get-credentials`.
+func Render(c Cluster, opts Options) (string, error) {
+	if c.Endpoint == "" {
+		return "", fmt.Errorf("cluster %q has no endpoint", c.ID)
+	}
+
+	var u user
+	switch opts.AuthMode {
+	case AuthModeToken:
+		if opts.Token == "" {
+			return "", fmt.Errorf("token is required in %q auth mode", AuthModeToken)
+		}
+		u.Token = opts.Token
+	case AuthModeExec:
+		args := []string{"k8s", "create-token"}
+		if opts.Profile != "" {
+			args = append(args, "--profile="+opts.Profile)
+		}
+		u.Exec = &execConfig{
+			APIVersion:      execAPIVersion,
+			Command:         "yc",
+			Args:            args,
+			InteractiveMode: "IfAvailable",
+		}
+	default:
+		return "", fmt.Errorf("unknown auth mode %q", opts.AuthMode)
+	}
+
+	name := "yc-managed-k8s-" + c.ID
+	contextName := "yc-" + c.Name
+	if c.Name == "" {
+		contextName = name
+	}
+
+	cfg := config{
+		APIVersion: "v1",
+		Kind:       "Config",
+		Clusters: []namedCluster{{
+			Name: name,
+			Cluster: cluster{
+				Server:                   c.Endpoint,
+				CertificateAuthorityData: base64.StdEncoding.EncodeToString([]byte(c.CACertificate)),
+			},
+		}},
+		Contexts: []namedContext{{
+			Name:    contextName,
+			Context: kubeContext{Cluster: name, User: name},
+		}},
+		CurrentContext: contextName,
+		Users:          []namedUser{{Name: name, User: u}},
+	}
+	if c.CACertificate == "" {
+		cfg.Clusters[0].Cluster.CertificateAuthorityData = ""
+	}
+
+	out, err := yaml.Marshal(cfg)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal kubeconfig: %w", err)
+	}
+	return string(out), nil
+}
@@ -0,0 +1,67 @@
+package kubeconfig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testCluster = Cluster{
+	ID:            "cat0abcdefgh",
+	Name:          "main",
+	Endpoint:      "https://192.0.2.1",
+	CACertificate: "-----BEGIN CERTIFICATE-----\n",
+}
+
+func TestRenderToken(t *testing.T) {
+	out, err := Render(testCluster, Options{AuthMode: AuthModeToken, Token: "t1.abc"})
+	require.NoError(t, err)
+
+	assert.Equal(t, `apiVersion: v1
+kind: Config
+clusters:
+    - name: yc-managed-k8s-cat0abcdefgh
+      cluster:
+        server: https://192.0.2.1
+        certificate-authority-data: LS0tLS1CRUdJTiBDRVJUSUZJQ0FURS0tLS0tCg==
+contexts:
+    - name: yc-main
+      context:
+        cluster: yc-managed-k8s-cat0abcdefgh
+        user: yc-managed-k8s-cat0abcdefgh
+current-context: yc-main
+users:
+    - name: yc-managed-k8s-cat0abcdefgh
+      user:
+        token: t1.abc
+`, out)
+}
+
+func TestRenderExec(t *testing.T) {
+	out, err := Render(testCluster, Options{AuthMode: AuthModeExec, Profile: "prod"})
+	require.NoError(t, err)
+
+	assert.Contains(t, out, `        exec:
+            apiVersion: client.authentication.k8s.io/v1beta1
+            command: yc
+            args:
+                - k8s
+                - create-token
+                - --profile=prod
+            interactiveMode: IfAvailable
+            provideClusterInfo: false
+`)
+	assert.NotContains(t, out, "token:")
+}
+
+func TestRenderErrors(t *testing.T) {
+	_, err := Render(Cluster{ID: "cat0abcdefgh"}, Options{AuthMode: AuthModeExec})
+	assert.Error(t, err)
+
+	_, err = Render(testCluster, Options{AuthMode: AuthModeToken})
+	assert.Error(t, err)
+
+	_, err = Render(testCluster, Options{AuthMode: "password"})
+	assert.Error(t, err)
+}
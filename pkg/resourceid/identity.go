@@ -0,0 +1,72 @@
+package resourceid
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// IdentitySchema returns resource identity schema with one string attribute per identifier part.
+func (f Format) IdentitySchema() identityschema.Schema {
+	attrs := make(map[string]identityschema.Attribute, len(f.Parts))
+	for _, part := range f.Parts {
+		attrs[part] = identityschema.StringAttribute{
+			RequiredForImport: true,
+			Description:       fmt.Sprintf("The `%s` part of the resource identifier %s.", part, f),
+		}
+	}
+	return identityschema.Schema{Attributes: attrs}
+}
+
+// SetIdentity writes identifier parts to the resource identity. Does nothing if the resource
+// identity is not supported by Terraform or the resource has been removed.
+func (f Format) SetIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, resourceID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if identity == nil || resourceID == "" {
+		return diags
+	}
+
+	parts, err := f.Deconstruct(resourceID)
+	if err != nil {
+		diags.AddError("Failed to set resource identity", err.Error())
+		return diags
+	}
+	for i, part := range f.Parts {
+		diags.Append(identity.SetAttribute(ctx, path.Root(part), parts[i])...)
+	}
+	return diags
+}
+
+// ImportID returns the identifier of the imported resource. It is either
+// the import ID as is or it is constructed from the resource identity attributes
+// when the resource is imported with an `identity` block. The identity of the
+// imported resource is stored to the response.
+func (f Format) ImportID(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) string {
+	resourceID := req.ID
+	if resourceID == "" && req.Identity != nil {
+		parts := make([]string, 0, len(f.Parts))
+		for _, part := range f.Parts {
+			var value types.String
+			resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root(part), &value)...)
+			parts = append(parts, value.ValueString())
+		}
+		if resp.Diagnostics.HasError() {
+			return ""
+		}
+		resourceID = f.Construct(parts...)
+	}
+
+	if _, err := f.Deconstruct(resourceID); err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return ""
+	}
+
+	resp.Diagnostics.Append(f.SetIdentity(ctx, resp.Identity, resourceID)...)
+	return resourceID
+}
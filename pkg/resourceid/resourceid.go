@@ -19,3 +19,109 @@ func Deconstruct(resourceID string) (string, string, error) {
 	resourceName := parts[1]
 	return clusterID, resourceName, nil
 }
+
+// Format describes a resource identifier composed of several parts joined with a separator.
+// Part names are also used as attribute names of the resource identity.
+type Format struct {
+	Separator string
+	Parts     []string
+	// SeparatorInLastPart allows the last part to contain the separator, e.g. a Kafka topic name may contain colons.
+	SeparatorInLastPart bool
+}
+
+var (
+	// ID is a plain resource identifier as returned by the API.
+	ID = Format{Parts: []string{"id"}}
+	// ClusterResource identifies a resource nested into a cluster, e.g. a database, a user or a Kafka topic.
+	ClusterResource = Format{Separator: ":", Parts: []string{"cluster_id", "name"}, SeparatorInLastPart: true}
+	// SecurityGroupRule identifies a rule of a VPC security group.
+	SecurityGroupRule = Format{Separator: ":", Parts: []string{"security_group_id", "rule_id"}}
+	// VirtualHost identifies an ALB virtual host within its HTTP router.
+	VirtualHost = Format{Separator: "/", Parts: []string{"http_router_id", "name"}}
+	// VirtualHostRoute identifies a route of an ALB virtual host.
+	VirtualHostRoute = Format{Separator: "/", Parts: []string{"http_router_id", "virtual_host_name", "name"}}
+	// IAMMember identifies an IAM member binding, the member part is in TYPE:ID format.
+	IAMMember = Format{Separator: "/", Parts: []string{"resource_id", "role", "member"}}
+)
+
+// String returns the human-readable layout of the identifier, e.g. <cluster_id>:<name>.
+func (f Format) String() string {
+	layout := make([]string, 0, len(f.Parts))
+	for _, part := range f.Parts {
+		layout = append(layout, "<"+part+">")
+	}
+	return strings.Join(layout, f.Separator)
+}
+
+// Construct joins identifier parts in the order of the format parts.
+func (f Format) Construct(parts ...string) string {
+	return strings.Join(parts, f.Separator)
+}
+
+// Deconstruct splits the identifier into its parts.
+func (f Format) Deconstruct(resourceID string) ([]string, error) {
+	if len(f.Parts) == 1 {
+		if resourceID == "" {
+			return nil, fmt.Errorf("Invalid resource id format: %q, expected format is %s", resourceID, f)
+		}
+		return []string{resourceID}, nil
+	}
+
+	var parts []string
+	if f.SeparatorInLastPart {
+		parts = strings.SplitN(resourceID, f.Separator, len(f.Parts))
+	} else {
+		parts = strings.Split(resourceID, f.Separator)
+	}
+	if len(parts) != len(f.Parts) {
+		return nil, fmt.Errorf("Invalid resource id format: %q, expected format is %s", resourceID, f)
+	}
+	for _, part := range parts {
+		if part == "" {
+			return nil, fmt.Errorf("Invalid resource id format: %q, expected format is %s", resourceID, f)
+		}
+	}
+	return parts, nil
+}
+
+// DeconstructVirtualHostID splits ALB virtual host id into HTTP router id and virtual host name.
+func DeconstructVirtualHostID(resourceID string) (string, string, error) {
+	parts, err := VirtualHost.Deconstruct(resourceID)
+	if err != nil {
+		return "", "", err
+	}
+	return parts[0], parts[1], nil
+}
+
+// DeconstructIAMMemberID splits IAM member id into resource id, role and member.
+func DeconstructIAMMemberID(resourceID string) (string, string, string, error) {
+	parts, err := IAMMember.Deconstruct(resourceID)
+	if err != nil {
+		return "", "", "", err
+	}
+	if err := ValidateIAMMember(parts[2]); err != nil {
+		return "", "", "", fmt.Errorf("Invalid resource id format: %q: %w", resourceID, err)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+// ParseIAMMemberImportID parses IAM member import id in 'resource_id role member' format.
+func ParseIAMMemberImportID(importID string) (string, string, string, error) {
+	s := strings.Fields(importID)
+	if len(s) != 3 {
+		return "", "", "", fmt.Errorf("Wrong number of parts to Member id %s; expected 'resource_name role member'", s)
+	}
+	if err := ValidateIAMMember(s[2]); err != nil {
+		return "", "", "", err
+	}
+	return s[0], s[1], s[2], nil
+}
+
+// ValidateIAMMember checks that IAM member is in TYPE:ID format.
+func ValidateIAMMember(member string) error {
+	chunks := strings.SplitN(member, ":", 2)
+	if len(chunks) != 2 || chunks[0] == "" || chunks[1] == "" {
+		return fmt.Errorf("Invalid member spec %q, must be in TYPE:ID format", member)
+	}
+	return nil
+}
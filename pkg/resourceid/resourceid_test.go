@@ -0,0 +1,122 @@
+package resourceid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatDeconstruct(t *testing.T) {
+	tests := []struct {
+		name      string
+		format    Format
+		id        string
+		expected  []string
+		expectErr bool
+	}{
+		{
+			name:     "plain id",
+			format:   ID,
+			id:       "c9qabc123",
+			expected: []string{"c9qabc123"},
+		},
+		{
+			name:      "empty plain id",
+			format:    ID,
+			id:        "",
+			expectErr: true,
+		},
+		{
+			name:     "cluster resource",
+			format:   ClusterResource,
+			id:       "c9qabc123:alice",
+			expected: []string{"c9qabc123", "alice"},
+		},
+		{
+			name:     "cluster resource with separator in name",
+			format:   ClusterResource,
+			id:       "c9qabc123:topic:1",
+			expected: []string{"c9qabc123", "topic:1"},
+		},
+		{
+			name:      "cluster resource without name",
+			format:    ClusterResource,
+			id:        "c9qabc123:",
+			expectErr: true,
+		},
+		{
+			name:     "virtual host",
+			format:   VirtualHost,
+			id:       "ds7abc123/my-host",
+			expected: []string{"ds7abc123", "my-host"},
+		},
+		{
+			name:      "virtual host with too many parts",
+			format:    VirtualHost,
+			id:        "ds7abc123/my-host/route",
+			expectErr: true,
+		},
+		{
+			name:     "virtual host route",
+			format:   VirtualHostRoute,
+			id:       "ds7abc123/my-host/my-route",
+			expected: []string{"ds7abc123", "my-host", "my-route"},
+		},
+		{
+			name:      "virtual host route without name",
+			format:    VirtualHostRoute,
+			id:        "ds7abc123/my-host",
+			expectErr: true,
+		},
+		{
+			name:     "iam member",
+			format:   IAMMember,
+			id:       "b1gabc123/editor/serviceAccount:aje123",
+			expected: []string{"b1gabc123", "editor", "serviceAccount:aje123"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			parts, err := tc.format.Deconstruct(tc.id)
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, parts)
+			assert.Equal(t, tc.id, tc.format.Construct(parts...))
+		})
+	}
+}
+
+func TestFormatString(t *testing.T) {
+	assert.Equal(t, "<cluster_id>:<name>", ClusterResource.String())
+	assert.Equal(t, "<resource_id>/<role>/<member>", IAMMember.String())
+}
+
+func TestDeconstructIAMMemberID(t *testing.T) {
+	resourceID, role, member, err := DeconstructIAMMemberID("b1gabc123/editor/userAccount:aje123")
+	require.NoError(t, err)
+	assert.Equal(t, "b1gabc123", resourceID)
+	assert.Equal(t, "editor", role)
+	assert.Equal(t, "userAccount:aje123", member)
+
+	_, _, _, err = DeconstructIAMMemberID("b1gabc123/editor/aje123")
+	assert.Error(t, err)
+}
+
+func TestParseIAMMemberImportID(t *testing.T) {
+	resourceID, role, member, err := ParseIAMMemberImportID("b1gabc123 editor system:allUsers")
+	require.NoError(t, err)
+	assert.Equal(t, "b1gabc123", resourceID)
+	assert.Equal(t, "editor", role)
+	assert.Equal(t, "system:allUsers", member)
+
+	_, _, _, err = ParseIAMMemberImportID("b1gabc123 editor")
+	assert.Error(t, err)
+
+	_, _, _, err = ParseIAMMemberImportID("b1gabc123 editor aje123")
+	assert.Error(t, err)
+}
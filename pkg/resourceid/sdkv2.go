@@ -0,0 +1,73 @@
+package resourceid
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// SDKIdentity returns SDKv2 resource identity with one string attribute per identifier part.
+func (f Format) SDKIdentity() *schema.ResourceIdentity {
+	return &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			attrs := make(map[string]*schema.Schema, len(f.Parts))
+			for _, part := range f.Parts {
+				attrs[part] = &schema.Schema{
+					Type:              schema.TypeString,
+					RequiredForImport: true,
+					Description:       fmt.Sprintf("The `%s` part of the resource identifier %s.", part, f),
+				}
+			}
+			return attrs
+		},
+	}
+}
+
+// SDKSetIdentity writes parts of the resource ID to the resource identity.
+func (f Format) SDKSetIdentity(d *schema.ResourceData) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	parts, err := f.Deconstruct(d.Id())
+	if err != nil {
+		return err
+	}
+	identity, err := d.Identity()
+	if err != nil {
+		return err
+	}
+	for i, part := range f.Parts {
+		if err := identity.Set(part, parts[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SDKImportState constructs the resource ID from the resource identity when the resource
+// is imported with an `identity` block and then calls the wrapped import function, if any.
+func (f Format) SDKImportState(next schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		if d.Id() == "" {
+			identity, err := d.Identity()
+			if err != nil {
+				return nil, err
+			}
+			parts := make([]string, 0, len(f.Parts))
+			for _, part := range f.Parts {
+				parts = append(parts, identity.Get(part).(string))
+			}
+			d.SetId(f.Construct(parts...))
+		}
+
+		if err := f.SDKSetIdentity(d); err != nil {
+			return nil, err
+		}
+		if next == nil {
+			return []*schema.ResourceData{d}, nil
+		}
+		return next(ctx, d, meta)
+	}
+}
@@ -64,10 +64,13 @@ var userID2 = "no user id"
 var storageEndpoint = "no.storage.endpoint"
 
 func NewFrameworkProviderServer(ctx context.Context) (func() tfprotov6.ProviderServer, error) {
-	upgradedSdkProvider, _ := tf5to6server.UpgradeServer(
-		context.Background(),
+	upgradedSdkProvider, err := tf5to6server.UpgradeServer(
+		ctx,
 		yandex.NewSDKProvider().GRPCProvider,
 	)
+	if err != nil {
+		return nil, err
+	}
 	providers := []func() tfprotov6.ProviderServer{
 		providerserver.NewProtocol6(AccProvider),
 		func() tfprotov6.ProviderServer {
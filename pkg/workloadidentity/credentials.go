@@ -0,0 +1,38 @@
+package workloadidentity
+
+import (
+	"context"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Credentials authenticate the SDK with IAM tokens exchanged for the JWT of the CI job.
+type Credentials struct {
+	config Config
+}
+
+var _ ycsdk.NonExchangeableCredentials = &Credentials{}
+
+func NewCredentials(config Config) (*Credentials, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return &Credentials{config: config}, nil
+}
+
+func (c *Credentials) YandexCloudAPICredentials() {}
+
+func (c *Credentials) IAMToken(ctx context.Context) (*iam.CreateIamTokenResponse, error) {
+	token, expiresAt, err := c.config.Exchange(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &iam.CreateIamTokenResponse{IamToken: token}
+	if !expiresAt.IsZero() {
+		resp.ExpiresAt = timestamppb.New(expiresAt)
+	}
+	return resp, nil
+}
@@ -0,0 +1,137 @@
+package workloadidentity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultTokenExchangeEndpoint is the Security Token Service endpoint exchanging external tokens for IAM tokens.
+	DefaultTokenExchangeEndpoint = "https://auth.yandex.cloud/oauth/token"
+
+	grantTypeTokenExchange = "urn:ietf:params:oauth:grant-type:token-exchange"
+	tokenTypeAccessToken   = "urn:ietf:params:oauth:token-type:access_token"
+	tokenTypeIDToken       = "urn:ietf:params:oauth:token-type:id_token"
+
+	exchangeTimeout = 30 * time.Second
+)
+
+// Config describes the federated credential of the service account. The JWT of the CI job is
+// read either from JWTFile or from JWTEnv environment variable on every exchange, since CI
+// systems may rotate it during the job.
+type Config struct {
+	ServiceAccountID string
+	JWTFile          string
+	JWTEnv           string
+
+	// Endpoint of the token exchange, DefaultTokenExchangeEndpoint is used if empty.
+	Endpoint   string
+	HTTPClient *http.Client
+}
+
+// Validate checks that the service account and exactly one JWT source are specified.
+func (c *Config) Validate() error {
+	if c.ServiceAccountID == "" {
+		return fmt.Errorf("workload identity: service_account_id must be specified")
+	}
+	if (c.JWTFile == "") == (c.JWTEnv == "") {
+		return fmt.Errorf("workload identity: exactly one of jwt_file or jwt_env must be specified")
+	}
+	return nil
+}
+
+type exchangeResponse struct {
+	AccessToken      string `json:"access_token"`
+	ExpiresIn        int64  `json:"expires_in"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// Exchange exchanges the JWT for an IAM token of the service account as described
+// in RFC 8693 and returns the token with its expiration time.
+func (c *Config) Exchange(ctx context.Context) (string, time.Time, error) {
+	subjectToken, err := c.subjectToken()
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	endpoint := c.Endpoint
+	if endpoint == "" {
+		endpoint = DefaultTokenExchangeEndpoint
+	}
+	form := url.Values{
+		"grant_type":           {grantTypeTokenExchange},
+		"requested_token_type": {tokenTypeAccessToken},
+		"audience":             {c.ServiceAccountID},
+		"subject_token":        {subjectToken},
+		"subject_token_type":   {tokenTypeIDToken},
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, exchangeTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("workload identity: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	client := c.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	requestedAt := time.Now()
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("workload identity: token exchange failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("workload identity: failed to read token exchange response: %w", err)
+	}
+
+	var result exchangeResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return "", time.Time{}, fmt.Errorf("workload identity: token exchange failed with status %d: %s", resp.StatusCode, body)
+	}
+	if resp.StatusCode != http.StatusOK || result.AccessToken == "" {
+		if result.Error != "" {
+			return "", time.Time{}, fmt.Errorf("workload identity: token exchange failed with status %d: %s: %s", resp.StatusCode, result.Error, result.ErrorDescription)
+		}
+		return "", time.Time{}, fmt.Errorf("workload identity: token exchange failed with status %d: %s", resp.StatusCode, body)
+	}
+
+	var expiresAt time.Time
+	if result.ExpiresIn > 0 {
+		expiresAt = requestedAt.Add(time.Duration(result.ExpiresIn) * time.Second)
+	}
+	return result.AccessToken, expiresAt, nil
+}
+
+func (c *Config) subjectToken() (string, error) {
+	if c.JWTEnv != "" {
+		token := strings.TrimSpace(os.Getenv(c.JWTEnv))
+		if token == "" {
+			return "", fmt.Errorf("workload identity: environment variable %s is empty", c.JWTEnv)
+		}
+		return token, nil
+	}
+
+	data, err := os.ReadFile(c.JWTFile)
+	if err != nil {
+		return "", fmt.Errorf("workload identity: failed to read JWT: %w", err)
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("workload identity: JWT file %s is empty", c.JWTFile)
+	}
+	return token, nil
+}
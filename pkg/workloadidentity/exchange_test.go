@@ -0,0 +1,89 @@
+package workloadidentity
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestSTS starts a stand-in Security Token Service issuing a token for the expected subject token.
+func newTestSTS(t *testing.T, subjectToken string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if r.PostForm.Get("grant_type") != grantTypeTokenExchange ||
+			r.PostForm.Get("requested_token_type") != tokenTypeAccessToken ||
+			r.PostForm.Get("subject_token_type") != tokenTypeIDToken ||
+			r.PostForm.Get("audience") != "aje1234567890" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_request","error_description":"unexpected parameters"}`))
+			return
+		}
+		if r.PostForm.Get("subject_token") != subjectToken {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_grant","error_description":"subject token is not trusted"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"access_token":"t1.exchanged","issued_token_type":"urn:ietf:params:oauth:token-type:access_token","token_type":"Bearer","expires_in":3600}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestExchangeJWTFile(t *testing.T) {
+	server := newTestSTS(t, "header.payload.signature")
+	jwtFile := filepath.Join(t.TempDir(), "jwt")
+	require.NoError(t, os.WriteFile(jwtFile, []byte("header.payload.signature\n"), 0600))
+
+	config := Config{ServiceAccountID: "aje1234567890", JWTFile: jwtFile, Endpoint: server.URL}
+	require.NoError(t, config.Validate())
+
+	token, expiresAt, err := config.Exchange(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "t1.exchanged", token)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), expiresAt, time.Minute)
+}
+
+func TestExchangeJWTEnv(t *testing.T) {
+	server := newTestSTS(t, "header.payload.signature")
+	t.Setenv("TEST_CI_JOB_JWT", "header.payload.signature")
+
+	config := Config{ServiceAccountID: "aje1234567890", JWTEnv: "TEST_CI_JOB_JWT", Endpoint: server.URL}
+	token, _, err := config.Exchange(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "t1.exchanged", token)
+}
+
+func TestExchangeRejected(t *testing.T) {
+	server := newTestSTS(t, "header.payload.signature")
+	t.Setenv("TEST_CI_JOB_JWT", "other.payload.signature")
+
+	config := Config{ServiceAccountID: "aje1234567890", JWTEnv: "TEST_CI_JOB_JWT", Endpoint: server.URL}
+	_, _, err := config.Exchange(context.Background())
+	assert.EqualError(t, err, "workload identity: token exchange failed with status 400: invalid_grant: subject token is not trusted")
+}
+
+func TestExchangeEmptyJWT(t *testing.T) {
+	t.Setenv("TEST_CI_JOB_JWT", "")
+
+	config := Config{ServiceAccountID: "aje1234567890", JWTEnv: "TEST_CI_JOB_JWT", Endpoint: "http://127.0.0.1:0"}
+	_, _, err := config.Exchange(context.Background())
+	assert.EqualError(t, err, "workload identity: environment variable TEST_CI_JOB_JWT is empty")
+}
+
+func TestConfigValidate(t *testing.T) {
+	assert.Error(t, (&Config{JWTFile: "jwt"}).Validate())
+	assert.Error(t, (&Config{ServiceAccountID: "aje1234567890"}).Validate())
+	assert.Error(t, (&Config{ServiceAccountID: "aje1234567890", JWTFile: "jwt", JWTEnv: "JWT"}).Validate())
+	assert.NoError(t, (&Config{ServiceAccountID: "aje1234567890", JWTEnv: "JWT"}).Validate())
+}
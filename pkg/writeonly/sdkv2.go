@@ -0,0 +1,64 @@
+package writeonly
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// SDKSchema is the SDKv2 version of Attributes.
+func SDKSchema(attr string) map[string]*sdkschema.Schema {
+	return map[string]*sdkschema.Schema{
+		Name(attr): {
+			Type:          sdkschema.TypeString,
+			Description:   description(attr),
+			Optional:      true,
+			Sensitive:     true,
+			WriteOnly:     true,
+			ConflictsWith: []string{attr},
+		},
+		VersionName(attr): {
+			Type:         sdkschema.TypeInt,
+			Description:  versionDescription(attr),
+			Optional:     true,
+			RequiredWith: []string{Name(attr)},
+		},
+	}
+}
+
+// SDKAddSchema adds schemas returned by SDKSchema to s.
+func SDKAddSchema(s map[string]*sdkschema.Schema, attr string) map[string]*sdkschema.Schema {
+	for name, sch := range SDKSchema(attr) {
+		s[name] = sch
+	}
+	return s
+}
+
+// SDKGetString is the SDKv2 version of GetString. It returns an empty string
+// if the write-only counterpart of attr is not set.
+func SDKGetString(d *sdkschema.ResourceData, attr string) (string, error) {
+	value, diags := d.GetRawConfigAt(cty.GetAttrPath(Name(attr)))
+	if diags.HasError() {
+		return "", fmt.Errorf("error reading %q from configuration: %v", Name(attr), diags)
+	}
+
+	if value.IsNull() || !value.IsKnown() || !value.Type().Equals(cty.String) {
+		return "", nil
+	}
+	return value.AsString(), nil
+}
+
+// SDKResolve is the SDKv2 version of Resolve.
+func SDKResolve(d *sdkschema.ResourceData, attr string, value string) (string, error) {
+	wo, err := SDKGetString(d, attr)
+	if err != nil || wo == "" {
+		return value, err
+	}
+	return wo, nil
+}
+
+// SDKVersionChanged is the SDKv2 version of VersionChanged.
+func SDKVersionChanged(d *sdkschema.ResourceData, attr string) bool {
+	return d.HasChange(VersionName(attr))
+}
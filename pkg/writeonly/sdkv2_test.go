@@ -0,0 +1,103 @@
+package writeonly
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSDKSchema = SDKAddSchema(map[string]*sdkschema.Schema{
+	"password": {
+		Type:      sdkschema.TypeString,
+		Optional:  true,
+		Sensitive: true,
+	},
+}, "password")
+
+func TestSDKSchema(t *testing.T) {
+	wo := testSDKSchema["password_wo"]
+	require.NotNil(t, wo)
+	assert.True(t, wo.WriteOnly)
+	assert.True(t, wo.Sensitive)
+	assert.Equal(t, []string{"password"}, wo.ConflictsWith)
+
+	version := testSDKSchema["password_wo_version"]
+	require.NotNil(t, version)
+	assert.Equal(t, sdkschema.TypeInt, version.Type)
+	assert.Equal(t, []string{"password_wo"}, version.RequiredWith)
+}
+
+func TestSDKResolve(t *testing.T) {
+	tests := []struct {
+		name     string
+		wo       cty.Value
+		value    string
+		expected string
+	}{
+		{
+			name:     "write-only is not set",
+			wo:       cty.NullVal(cty.String),
+			value:    "plain",
+			expected: "plain",
+		},
+		{
+			name:     "write-only is set",
+			wo:       cty.StringVal("secret"),
+			expected: "secret",
+		},
+		{
+			name:     "write-only is unknown",
+			wo:       cty.UnknownVal(cty.String),
+			value:    "plain",
+			expected: "plain",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Write-only values are only available in the raw configuration.
+			d := (&sdkschema.Resource{Schema: testSDKSchema}).Data(&terraform.InstanceState{
+				RawConfig: cty.ObjectVal(map[string]cty.Value{
+					"id":                  cty.NullVal(cty.String),
+					"password":            cty.NullVal(cty.String),
+					"password_wo":         tt.wo,
+					"password_wo_version": cty.NullVal(cty.Number),
+				}),
+			})
+
+			value, err := SDKResolve(d, "password", tt.value)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, value)
+		})
+	}
+}
+
+func TestSDKVersionChanged(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      map[string]interface{}
+		expected bool
+	}{
+		{
+			name:     "no version",
+			raw:      map[string]interface{}{"password": "plain"},
+			expected: false,
+		},
+		{
+			name:     "version is set",
+			raw:      map[string]interface{}{"password_wo_version": 1},
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := sdkschema.TestResourceDataRaw(t, testSDKSchema, tt.raw)
+			assert.Equal(t, tt.expected, SDKVersionChanged(d, "password"))
+		})
+	}
+}
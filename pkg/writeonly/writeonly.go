@@ -0,0 +1,111 @@
+// Package writeonly contains helpers for write-only arguments.
+//
+// A write-only argument is sent to the API on create or update but is never
+// persisted to the plan or state. Since Terraform can not detect changes of
+// such argument, every write-only argument `<name>_wo` is accompanied by a
+// `<name>_wo_version` trigger: changing the trigger makes the provider send
+// the write-only value to the API again.
+package writeonly
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	suffix        = "_wo"
+	versionSuffix = "_wo_version"
+)
+
+// Name returns the name of the write-only counterpart of attr.
+func Name(attr string) string {
+	return attr + suffix
+}
+
+// VersionName returns the name of the trigger attribute of the write-only counterpart of attr.
+func VersionName(attr string) string {
+	return attr + versionSuffix
+}
+
+func description(attr string) string {
+	return fmt.Sprintf("Write-only counterpart of `%s`. The value is sent to the API but never stored in the plan or state. "+
+		"Requires Terraform v1.11 or later. Conflicts with `%s`.", attr, attr)
+}
+
+func versionDescription(attr string) string {
+	return fmt.Sprintf("Trigger for `%s`. Change this value to send the current value of `%s` to the API.", Name(attr), Name(attr))
+}
+
+// Attributes returns schema attributes for the write-only counterpart of attr
+// and its version trigger. The write-only attribute conflicts with attr.
+func Attributes(attr string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		Name(attr): schema.StringAttribute{
+			MarkdownDescription: description(attr),
+			Optional:            true,
+			Sensitive:           true,
+			WriteOnly:           true,
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRoot(attr)),
+			},
+		},
+		VersionName(attr): schema.Int64Attribute{
+			MarkdownDescription: versionDescription(attr),
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AlsoRequires(path.MatchRoot(Name(attr))),
+			},
+		},
+	}
+}
+
+// AddAttributes adds attributes returned by Attributes to attrs.
+func AddAttributes(attrs map[string]schema.Attribute, attr string) map[string]schema.Attribute {
+	for name, a := range Attributes(attr) {
+		attrs[name] = a
+	}
+	return attrs
+}
+
+// GetString reads the write-only counterpart of attr from the configuration.
+// Write-only values are available only in the configuration, plan and state
+// always contain null for them.
+func GetString(ctx context.Context, config tfsdk.Config, attr string) (types.String, diag.Diagnostics) {
+	var value types.String
+	diags := config.GetAttribute(ctx, path.Root(Name(attr)), &value)
+	return value, diags
+}
+
+// Resolve returns the value of the write-only counterpart of attr if it is
+// set in the configuration and value otherwise.
+func Resolve(ctx context.Context, config tfsdk.Config, attr string, value types.String) (types.String, diag.Diagnostics) {
+	wo, diags := GetString(ctx, config, attr)
+	if diags.HasError() || wo.IsNull() || wo.IsUnknown() {
+		return value, diags
+	}
+	return wo, diags
+}
+
+// VersionChanged reports whether the trigger of the write-only counterpart of
+// attr differs between plan and state, i.e. the write-only value has to be
+// sent to the API on update.
+func VersionChanged(ctx context.Context, plan tfsdk.Plan, state tfsdk.State, attr string) (bool, diag.Diagnostics) {
+	var planVersion, stateVersion types.Int64
+
+	diags := plan.GetAttribute(ctx, path.Root(VersionName(attr)), &planVersion)
+	diags.Append(state.GetAttribute(ctx, path.Root(VersionName(attr)), &stateVersion)...)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	return !planVersion.Equal(stateVersion), diags
+}
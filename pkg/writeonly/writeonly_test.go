@@ -0,0 +1,139 @@
+package writeonly
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSchema = schema.Schema{
+	Attributes: AddAttributes(map[string]schema.Attribute{
+		"password": schema.StringAttribute{
+			Optional:  true,
+			Sensitive: true,
+		},
+	}, "password"),
+}
+
+func testRaw(password, wo, version tftypes.Value) tftypes.Value {
+	return tftypes.NewValue(testSchema.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"password":            password,
+		"password_wo":         wo,
+		"password_wo_version": version,
+	})
+}
+
+var (
+	nullString    = tftypes.NewValue(tftypes.String, nil)
+	unknownString = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	nullNumber    = tftypes.NewValue(tftypes.Number, nil)
+)
+
+func TestNames(t *testing.T) {
+	assert.Equal(t, "password_wo", Name("password"))
+	assert.Equal(t, "password_wo_version", VersionName("password"))
+	assert.Contains(t, testSchema.Attributes, "password_wo")
+	assert.Contains(t, testSchema.Attributes, "password_wo_version")
+	assert.True(t, testSchema.Attributes["password_wo"].IsWriteOnly())
+	assert.True(t, testSchema.Attributes["password_wo"].IsSensitive())
+}
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		name     string
+		password tftypes.Value
+		wo       tftypes.Value
+		expected types.String
+	}{
+		{
+			name:     "write-only is not set",
+			password: tftypes.NewValue(tftypes.String, "plain"),
+			wo:       nullString,
+			expected: types.StringValue("plain"),
+		},
+		{
+			name:     "write-only is set",
+			password: nullString,
+			wo:       tftypes.NewValue(tftypes.String, "secret"),
+			expected: types.StringValue("secret"),
+		},
+		{
+			name:     "write-only is unknown",
+			password: nullString,
+			wo:       unknownString,
+			expected: types.StringNull(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			config := tfsdk.Config{Schema: testSchema, Raw: testRaw(tt.password, tt.wo, nullNumber)}
+
+			var password types.String
+			require.False(t, config.GetAttribute(ctx, path.Root("password"), &password).HasError())
+
+			value, diags := Resolve(ctx, config, "password", password)
+			require.False(t, diags.HasError(), diags)
+			assert.Equal(t, tt.expected, value)
+		})
+	}
+}
+
+func TestVersionChanged(t *testing.T) {
+	tests := []struct {
+		name     string
+		plan     tftypes.Value
+		state    tftypes.Value
+		expected bool
+	}{
+		{
+			name:     "no version",
+			plan:     nullNumber,
+			state:    nullNumber,
+			expected: false,
+		},
+		{
+			name:     "same version",
+			plan:     tftypes.NewValue(tftypes.Number, 1),
+			state:    tftypes.NewValue(tftypes.Number, 1),
+			expected: false,
+		},
+		{
+			name:     "version increased",
+			plan:     tftypes.NewValue(tftypes.Number, 2),
+			state:    tftypes.NewValue(tftypes.Number, 1),
+			expected: true,
+		},
+		{
+			name:     "version added",
+			plan:     tftypes.NewValue(tftypes.Number, 1),
+			state:    nullNumber,
+			expected: true,
+		},
+		{
+			name:     "version removed",
+			plan:     nullNumber,
+			state:    tftypes.NewValue(tftypes.Number, 1),
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := tfsdk.Plan{Schema: testSchema, Raw: testRaw(nullString, nullString, tt.plan)}
+			state := tfsdk.State{Schema: testSchema, Raw: testRaw(nullString, nullString, tt.state)}
+
+			changed, diags := VersionChanged(context.Background(), plan, state, "password")
+			require.False(t, diags.HasError(), diags)
+			assert.Equal(t, tt.expected, changed)
+		})
+	}
+}
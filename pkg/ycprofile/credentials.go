@@ -0,0 +1,71 @@
+package ycprofile
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+	"github.com/yandex-cloud/go-sdk/iamkey"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// federationTokenLifetime is assumed for IAM tokens of federated accounts issued by yc CLI,
+// which does not report their expiration time.
+const federationTokenLifetime = time.Hour
+
+// Credentials returns SDK credentials of the profile or nil if the profile has no credentials.
+func (p *Profile) Credentials() (ycsdk.Credentials, error) {
+	switch {
+	case len(p.ServiceAccountKey) > 0:
+		content, err := p.serviceAccountKeyJSON()
+		if err != nil {
+			return nil, err
+		}
+		key := &iamkey.Key{}
+		if err := json.Unmarshal([]byte(content), key); err != nil {
+			return nil, fmt.Errorf("invalid service account key of yc CLI profile %q: %w", p.Name, err)
+		}
+		return ycsdk.ServiceAccountKey(key)
+	case p.Token != "":
+		if strings.HasPrefix(p.Token, "t1.") && strings.Count(p.Token, ".") == 2 {
+			return ycsdk.NewIAMTokenCredentials(p.Token), nil
+		}
+		return ycsdk.OAuthToken(p.Token), nil
+	case p.FederationID != "":
+		return &federationCredentials{profile: p.Name}, nil
+	case p.InstanceServiceAccount:
+		return ycsdk.InstanceServiceAccount(), nil
+	}
+	return nil, nil
+}
+
+// federationCredentials issue IAM tokens of the federated account with yc CLI, since
+// the browser based login of the federation can not be done by the provider.
+type federationCredentials struct {
+	profile string
+}
+
+var _ ycsdk.NonExchangeableCredentials = &federationCredentials{}
+
+func (c *federationCredentials) YandexCloudAPICredentials() {}
+
+func (c *federationCredentials) IAMToken(ctx context.Context) (*iam.CreateIamTokenResponse, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "yc", "iam", "create-token", "--profile", c.profile)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("failed to create IAM token with yc CLI profile %q: %w: %s", c.profile, err, strings.TrimSpace(stderr.String()))
+	}
+
+	return &iam.CreateIamTokenResponse{
+		IamToken:  strings.TrimSpace(stdout.String()),
+		ExpiresAt: timestamppb.New(time.Now().Add(federationTokenLifetime)),
+	}, nil
+}
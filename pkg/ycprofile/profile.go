@@ -0,0 +1,142 @@
+package ycprofile
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Profile is a profile of the yc CLI configuration, see `yc config profile list`.
+type Profile struct {
+	Name string `yaml:"-"`
+
+	Token                  string                 `yaml:"token"`
+	ServiceAccountKey      map[string]interface{} `yaml:"service-account-key"`
+	FederationID           string                 `yaml:"federation-id"`
+	InstanceServiceAccount bool                   `yaml:"instance-service-account"`
+
+	CloudID        string `yaml:"cloud-id"`
+	FolderID       string `yaml:"folder-id"`
+	OrganizationID string `yaml:"organization-id"`
+	Endpoint       string `yaml:"endpoint"`
+	Zone           string `yaml:"compute-default-zone"`
+}
+
+// Config is the yc CLI configuration file.
+type Config struct {
+	Current  string              `yaml:"current"`
+	Profiles map[string]*Profile `yaml:"profiles"`
+}
+
+// DefaultConfigPath returns path of the yc CLI configuration file.
+func DefaultConfigPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".config", "yandex-cloud", "config.yaml")
+}
+
+// IsConfigFile reports whether the file is yc CLI configuration rather than INI shared credentials file.
+func IsConfigFile(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	return ext == ".yaml" || ext == ".yml"
+}
+
+// Load reads yc CLI configuration file.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read yc CLI config: %w", err)
+	}
+
+	config := &Config{}
+	if err := yaml.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("failed to parse yc CLI config %s: %w", path, err)
+	}
+	for name, profile := range config.Profiles {
+		if profile == nil {
+			profile = &Profile{}
+			config.Profiles[name] = profile
+		}
+		profile.Name = name
+	}
+	return config, nil
+}
+
+// Profile returns profile with the given name or the current profile of yc CLI if the name is empty.
+func (c *Config) Profile(name string) (*Profile, error) {
+	if name == "" {
+		name = c.Current
+	}
+	profile, ok := c.Profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("profile %q is not found in yc CLI config", name)
+	}
+	return profile, nil
+}
+
+// Resolve returns the yc CLI profile to configure the provider with. The profile is read from
+// sharedCredentialsFile when it is a yc CLI config, or from the default yc CLI config when only
+// the profile name is set. The current profile of yc CLI is used when the name is empty.
+// It returns an empty profile when the provider is not configured with a yc CLI profile. Since the
+// profile name may belong to the INI shared credentials file, a profile missing in the default yc CLI
+// config is only reported with a warning.
+func Resolve(sharedCredentialsFile, profile string) (*Profile, error) {
+	path := sharedCredentialsFile
+	switch {
+	case path != "" && !IsConfigFile(path):
+		return &Profile{}, nil
+	case path == "" && profile == "":
+		return &Profile{}, nil
+	case path == "":
+		path = DefaultConfigPath()
+		if _, err := os.Stat(path); err != nil {
+			// Profile of the INI shared credentials file may be set without the file itself.
+			log.Printf("[WARN] Profile %q is set, but yc CLI config %s is not available: %s", profile, path, err)
+			return &Profile{}, nil
+		}
+	}
+
+	config, err := Load(path)
+	if err != nil {
+		return nil, err
+	}
+	p, err := config.Profile(profile)
+	if err != nil && sharedCredentialsFile == "" {
+		log.Printf("[WARN] %s, it is not used to configure the provider", err)
+		return &Profile{}, nil
+	}
+	return p, err
+}
+
+// HasCredentials reports whether the profile defines authentication method.
+func (p *Profile) HasCredentials() bool {
+	return p.Token != "" || len(p.ServiceAccountKey) > 0 || p.FederationID != "" || p.InstanceServiceAccount
+}
+
+// serviceAccountKeyJSON returns authorized key of the profile in the format of the service account key file.
+func (p *Profile) serviceAccountKeyJSON() (string, error) {
+	if len(p.ServiceAccountKey) == 0 {
+		return "", nil
+	}
+	data, err := json.Marshal(p.ServiceAccountKey)
+	if err != nil {
+		return "", fmt.Errorf("invalid service account key of yc CLI profile %q: %w", p.Name, err)
+	}
+	return string(data), nil
+}
+
+// Or returns value if it is not empty, otherwise it returns def. It is used to take settings of the profile
+// only when they are not specified in the provider configuration or environment.
+func Or(value, def string) string {
+	if value != "" {
+		return value
+	}
+	return def
+}
@@ -0,0 +1,117 @@
+package ycprofile
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testConfig = `current: dev
+profiles:
+  dev:
+    token: y0_dev-oauth-token
+    cloud-id: b1gdev
+    folder-id: b1gdevfolder
+    compute-default-zone: ru-central1-a
+  prod:
+    service-account-key:
+      id: ajekey
+      service_account_id: ajesa
+      key_algorithm: RSA_2048
+      private_key: PRIVATE
+    endpoint: api.cloud.yandex.net:443
+    folder-id: b1gprodfolder
+  federated:
+    federation-id: bpffederation
+  empty:
+`
+
+func writeConfig(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestLoadProfiles(t *testing.T) {
+	config, err := Load(writeConfig(t, "config.yaml", testConfig))
+	require.NoError(t, err)
+
+	current, err := config.Profile("")
+	require.NoError(t, err)
+	assert.Equal(t, "dev", current.Name)
+	assert.Equal(t, "y0_dev-oauth-token", current.Token)
+	assert.Equal(t, "b1gdev", current.CloudID)
+	assert.Equal(t, "b1gdevfolder", current.FolderID)
+	assert.Equal(t, "ru-central1-a", current.Zone)
+
+	prod, err := config.Profile("prod")
+	require.NoError(t, err)
+	assert.Equal(t, "api.cloud.yandex.net:443", prod.Endpoint)
+	assert.True(t, prod.HasCredentials())
+	key, err := prod.serviceAccountKeyJSON()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":"ajekey","service_account_id":"ajesa","key_algorithm":"RSA_2048","private_key":"PRIVATE"}`, key)
+
+	federated, err := config.Profile("federated")
+	require.NoError(t, err)
+	assert.True(t, federated.HasCredentials())
+
+	empty, err := config.Profile("empty")
+	require.NoError(t, err)
+	assert.Equal(t, "empty", empty.Name)
+	assert.False(t, empty.HasCredentials())
+
+	_, err = config.Profile("missing")
+	assert.Error(t, err)
+}
+
+func TestResolve(t *testing.T) {
+	path := writeConfig(t, "config.yaml", testConfig)
+
+	profile, err := Resolve(path, "prod")
+	require.NoError(t, err)
+	assert.Equal(t, "b1gprodfolder", profile.FolderID)
+
+	profile, err = Resolve(path, "")
+	require.NoError(t, err)
+	assert.Equal(t, "dev", profile.Name)
+
+	// INI shared credentials file only provides storage keys.
+	profile, err = Resolve(writeConfig(t, "credentials", "[default]\nstorage_access_key = key\n"), "default")
+	require.NoError(t, err)
+	assert.Equal(t, &Profile{}, profile)
+
+	profile, err = Resolve("", "")
+	require.NoError(t, err)
+	assert.Equal(t, &Profile{}, profile)
+
+	_, err = Resolve(writeConfig(t, "broken.yaml", "profiles: ["), "")
+	assert.Error(t, err)
+
+	_, err = Resolve(path, "missing")
+	assert.Error(t, err)
+}
+
+func TestResolveDefaultConfig(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	require.NoError(t, os.MkdirAll(filepath.Join(home, ".config", "yandex-cloud"), 0700))
+	require.NoError(t, os.WriteFile(DefaultConfigPath(), []byte(testConfig), 0600))
+
+	profile, err := Resolve("", "prod")
+	require.NoError(t, err)
+	assert.Equal(t, "b1gprodfolder", profile.FolderID)
+
+	// The profile may belong to the INI shared credentials file, so it is not required in the yc CLI config.
+	profile, err = Resolve("", "missing")
+	require.NoError(t, err)
+	assert.Equal(t, &Profile{}, profile)
+}
+
+func TestOr(t *testing.T) {
+	assert.Equal(t, "value", Or("value", "default"))
+	assert.Equal(t, "default", Or("", "default"))
+}
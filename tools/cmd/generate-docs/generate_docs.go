@@ -242,7 +242,7 @@ func regroupTemplatesFiles(templatesDir, tmpDir string, categories categories.Ca
 			return fmt.Errorf("failed to read file %s: %w", path, err)
 		}
 		// We are checking that cats are registered
-		if strings.HasPrefix(filename, "d_") || strings.HasPrefix(filename, "r_") {
+		if strings.HasPrefix(filename, "d_") || strings.HasPrefix(filename, "r_") || strings.HasPrefix(filename, "e_") || strings.HasPrefix(filename, "f_") || strings.HasPrefix(filename, "l_") {
 			cat, err := extractSubcategory(data)
 			if err != nil {
 				log.Printf("Failed to extract subcategory for %s", path)
@@ -261,6 +261,12 @@ func regroupTemplatesFiles(templatesDir, tmpDir string, categories categories.Ca
 			file = filepath.Join(tmpDir, "data-sources", filename[2:])
 		} else if strings.HasPrefix(filename, "r_") {
 			file = filepath.Join(tmpDir, "resources", filename[2:])
+		} else if strings.HasPrefix(filename, "e_") {
+			file = filepath.Join(tmpDir, "ephemeral-resources", filename[2:])
+		} else if strings.HasPrefix(filename, "f_") {
+			file = filepath.Join(tmpDir, "functions", filename[2:])
+		} else if strings.HasPrefix(filename, "l_") {
+			file = filepath.Join(tmpDir, "list-resources", filename[2:])
 		} else if filename == "index.md.tmpl" {
 			file = filepath.Join(tmpDir, filename)
 			err = os.WriteFile(file, data, os.FileMode(0644))
@@ -455,6 +461,24 @@ func main() {
 		return
 	}
 
+	ephemeralResourceDir := filepath.Join(tmpDir, "ephemeral-resources")
+	if err := os.MkdirAll(ephemeralResourceDir, os.ModePerm); err != nil {
+		log.Fatalln("Unable to create temporary dir ephemeral-resources")
+		return
+	}
+
+	functionDir := filepath.Join(tmpDir, "functions")
+	if err := os.MkdirAll(functionDir, os.ModePerm); err != nil {
+		log.Fatalln("Unable to create temporary dir functions")
+		return
+	}
+
+	listResourceDir := filepath.Join(tmpDir, "list-resources")
+	if err := os.MkdirAll(listResourceDir, os.ModePerm); err != nil {
+		log.Fatalln("Unable to create temporary dir list-resources")
+		return
+	}
+
 	defer os.RemoveAll(tmpDir)
 
 	var categories_ categories.Categories
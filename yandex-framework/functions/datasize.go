@@ -0,0 +1,91 @@
+package functions
+
+import (
+	"context"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/datasize"
+)
+
+type bytesToGBFunction struct{}
+
+func NewBytesToGBFunction() function.Function {
+	return &bytesToGBFunction{}
+}
+
+func (f *bytesToGBFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "bytes_to_gb"
+}
+
+func (f *bytesToGBFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Convert bytes to gigabytes.",
+		MarkdownDescription: "Converts size in bytes to gigabytes (2^30 bytes) the same way the provider does for disk and storage sizes. The result is rounded down.",
+		Parameters: []function.Parameter{
+			function.Int64Parameter{
+				Name:                "bytes",
+				MarkdownDescription: "Size in bytes.",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *bytesToGBFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var bytes int64
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &bytes))
+	if resp.Error != nil {
+		return
+	}
+
+	if bytes < 0 {
+		resp.Error = function.NewArgumentFuncError(0, "Size must not be negative")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, datasize.ToGigabytes(bytes)))
+}
+
+type gbToBytesFunction struct{}
+
+func NewGBToBytesFunction() function.Function {
+	return &gbToBytesFunction{}
+}
+
+func (f *gbToBytesFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "gb_to_bytes"
+}
+
+func (f *gbToBytesFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Convert gigabytes to bytes.",
+		MarkdownDescription: "Converts size in gigabytes (2^30 bytes) to bytes the same way the provider does for disk and storage sizes.",
+		Parameters: []function.Parameter{
+			function.Int64Parameter{
+				Name:                "gb",
+				MarkdownDescription: "Size in gigabytes.",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *gbToBytesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var gb int64
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &gb))
+	if resp.Error != nil {
+		return
+	}
+
+	if gb < 0 {
+		resp.Error = function.NewArgumentFuncError(0, "Size must not be negative")
+		return
+	}
+	if gb > math.MaxInt64>>30 {
+		resp.Error = function.NewArgumentFuncError(0, "Size is too large")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, datasize.ToBytes(gb)))
+}
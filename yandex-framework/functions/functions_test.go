@@ -0,0 +1,202 @@
+package functions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/functions"
+)
+
+type testCase struct {
+	name      string
+	args      []attr.Value
+	expected  attr.Value
+	expectErr bool
+}
+
+func runFunction(t *testing.T, f function.Function, result attr.Value, cases []testCase) {
+	t.Helper()
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := function.RunResponse{
+				Result: function.NewResultData(result),
+			}
+			f.Run(context.Background(), function.RunRequest{
+				Arguments: function.NewArgumentsData(tc.args),
+			}, &resp)
+
+			if tc.expectErr {
+				assert.NotNil(t, resp.Error)
+				return
+			}
+			if !assert.Nil(t, resp.Error) {
+				return
+			}
+			assert.True(t, tc.expected.Equal(resp.Result.Value()), "expected %s, got %s", tc.expected, resp.Result.Value())
+		})
+	}
+}
+
+func TestParseVirtualHostID(t *testing.T) {
+	attrTypes := map[string]attr.Type{
+		"http_router_id": types.StringType,
+		"name":           types.StringType,
+	}
+
+	runFunction(t, functions.NewParseVirtualHostIDFunction(), types.ObjectUnknown(attrTypes), []testCase{
+		{
+			name: "valid",
+			args: []attr.Value{types.StringValue("ds7abc123/my-host")},
+			expected: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+				"http_router_id": types.StringValue("ds7abc123"),
+				"name":           types.StringValue("my-host"),
+			}),
+		},
+		{
+			name:      "no separator",
+			args:      []attr.Value{types.StringValue("ds7abc123")},
+			expectErr: true,
+		},
+		{
+			name:      "too many parts",
+			args:      []attr.Value{types.StringValue("ds7abc123/my-host/route")},
+			expectErr: true,
+		},
+		{
+			name:      "empty name",
+			args:      []attr.Value{types.StringValue("ds7abc123/")},
+			expectErr: true,
+		},
+	})
+}
+
+func TestParseClusterResourceID(t *testing.T) {
+	attrTypes := map[string]attr.Type{
+		"cluster_id": types.StringType,
+		"name":       types.StringType,
+	}
+
+	runFunction(t, functions.NewParseClusterResourceIDFunction(), types.ObjectUnknown(attrTypes), []testCase{
+		{
+			name: "valid",
+			args: []attr.Value{types.StringValue("c9qabc123:alice")},
+			expected: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+				"cluster_id": types.StringValue("c9qabc123"),
+				"name":       types.StringValue("alice"),
+			}),
+		},
+		{
+			name: "name with separator",
+			args: []attr.Value{types.StringValue("c9qabc123:topic:1")},
+			expected: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+				"cluster_id": types.StringValue("c9qabc123"),
+				"name":       types.StringValue("topic:1"),
+			}),
+		},
+		{
+			name:      "no separator",
+			args:      []attr.Value{types.StringValue("c9qabc123")},
+			expectErr: true,
+		},
+	})
+}
+
+func TestParseIAMMemberID(t *testing.T) {
+	attrTypes := map[string]attr.Type{
+		"resource_id": types.StringType,
+		"role":        types.StringType,
+		"member":      types.StringType,
+	}
+
+	runFunction(t, functions.NewParseIAMMemberIDFunction(), types.ObjectUnknown(attrTypes), []testCase{
+		{
+			name: "valid",
+			args: []attr.Value{types.StringValue("b1gabc123/editor/serviceAccount:aje123")},
+			expected: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+				"resource_id": types.StringValue("b1gabc123"),
+				"role":        types.StringValue("editor"),
+				"member":      types.StringValue("serviceAccount:aje123"),
+			}),
+		},
+		{
+			name:      "no member",
+			args:      []attr.Value{types.StringValue("b1gabc123/editor")},
+			expectErr: true,
+		},
+		{
+			name:      "member without type",
+			args:      []attr.Value{types.StringValue("b1gabc123/editor/aje123")},
+			expectErr: true,
+		},
+	})
+}
+
+func TestZoneToRegion(t *testing.T) {
+	runFunction(t, functions.NewZoneToRegionFunction(), types.StringUnknown(), []testCase{
+		{
+			name:     "ru-central1",
+			args:     []attr.Value{types.StringValue("ru-central1-a")},
+			expected: types.StringValue("ru-central1"),
+		},
+		{
+			name:     "kz1",
+			args:     []attr.Value{types.StringValue("kz1-a")},
+			expected: types.StringValue("kz1"),
+		},
+		{
+			name:      "no zone letter",
+			args:      []attr.Value{types.StringValue("ru-central1-")},
+			expectErr: true,
+		},
+		{
+			name:      "region",
+			args:      []attr.Value{types.StringValue("central")},
+			expectErr: true,
+		},
+	})
+}
+
+func TestBytesToGB(t *testing.T) {
+	runFunction(t, functions.NewBytesToGBFunction(), types.Int64Unknown(), []testCase{
+		{
+			name:     "exact",
+			args:     []attr.Value{types.Int64Value(10737418240)},
+			expected: types.Int64Value(10),
+		},
+		{
+			name:     "rounded down",
+			args:     []attr.Value{types.Int64Value(1610612736)},
+			expected: types.Int64Value(1),
+		},
+		{
+			name:      "negative",
+			args:      []attr.Value{types.Int64Value(-1)},
+			expectErr: true,
+		},
+	})
+}
+
+func TestGBToBytes(t *testing.T) {
+	runFunction(t, functions.NewGBToBytesFunction(), types.Int64Unknown(), []testCase{
+		{
+			name:     "valid",
+			args:     []attr.Value{types.Int64Value(10)},
+			expected: types.Int64Value(10737418240),
+		},
+		{
+			name:      "negative",
+			args:      []attr.Value{types.Int64Value(-1)},
+			expectErr: true,
+		},
+		{
+			name:      "overflow",
+			args:      []attr.Value{types.Int64Value(1 << 40)},
+			expectErr: true,
+		},
+	})
+}
@@ -0,0 +1,68 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
+)
+
+var clusterResourceIDAttrTypes = map[string]attr.Type{
+	"cluster_id": types.StringType,
+	"name":       types.StringType,
+}
+
+type clusterResourceID struct {
+	ClusterID types.String `tfsdk:"cluster_id"`
+	Name      types.String `tfsdk:"name"`
+}
+
+type parseClusterResourceIDFunction struct{}
+
+func NewParseClusterResourceIDFunction() function.Function {
+	return &parseClusterResourceIDFunction{}
+}
+
+func (f *parseClusterResourceIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_cluster_resource_id"
+}
+
+func (f *parseClusterResourceIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse ID of the resource nested in a managed database cluster.",
+		MarkdownDescription: "Parses ID of the resource nested in a managed database cluster (e.g. `yandex_mdb_mongodb_user` or `yandex_mdb_clickhouse_database`) " +
+			"in format `<cluster_id>:<name>` and returns an object with `cluster_id` and `name` attributes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "id",
+				MarkdownDescription: "ID of the resource.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: clusterResourceIDAttrTypes,
+		},
+	}
+}
+
+func (f *parseClusterResourceIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &id))
+	if resp.Error != nil {
+		return
+	}
+
+	clusterID, name, err := resourceid.Deconstruct(id)
+	if err != nil || clusterID == "" || name == "" {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid resource id %q, expected format is <cluster_id>:<name>", id))
+		return
+	}
+
+	result := clusterResourceID{
+		ClusterID: types.StringValue(clusterID),
+		Name:      types.StringValue(name),
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, &result))
+}
@@ -0,0 +1,72 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
+)
+
+var iamMemberIDAttrTypes = map[string]attr.Type{
+	"resource_id": types.StringType,
+	"role":        types.StringType,
+	"member":      types.StringType,
+}
+
+type iamMemberID struct {
+	ResourceID types.String `tfsdk:"resource_id"`
+	Role       types.String `tfsdk:"role"`
+	Member     types.String `tfsdk:"member"`
+}
+
+type parseIAMMemberIDFunction struct{}
+
+func NewParseIAMMemberIDFunction() function.Function {
+	return &parseIAMMemberIDFunction{}
+}
+
+func (f *parseIAMMemberIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_iam_member_id"
+}
+
+func (f *parseIAMMemberIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse ID of the IAM member resource.",
+		MarkdownDescription: "Parses ID of the IAM member resource (e.g. `yandex_resourcemanager_folder_iam_member`) in format `<resource_id>/<role>/<member>` " +
+			"and returns an object with `resource_id`, `role` and `member` attributes. For folder IAM members `resource_id` is the folder ID.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "id",
+				MarkdownDescription: "ID of the IAM member resource.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: iamMemberIDAttrTypes,
+		},
+	}
+}
+
+func (f *parseIAMMemberIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &id))
+	if resp.Error != nil {
+		return
+	}
+
+	resourceID, role, member, err := resourceid.DeconstructIAMMemberID(id)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid IAM member id %q, expected format is <resource_id>/<role>/<member_type>:<member_id>", id))
+		return
+	}
+
+	result := iamMemberID{
+		ResourceID: types.StringValue(resourceID),
+		Role:       types.StringValue(role),
+		Member:     types.StringValue(member),
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, &result))
+}
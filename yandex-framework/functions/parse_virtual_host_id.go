@@ -0,0 +1,68 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
+)
+
+var virtualHostIDAttrTypes = map[string]attr.Type{
+	"http_router_id": types.StringType,
+	"name":           types.StringType,
+}
+
+type virtualHostID struct {
+	HTTPRouterID types.String `tfsdk:"http_router_id"`
+	Name         types.String `tfsdk:"name"`
+}
+
+type parseVirtualHostIDFunction struct{}
+
+func NewParseVirtualHostIDFunction() function.Function {
+	return &parseVirtualHostIDFunction{}
+}
+
+func (f *parseVirtualHostIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_virtual_host_id"
+}
+
+func (f *parseVirtualHostIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parse ID of the ALB virtual host.",
+		MarkdownDescription: "Parses ID of the `yandex_alb_virtual_host` resource in format `<http_router_id>/<name>` and returns an object with `http_router_id` and `name` attributes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "id",
+				MarkdownDescription: "ID of the ALB virtual host.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: virtualHostIDAttrTypes,
+		},
+	}
+}
+
+func (f *parseVirtualHostIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &id))
+	if resp.Error != nil {
+		return
+	}
+
+	httpRouterID, name, err := resourceid.DeconstructVirtualHostID(id)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid virtual host id %q, expected format is %s", id, resourceid.VirtualHost))
+		return
+	}
+
+	result := virtualHostID{
+		HTTPRouterID: types.StringValue(httpRouterID),
+		Name:         types.StringValue(name),
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, &result))
+}
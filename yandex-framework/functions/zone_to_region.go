@@ -0,0 +1,58 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type zoneToRegionFunction struct{}
+
+func NewZoneToRegionFunction() function.Function {
+	return &zoneToRegionFunction{}
+}
+
+func (f *zoneToRegionFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "zone_to_region"
+}
+
+func (f *zoneToRegionFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Get region of the availability zone.",
+		MarkdownDescription: "Returns ID of the region the availability zone belongs to, e.g. `ru-central1` for `ru-central1-a`. For more information, see [the official documentation](https://yandex.cloud/docs/overview/concepts/geo-scope).",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "zone",
+				MarkdownDescription: "ID of the availability zone.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *zoneToRegionFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var zone string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &zone))
+	if resp.Error != nil {
+		return
+	}
+
+	region, err := zoneToRegion(zone)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, region))
+}
+
+// zoneToRegion strips the zone letter suffix, i.e. "ru-central1-a" becomes "ru-central1".
+func zoneToRegion(zone string) (string, error) {
+	i := strings.LastIndex(zone, "-")
+	if i <= 0 || i == len(zone)-1 {
+		return "", fmt.Errorf("Invalid availability zone %q, expected format is <region>-<zone letter>", zone)
+	}
+	return zone[:i], nil
+}
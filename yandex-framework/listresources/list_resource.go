@@ -0,0 +1,209 @@
+package listresources
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/validate"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+// folderLister pages through the objects of the folder and passes their ids and names
+// to yield until there are no more objects or yield returns false.
+type folderLister func(ctx context.Context, sdk *ycsdk.SDK, folderID string, yield func(id, name string) bool) error
+
+// sdkIterator is implemented by the paging iterators of the go-sdk, e.g. compute.InstanceIterator.
+type sdkIterator[T namedObject] interface {
+	Next() bool
+	Value() T
+	Error() error
+}
+
+type namedObject interface {
+	GetId() string
+	GetName() string
+}
+
+// iterate passes objects of the go-sdk iterator to yield until it returns false.
+func iterate[T namedObject](it sdkIterator[T], yield func(id, name string) bool) error {
+	for it.Next() {
+		v := it.Value()
+		if !yield(v.GetId(), v.GetName()) {
+			return nil
+		}
+	}
+	return it.Error()
+}
+
+// sdkResources returns resources of the SDKv2 provider. List resources of the SDKv2 managed
+// resources are served by the framework provider and use SDKv2 schemas to build listed identities.
+var sdkResources = sync.OnceValue(func() map[string]*sdkschema.Resource {
+	return yandex.NewSDKProvider().ResourcesMap
+})
+
+type folderListResourceModel struct {
+	FolderID types.String `tfsdk:"folder_id"`
+}
+
+// folderListResource lists objects of one type in the folder. Listed objects are
+// identified by plain resource IDs, see resourceid.ID.
+type folderListResource struct {
+	typeName       string
+	objectName     string
+	lister         folderLister
+	sdkResource    *sdkschema.Resource
+	providerConfig *provider_config.Config
+}
+
+// sdkFolderListResource lists objects of a managed resource implemented with SDKv2.
+type sdkFolderListResource struct {
+	*folderListResource
+}
+
+var (
+	_ list.ListResourceWithConfigure    = &folderListResource{}
+	_ list.ListResourceWithConfigure    = sdkFolderListResource{}
+	_ list.ListResourceWithRawV5Schemas = sdkFolderListResource{}
+)
+
+func newFolderListResource(typeName, objectName string, lister folderLister) list.ListResource {
+	return &folderListResource{
+		typeName:   typeName,
+		objectName: objectName,
+		lister:     lister,
+	}
+}
+
+func newSDKFolderListResource(typeName, objectName string, lister folderLister) list.ListResource {
+	return sdkFolderListResource{
+		folderListResource: &folderListResource{
+			typeName:    typeName,
+			objectName:  objectName,
+			lister:      lister,
+			sdkResource: sdkResources()["yandex"+typeName],
+		},
+	}
+}
+
+func (r *folderListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.typeName
+}
+
+func (r *folderListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Lists %s of the folder with identities to import them.", r.objectName),
+		Attributes: map[string]schema.Attribute{
+			"folder_id": schema.StringAttribute{
+				MarkdownDescription: "The folder to list objects in. If it is not provided, the default provider folder is used.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *folderListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func (r *folderListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config folderListResourceModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	folderID, d := validate.FolderID(config.FolderID, &r.providerConfig.ProviderState)
+	if d != nil {
+		diags.Append(d)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		err := r.lister(ctx, r.providerConfig.SDK, folderID, func(id, name string) bool {
+			result := req.NewListResult(ctx)
+			result.DisplayName = name
+			r.setResult(ctx, &result, id, req.IncludeResource)
+			if !push(result) {
+				return false
+			}
+			count++
+			return req.Limit <= 0 || count < req.Limit
+		})
+		if err != nil {
+			result := list.ListResult{}
+			result.Diagnostics.AddError(
+				fmt.Sprintf("Failed to list %s", r.objectName),
+				fmt.Sprintf("Error while listing %s in folder %q: %s", r.objectName, folderID, err),
+			)
+			push(result)
+		}
+	}
+}
+
+// setResult sets identity of the listed object and, if requested, the resource with the object ID.
+// Other resource attributes are populated by Terraform import.
+func (r *folderListResource) setResult(ctx context.Context, result *list.ListResult, id string, includeResource bool) {
+	if r.sdkResource == nil {
+		result.Diagnostics.Append(resourceid.ID.SetIdentity(ctx, result.Identity, id)...)
+		if includeResource {
+			result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("id"), id)...)
+		}
+		return
+	}
+
+	d := r.sdkResource.Data(&terraform.InstanceState{})
+	d.SetId(id)
+	if err := resourceid.ID.SDKSetIdentity(d); err != nil {
+		result.Diagnostics.AddError("Failed to set resource identity", err.Error())
+		return
+	}
+
+	identity, err := d.TfTypeIdentityState()
+	if err != nil {
+		result.Diagnostics.AddError("Failed to set resource identity", err.Error())
+		return
+	}
+	result.Identity.Raw = *identity
+
+	if includeResource {
+		state, err := d.TfTypeResourceState()
+		if err != nil {
+			result.Diagnostics.AddError("Failed to set resource state", err.Error())
+			return
+		}
+		result.Resource.Raw = *state
+	}
+}
+
+// RawV5Schemas provides the schemas of the SDKv2 managed resource, since the framework provider does not serve it.
+func (r sdkFolderListResource) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, resp *list.RawV5SchemaResponse) {
+	resp.ProtoV5Schema = r.sdkResource.ProtoSchema(ctx)()
+	resp.ProtoV5IdentitySchema = r.sdkResource.ProtoIdentitySchema(ctx)()
+}
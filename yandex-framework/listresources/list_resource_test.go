@@ -0,0 +1,61 @@
+package listresources
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testObject struct {
+	id   string
+	name string
+}
+
+func (o testObject) GetId() string   { return o.id }
+func (o testObject) GetName() string { return o.name }
+
+type testIterator struct {
+	objects []testObject
+	next    int
+	err     error
+}
+
+func (it *testIterator) Next() bool {
+	if it.next >= len(it.objects) {
+		return false
+	}
+	it.next++
+	return true
+}
+
+func (it *testIterator) Value() testObject { return it.objects[it.next-1] }
+func (it *testIterator) Error() error      { return it.err }
+
+func TestIterate(t *testing.T) {
+	objects := []testObject{{"id1", "first"}, {"id2", "second"}, {"id3", "third"}}
+
+	var names []string
+	err := iterate[testObject](&testIterator{objects: objects}, func(id, name string) bool {
+		names = append(names, name)
+		return true
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"first", "second", "third"}, names)
+
+	var ids []string
+	it := &testIterator{objects: objects}
+	err = iterate[testObject](it, func(id, name string) bool {
+		ids = append(ids, id)
+		return len(ids) < 2
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"id1", "id2"}, ids)
+	assert.Equal(t, 2, it.next, "iteration must stop once yield returns false")
+
+	err = iterate[testObject](&testIterator{err: errors.New("permission denied")}, func(id, name string) bool {
+		return true
+	})
+	assert.EqualError(t, err, "permission denied")
+}
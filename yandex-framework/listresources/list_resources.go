@@ -0,0 +1,142 @@
+package listresources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mongodb/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mysql/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/opensearch/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/redis/v1"
+	storagepb "github.com/yandex-cloud/go-genproto/yandex/cloud/storage/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+)
+
+// ListResources returns list resources to discover objects of the folder with `terraform query`.
+func ListResources() []func() list.ListResource {
+	return []func() list.ListResource{
+		NewComputeInstanceListResource,
+		NewComputeDiskListResource,
+		NewVPCNetworkListResource,
+		NewVPCSubnetListResource,
+		NewStorageBucketListResource,
+		NewMDBClickHouseClusterListResource,
+		NewMDBKafkaClusterListResource,
+		NewMDBMongodbClusterListResource,
+		NewMDBMySQLClusterListResource,
+		NewMDBOpenSearchClusterListResource,
+		NewMDBPostgreSQLClusterListResource,
+		NewMDBRedisClusterListResource,
+	}
+}
+
+func NewComputeInstanceListResource() list.ListResource {
+	return newSDKFolderListResource("_compute_instance", "compute instances",
+		func(ctx context.Context, sdk *ycsdk.SDK, folderID string, yield func(id, name string) bool) error {
+			it := sdk.Compute().Instance().InstanceIterator(ctx, &compute.ListInstancesRequest{FolderId: folderID})
+			return iterate[*compute.Instance](it, yield)
+		})
+}
+
+func NewComputeDiskListResource() list.ListResource {
+	return newSDKFolderListResource("_compute_disk", "compute disks",
+		func(ctx context.Context, sdk *ycsdk.SDK, folderID string, yield func(id, name string) bool) error {
+			it := sdk.Compute().Disk().DiskIterator(ctx, &compute.ListDisksRequest{FolderId: folderID})
+			return iterate[*compute.Disk](it, yield)
+		})
+}
+
+func NewVPCNetworkListResource() list.ListResource {
+	return newSDKFolderListResource("_vpc_network", "VPC networks",
+		func(ctx context.Context, sdk *ycsdk.SDK, folderID string, yield func(id, name string) bool) error {
+			it := sdk.VPC().Network().NetworkIterator(ctx, &vpc.ListNetworksRequest{FolderId: folderID})
+			return iterate[*vpc.Network](it, yield)
+		})
+}
+
+func NewVPCSubnetListResource() list.ListResource {
+	return newSDKFolderListResource("_vpc_subnet", "VPC subnets",
+		func(ctx context.Context, sdk *ycsdk.SDK, folderID string, yield func(id, name string) bool) error {
+			it := sdk.VPC().Subnet().SubnetIterator(ctx, &vpc.ListSubnetsRequest{FolderId: folderID})
+			return iterate[*vpc.Subnet](it, yield)
+		})
+}
+
+// NewStorageBucketListResource lists buckets with the Storage API, buckets are identified by their names.
+func NewStorageBucketListResource() list.ListResource {
+	return newSDKFolderListResource("_storage_bucket", "storage buckets",
+		func(ctx context.Context, sdk *ycsdk.SDK, folderID string, yield func(id, name string) bool) error {
+			resp, err := sdk.StorageAPI().Bucket().List(ctx, &storagepb.ListBucketsRequest{FolderId: folderID})
+			if err != nil {
+				return err
+			}
+			for _, b := range resp.GetBuckets() {
+				if !yield(b.GetName(), b.GetName()) {
+					return nil
+				}
+			}
+			return nil
+		})
+}
+
+func NewMDBClickHouseClusterListResource() list.ListResource {
+	return newSDKFolderListResource("_mdb_clickhouse_cluster", "ClickHouse clusters",
+		func(ctx context.Context, sdk *ycsdk.SDK, folderID string, yield func(id, name string) bool) error {
+			it := sdk.MDB().Clickhouse().Cluster().ClusterIterator(ctx, &clickhouse.ListClustersRequest{FolderId: folderID})
+			return iterate[*clickhouse.Cluster](it, yield)
+		})
+}
+
+func NewMDBKafkaClusterListResource() list.ListResource {
+	return newSDKFolderListResource("_mdb_kafka_cluster", "Kafka clusters",
+		func(ctx context.Context, sdk *ycsdk.SDK, folderID string, yield func(id, name string) bool) error {
+			it := sdk.MDB().Kafka().Cluster().ClusterIterator(ctx, &kafka.ListClustersRequest{FolderId: folderID})
+			return iterate[*kafka.Cluster](it, yield)
+		})
+}
+
+func NewMDBMongodbClusterListResource() list.ListResource {
+	return newSDKFolderListResource("_mdb_mongodb_cluster", "MongoDB clusters",
+		func(ctx context.Context, sdk *ycsdk.SDK, folderID string, yield func(id, name string) bool) error {
+			it := sdk.MDB().MongoDB().Cluster().ClusterIterator(ctx, &mongodb.ListClustersRequest{FolderId: folderID})
+			return iterate[*mongodb.Cluster](it, yield)
+		})
+}
+
+func NewMDBMySQLClusterListResource() list.ListResource {
+	return newSDKFolderListResource("_mdb_mysql_cluster", "MySQL clusters",
+		func(ctx context.Context, sdk *ycsdk.SDK, folderID string, yield func(id, name string) bool) error {
+			it := sdk.MDB().MySQL().Cluster().ClusterIterator(ctx, &mysql.ListClustersRequest{FolderId: folderID})
+			return iterate[*mysql.Cluster](it, yield)
+		})
+}
+
+// NewMDBOpenSearchClusterListResource lists OpenSearch clusters, the managed resource is implemented with the framework.
+func NewMDBOpenSearchClusterListResource() list.ListResource {
+	return newFolderListResource("_mdb_opensearch_cluster", "OpenSearch clusters",
+		func(ctx context.Context, sdk *ycsdk.SDK, folderID string, yield func(id, name string) bool) error {
+			it := sdk.MDB().OpenSearch().Cluster().ClusterIterator(ctx, &opensearch.ListClustersRequest{FolderId: folderID})
+			return iterate[*opensearch.Cluster](it, yield)
+		})
+}
+
+func NewMDBPostgreSQLClusterListResource() list.ListResource {
+	return newSDKFolderListResource("_mdb_postgresql_cluster", "PostgreSQL clusters",
+		func(ctx context.Context, sdk *ycsdk.SDK, folderID string, yield func(id, name string) bool) error {
+			it := sdk.MDB().PostgreSQL().Cluster().ClusterIterator(ctx, &postgresql.ListClustersRequest{FolderId: folderID})
+			return iterate[*postgresql.Cluster](it, yield)
+		})
+}
+
+func NewMDBRedisClusterListResource() list.ListResource {
+	return newSDKFolderListResource("_mdb_redis_cluster", "Redis clusters",
+		func(ctx context.Context, sdk *ycsdk.SDK, folderID string, yield func(id, name string) bool) error {
+			it := sdk.MDB().Redis().Cluster().ClusterIterator(ctx, &redis.ListClustersRequest{FolderId: folderID})
+			return iterate[*redis.Cluster](it, yield)
+		})
+}
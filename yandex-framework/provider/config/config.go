@@ -25,7 +25,10 @@ import (
 	"google.golang.org/grpc/metadata"
 
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/config"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/iamtoken"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/logging"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/workloadidentity"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/ycprofile"
 )
 
 const (
@@ -59,17 +62,29 @@ type State struct {
 
 	SharedCredentialsFile types.String `tfsdk:"shared_credentials_file"`
 	Profile               types.String `tfsdk:"profile"`
+
+	ImpersonateServiceAccountID types.String `tfsdk:"impersonate_service_account_id"`
+
+	WorkloadIdentity types.List `tfsdk:"workload_identity"`
 	//
-	//sharedCredentials *SharedCredentials
 	//defaultS3Client   *s3.S3
 }
 
+// WorkloadIdentity is the workload_identity block of the provider.
+type WorkloadIdentity struct {
+	ServiceAccountID types.String `tfsdk:"service_account_id"`
+	JWTFile          types.String `tfsdk:"jwt_file"`
+	JWTEnv           types.String `tfsdk:"jwt_env"`
+}
+
 // TODO: remove yandex.Config when it is not used
 type Config struct {
 	ProviderState State
 
 	UserAgent types.String
 	SDK       *ycsdk.SDK
+	// CLIProfile is the yc CLI profile that authenticates the provider when no credentials are configured.
+	CLIProfile *ycprofile.Profile
 }
 
 // Client configures and returns a fully initialized Yandex Cloud SDK
@@ -80,6 +95,12 @@ func (c *Config) InitAndValidate(ctx context.Context, terraformVersion string, s
 	if err != nil {
 		return err
 	}
+	if id := c.ProviderState.ImpersonateServiceAccountID.ValueString(); id != "" {
+		// Tokens of the impersonated service account are issued with the resolved credentials by the SDK itself.
+		credentials = iamtoken.NewImpersonatedCredentials(credentials, id, func() iamtoken.IAMTokenClient {
+			return c.SDK.IAM().IamToken()
+		})
+	}
 
 	yandexSDKConfig := &ycsdk.Config{
 		Credentials: credentials,
@@ -125,11 +146,28 @@ func (c *Config) InitAndValidate(ctx context.Context, terraformVersion string, s
 		grpc.WithDefaultCallOptions(grpc.Header(&headerMD)),
 		grpc.WithUnaryInterceptor(interceptorChain),
 		retryOptions)
+	if err != nil {
+		return err
+	}
 
-	return err
+	return nil
 }
 
 func (c *Config) Credentials(ctx context.Context) (ycsdk.Credentials, error) {
+	if !c.ProviderState.WorkloadIdentity.IsNull() && !c.ProviderState.WorkloadIdentity.IsUnknown() {
+		var blocks []WorkloadIdentity
+		if diags := c.ProviderState.WorkloadIdentity.ElementsAs(ctx, &blocks, false); diags.HasError() {
+			return nil, fmt.Errorf("failed to read workload_identity: %v", diags)
+		}
+		if len(blocks) > 0 {
+			return workloadidentity.NewCredentials(workloadidentity.Config{
+				ServiceAccountID: blocks[0].ServiceAccountID.ValueString(),
+				JWTFile:          blocks[0].JWTFile.ValueString(),
+				JWTEnv:           blocks[0].JWTEnv.ValueString(),
+			})
+		}
+	}
+
 	if c.ProviderState.ServiceAccountKeyFileOrContent.ValueString() != "" {
 		contents, _, err := pathOrContents(c.ProviderState.ServiceAccountKeyFileOrContent.ValueString())
 		if err != nil {
@@ -154,11 +192,15 @@ func (c *Config) Credentials(ctx context.Context) (ycsdk.Credentials, error) {
 		return ycsdk.OAuthToken(c.ProviderState.Token.ValueString()), nil
 	}
 
+	if c.CLIProfile != nil && c.CLIProfile.HasCredentials() {
+		return c.CLIProfile.Credentials()
+	}
+
 	if sa := ycsdk.InstanceServiceAccount(); checkServiceAccountAvailable(ctx, sa) {
 		return sa, nil
 	}
 
-	return nil, fmt.Errorf("one of 'token' or 'service_account_key_file' should be specified;" +
+	return nil, fmt.Errorf("one of 'token', 'service_account_key_file', 'workload_identity' or yc CLI 'profile' should be specified;" +
 		" if you are inside compute instance, you can attach service account to it in order to " +
 		"authenticate via instance service account")
 }
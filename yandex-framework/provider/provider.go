@@ -7,8 +7,12 @@ import (
 	"os"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/providervalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -16,6 +20,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/ycprofile"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/functions"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/listresources"
 	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/airflow_cluster"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/billing_cloud_binding"
@@ -32,7 +39,11 @@ import (
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/datasphere_community_iam_binding"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/datasphere_project"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/datasphere_project_iam_binding"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/iam_token"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/kms_secret_plaintext"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/kubernetes_cluster_auth"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/kubernetes_marketplace_helm_release"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/lockbox_secret_version"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_clickhouse_database"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_clickhouse_user"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_mongodb_database"
@@ -42,8 +53,8 @@ import (
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_postgresql_cluster_v2"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_redis_cluster_v2"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/spark_cluster"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/vpc_security_group"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/vpc_security_group_rule"
-	// "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/vpc_security_group"
 )
 
 type saKeyValidator struct{}
@@ -89,6 +100,14 @@ func (p *Provider) ConfigValidators(ctx context.Context) []provider.ConfigValida
 			path.MatchRoot("token"),
 			path.MatchRoot("service_account_key_file"),
 		),
+		providervalidator.Conflicting(
+			path.MatchRoot("workload_identity"),
+			path.MatchRoot("token"),
+		),
+		providervalidator.Conflicting(
+			path.MatchRoot("workload_identity"),
+			path.MatchRoot("service_account_key_file"),
+		),
 	}
 }
 
@@ -182,6 +201,34 @@ func (p *Provider) Schema(ctx context.Context, req provider.SchemaRequest, resp
 				Optional:    true,
 				Description: common.Descriptions["profile"],
 			},
+			"impersonate_service_account_id": schema.StringAttribute{
+				Optional:    true,
+				Description: common.Descriptions["impersonate_service_account_id"],
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"workload_identity": schema.ListNestedBlock{
+				Description: common.Descriptions["workload_identity"],
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"service_account_id": schema.StringAttribute{
+							Required:    true,
+							Description: common.Descriptions["workload_identity_service_account_id"],
+						},
+						"jwt_file": schema.StringAttribute{
+							Optional:    true,
+							Description: common.Descriptions["workload_identity_jwt_file"],
+						},
+						"jwt_env": schema.StringAttribute{
+							Optional:    true,
+							Description: common.Descriptions["workload_identity_jwt_env"],
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
 		},
 	}
 }
@@ -209,13 +256,14 @@ func setToDefaultBoolIfNeeded(field types.Bool, osEnvName string, defaultVal boo
 	return field
 }
 
-func setDefaults(config provider_config.State) provider_config.State {
-	config.Endpoint = setToDefaultIfNeeded(config.Endpoint, "YC_ENDPOINT", common.DefaultEndpoint)
-	config.FolderID = setToDefaultIfNeeded(config.FolderID, "YC_FOLDER_ID", "")
-	config.CloudID = setToDefaultIfNeeded(config.CloudID, "YC_CLOUD_ID", "")
-	config.OrganizationID = setToDefaultIfNeeded(config.OrganizationID, "YC_ORGANIZATION_ID", "")
+// setDefaults sets unset provider settings from the environment, then from the yc CLI profile.
+func setDefaults(config provider_config.State, cliProfile *ycprofile.Profile) provider_config.State {
+	config.Endpoint = setToDefaultIfNeeded(config.Endpoint, "YC_ENDPOINT", ycprofile.Or(cliProfile.Endpoint, common.DefaultEndpoint))
+	config.FolderID = setToDefaultIfNeeded(config.FolderID, "YC_FOLDER_ID", cliProfile.FolderID)
+	config.CloudID = setToDefaultIfNeeded(config.CloudID, "YC_CLOUD_ID", cliProfile.CloudID)
+	config.OrganizationID = setToDefaultIfNeeded(config.OrganizationID, "YC_ORGANIZATION_ID", cliProfile.OrganizationID)
 	config.Region = setToDefaultIfNeeded(config.Region, "YC_REGION", common.DefaultRegion)
-	config.Zone = setToDefaultIfNeeded(config.Zone, "YC_ZONE", "")
+	config.Zone = setToDefaultIfNeeded(config.Zone, "YC_ZONE", cliProfile.Zone)
 	config.Token = setToDefaultIfNeeded(config.Token, "YC_TOKEN", "")
 	config.ServiceAccountKeyFileOrContent = setToDefaultIfNeeded(config.ServiceAccountKeyFileOrContent, "YC_SERVICE_ACCOUNT_KEY_FILE", "")
 	config.StorageEndpoint = setToDefaultIfNeeded(config.StorageEndpoint, "YC_STORAGE_ENDPOINT_URL", common.DefaultStorageEndpoint)
@@ -224,6 +272,7 @@ func setDefaults(config provider_config.State) provider_config.State {
 	config.YMQEndpoint = setToDefaultIfNeeded(config.YMQEndpoint, "YC_MESSAGE_QUEUE_ENDPOINT", common.DefaultYMQEndpoint)
 	config.YMQAccessKey = setToDefaultIfNeeded(config.YMQAccessKey, "YC_MESSAGE_QUEUE_ACCESS_KEY", "")
 	config.YMQSecretKey = setToDefaultIfNeeded(config.YMQSecretKey, "YC_MESSAGE_QUEUE_SECRET_KEY", "")
+	config.ImpersonateServiceAccountID = setToDefaultIfNeeded(config.ImpersonateServiceAccountID, "YC_IMPERSONATE_SERVICE_ACCOUNT_ID", "")
 
 	config.Insecure = setToDefaultBoolIfNeeded(config.Insecure, "YC_INSECURE", false)
 	config.Plaintext = setToDefaultBoolIfNeeded(config.Plaintext, "YC_PLAINTEXT", false)
@@ -243,7 +292,18 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 	p.config = provider_config.Config{}
 	resp.Diagnostics.Append(req.Config.Get(ctx, &p.config.ProviderState)...)
 	p.config.UserAgent = types.StringValue(req.TerraformVersion)
-	p.config.ProviderState = setDefaults(p.config.ProviderState)
+
+	cliProfile, err := ycprofile.Resolve(p.config.ProviderState.SharedCredentialsFile.ValueString(), p.config.ProviderState.Profile.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to configure", err.Error())
+		return
+	}
+	p.config.ProviderState = setDefaults(p.config.ProviderState, cliProfile)
+	if p.config.ProviderState.Token.ValueString() == "" &&
+		p.config.ProviderState.ServiceAccountKeyFileOrContent.ValueString() == "" &&
+		(p.config.ProviderState.WorkloadIdentity.IsNull() || len(p.config.ProviderState.WorkloadIdentity.Elements()) == 0) {
+		p.config.CLIProfile = cliProfile
+	}
 	if p.emptyFolder {
 		p.config.ProviderState.FolderID = types.StringValue("")
 	}
@@ -253,6 +313,8 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 	}
 	resp.ResourceData = &p.config
 	resp.DataSourceData = &p.config
+	resp.EphemeralResourceData = &p.config
+	resp.ListResourceData = &p.config
 }
 
 func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
@@ -281,6 +343,7 @@ func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 		compute_snapshot_iam_binding.NewIamBinding,
 		compute_snapshot_schedule_iam_binding.NewIamBinding,
 		airflow_cluster.NewResource,
+		vpc_security_group.NewResource,
 		vpc_security_group_rule.NewResource,
 		mdb_postgresql_cluster_v2.NewPostgreSQLClusterResourceV2,
 		mdb_redis_cluster_v2.NewResource,
@@ -311,6 +374,30 @@ func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource
 	}
 }
 
+func (p *Provider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		iam_token.NewEphemeralResource,
+		kms_secret_plaintext.NewEphemeralResource,
+		kubernetes_cluster_auth.NewEphemeralResource,
+		lockbox_secret_version.NewEphemeralResource,
+	}
+}
+
+func (p *Provider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewBytesToGBFunction,
+		functions.NewGBToBytesFunction,
+		functions.NewParseClusterResourceIDFunction,
+		functions.NewParseIAMMemberIDFunction,
+		functions.NewParseVirtualHostIDFunction,
+		functions.NewZoneToRegionFunction,
+	}
+}
+
+func (p *Provider) ListResources(_ context.Context) []func() list.ListResource {
+	return listresources.ListResources()
+}
+
 func (p *Provider) GetConfig() provider_config.Config {
 	return p.config
 }
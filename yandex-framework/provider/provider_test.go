@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testProviderConfig(t *testing.T, p provider.Provider, values map[string]tftypes.Value) tfsdk.Config {
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(context.Background(), provider.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError(), schemaResp.Diagnostics)
+
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for name, value := range values {
+		attributes[name] = value
+	}
+	return tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(objectType, attributes),
+	}
+}
+
+func TestProviderImpersonateServiceAccountID(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    tftypes.Value
+		env      string
+		expected string
+	}{
+		{
+			name:     "not set",
+			value:    tftypes.NewValue(tftypes.String, nil),
+			expected: "",
+		},
+		{
+			name:     "attribute",
+			value:    tftypes.NewValue(tftypes.String, "sa-from-config"),
+			env:      "sa-from-env",
+			expected: "sa-from-config",
+		},
+		{
+			name:     "env var",
+			value:    tftypes.NewValue(tftypes.String, nil),
+			env:      "sa-from-env",
+			expected: "sa-from-env",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("YC_IMPERSONATE_SERVICE_ACCOUNT_ID", tt.env)
+			p := &Provider{}
+
+			resp := &provider.ConfigureResponse{}
+			p.Configure(context.Background(), provider.ConfigureRequest{
+				Config: testProviderConfig(t, p, map[string]tftypes.Value{
+					"token":                          tftypes.NewValue(tftypes.String, "any_string_like_a_oauth"),
+					"impersonate_service_account_id": tt.value,
+				}),
+			}, resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			assert.Equal(t, tt.expected, p.config.ProviderState.ImpersonateServiceAccountID.ValueString())
+			assert.NotNil(t, p.config.SDK)
+		})
+	}
+}
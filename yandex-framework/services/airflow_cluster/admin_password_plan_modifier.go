@@ -3,7 +3,10 @@ package airflow_cluster
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/writeonly"
 )
 
 type adminPasswordModifier struct{}
@@ -33,9 +36,21 @@ func (m adminPasswordModifier) PlanModifyString(ctx context.Context, req planmod
 		resp.PlanValue = req.StateValue
 	} else {
 		if req.ConfigValue.IsNull() {
+			var adminPasswordWO types.String
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(writeonly.Name("admin_password")), &adminPasswordWO)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			if !adminPasswordWO.IsNull() {
+				// write-only value is never stored, its changes are tracked by admin_password_wo_version
+				resp.PlanValue = types.StringNull()
+				return
+			}
+
 			resp.Diagnostics.AddError(
 				"Missing required argument",
-				"The argument \"admin_password\" is required, but no definition was found.",
+				"Either \"admin_password\" or \"admin_password_wo\" argument is required, but no definition was found.",
 			)
 			return
 		}
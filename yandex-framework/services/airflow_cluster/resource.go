@@ -8,18 +8,24 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	ycsdk "github.com/yandex-cloud/go-sdk"
 
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/writeonly"
 	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &airflowClusterResource{}
 var _ resource.ResourceWithImportState = &airflowClusterResource{}
+var _ resource.ResourceWithIdentity = &airflowClusterResource{}
 var _ resource.ResourceWithValidateConfig = &airflowClusterResource{}
 
 func NewResource() resource.Resource {
@@ -30,6 +36,14 @@ type airflowClusterResource struct {
 	providerConfig *provider_config.Config
 }
 
+// resourceModel extends generated ClusterModel with write-only arguments,
+// which are not supported by the code generator.
+type resourceModel struct {
+	ClusterModel
+	AdminPasswordWO        types.String `tfsdk:"admin_password_wo"`
+	AdminPasswordWOVersion types.Int64  `tfsdk:"admin_password_wo_version"`
+}
+
 // Metadata implements resource.Resource.
 func (a *airflowClusterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_airflow_cluster"
@@ -53,9 +67,18 @@ func (a *airflowClusterResource) Configure(_ context.Context, req resource.Confi
 	a.providerConfig = providerConfig
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (a *airflowClusterResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceid.ID.IdentitySchema()
+}
+
 // ImportState implements resource.ResourceWithImportState.
 func (a *airflowClusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	id := resourceid.ID.ImportID(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 
 	adminPassword := path.Root("admin_password")
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, adminPassword, AdminPasswordStubOnImport)...)
@@ -63,18 +86,25 @@ func (a *airflowClusterResource) ImportState(ctx context.Context, req resource.I
 
 // Create implements resource.Resource.
 func (a *airflowClusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan ClusterModel
+	var plan resourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	createClusterRequest, diags := BuildCreateClusterRequest(ctx, &plan, &a.providerConfig.ProviderState)
+	createClusterRequest, diags := BuildCreateClusterRequest(ctx, &plan.ClusterModel, &a.providerConfig.ProviderState)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	adminPassword, diags := writeonly.Resolve(ctx, req.Config, "admin_password", plan.AdminPassword)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createClusterRequest.AdminPassword = adminPassword.ValueString()
 	tflog.Debug(ctx, fmt.Sprintf("Create Airflow cluster request: %+v", createClusterRequest))
 
 	createTimeout, diags := plan.Timeouts.Create(ctx, YandexAirflowClusterCreateTimeout)
@@ -92,7 +122,7 @@ func (a *airflowClusterResource) Create(ctx context.Context, req resource.Create
 	}
 
 	plan.Id = types.StringValue(clusterID)
-	diags = updateState(ctx, a.providerConfig.SDK, &plan)
+	diags = updateState(ctx, a.providerConfig.SDK, &plan.ClusterModel)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -100,13 +130,14 @@ func (a *airflowClusterResource) Create(ctx context.Context, req resource.Create
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resourceid.ID.SetIdentity(ctx, resp.Identity, plan.Id.ValueString())...)
 
 	tflog.Debug(ctx, "Finished creating Airflow cluster", clusterIDLogField(clusterID))
 }
 
 // Delete implements resource.Resource.
 func (a *airflowClusterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state ClusterModel
+	var state resourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -132,7 +163,7 @@ func (a *airflowClusterResource) Delete(ctx context.Context, req resource.Delete
 
 // Read implements resource.Resource.
 func (a *airflowClusterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state ClusterModel
+	var state resourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -152,7 +183,7 @@ func (a *airflowClusterResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	diags = ClusterToState(ctx, cluster, &state)
+	diags = ClusterToState(ctx, cluster, &state.ClusterModel)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -160,13 +191,14 @@ func (a *airflowClusterResource) Read(ctx context.Context, req resource.ReadRequ
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resourceid.ID.SetIdentity(ctx, resp.Identity, state.Id.ValueString())...)
 	tflog.Debug(ctx, "Finished reading Airflow cluster", clusterIDLogField(clusterID))
 }
 
 // Update implements resource.Resource.
 func (a *airflowClusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan ClusterModel
-	var state ClusterModel
+	var plan resourceModel
+	var state resourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -186,7 +218,7 @@ func (a *airflowClusterResource) Update(ctx context.Context, req resource.Update
 	tflog.Debug(ctx, fmt.Sprintf("Update Airflow cluster state: %+v", state))
 	tflog.Debug(ctx, fmt.Sprintf("Update Airflow cluster plan: %+v", plan))
 
-	updateReq, diags := BuildUpdateClusterRequest(ctx, &state, &plan)
+	updateReq, diags := BuildUpdateClusterRequest(ctx, &state.ClusterModel, &plan.ClusterModel)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -199,7 +231,7 @@ func (a *airflowClusterResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	diags = updateState(ctx, a.providerConfig.SDK, &plan)
+	diags = updateState(ctx, a.providerConfig.SDK, &plan.ClusterModel)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -212,6 +244,14 @@ func (a *airflowClusterResource) Update(ctx context.Context, req resource.Update
 // Schema implements resource.Resource.
 func (a *airflowClusterResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = ClusterResourceSchema(ctx)
+	writeonly.AddAttributes(resp.Schema.Attributes, "admin_password")
+	// Admin password can be set only on cluster creation.
+	adminPasswordWOVersion := resp.Schema.Attributes[writeonly.VersionName("admin_password")].(schema.Int64Attribute)
+	adminPasswordWOVersion.MarkdownDescription += " Since admin password can be set only on cluster creation, changing this value recreates the cluster."
+	adminPasswordWOVersion.PlanModifiers = []planmodifier.Int64{
+		int64planmodifier.RequiresReplace(),
+	}
+	resp.Schema.Attributes[writeonly.VersionName("admin_password")] = adminPasswordWOVersion
 	resp.Schema.Blocks["timeouts"] = timeouts.Block(ctx, timeouts.Opts{
 		Create: true,
 		Update: true,
@@ -220,7 +260,7 @@ func (a *airflowClusterResource) Schema(ctx context.Context, req resource.Schema
 }
 
 func (r *airflowClusterResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var cluster ClusterModel
+	var cluster resourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &cluster)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -0,0 +1,81 @@
+package iam_token
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/timestamp"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+type iamTokenModel struct {
+	IAMToken  types.String `tfsdk:"iam_token"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+}
+
+type iamTokenEphemeralResource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewEphemeralResource() ephemeral.EphemeralResource {
+	return &iamTokenEphemeralResource{}
+}
+
+func (e *iamTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iam_token"
+}
+
+func (e *iamTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.providerConfig = providerConfig
+}
+
+func (e *iamTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Issues a short-lived IAM token for the credentials the provider is configured with. The token is never persisted to the plan or state. For more information, see [the official documentation](https://yandex.cloud/docs/iam/concepts/authorization/iam-token).\n\n" +
+			"~> Ephemeral resources are available in Terraform v1.10 and later.\n",
+		Attributes: map[string]schema.Attribute{
+			"iam_token": schema.StringAttribute{
+				MarkdownDescription: "The IAM token.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "The token expiration timestamp in [RFC3339](https://www.ietf.org/rfc/rfc3339.txt) text format.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (e *iamTokenEphemeralResource) Open(ctx context.Context, _ ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	token, err := e.providerConfig.SDK.CreateIAMToken(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Open ephemeral resource",
+			"Error while requesting API to create IAM token: "+err.Error(),
+		)
+		return
+	}
+
+	model := iamTokenModel{
+		IAMToken:  types.StringValue(token.GetIamToken()),
+		ExpiresAt: types.StringValue(timestamp.Get(token.GetExpiresAt())),
+	}
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &model)...)
+}
@@ -0,0 +1,115 @@
+package kms_secret_plaintext
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/kms/v1"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+type secretPlaintextModel struct {
+	KeyID      types.String `tfsdk:"key_id"`
+	Ciphertext types.String `tfsdk:"ciphertext"`
+	AADContext types.String `tfsdk:"aad_context"`
+	Plaintext  types.String `tfsdk:"plaintext"`
+}
+
+type secretPlaintextEphemeralResource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewEphemeralResource() ephemeral.EphemeralResource {
+	return &secretPlaintextEphemeralResource{}
+}
+
+func (e *secretPlaintextEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kms_secret_plaintext"
+}
+
+func (e *secretPlaintextEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.providerConfig = providerConfig
+}
+
+func (e *secretPlaintextEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Decrypts a ciphertext produced by `yandex_kms_secret_ciphertext` with the specified Yandex KMS key. The plaintext is never persisted to the plan or state. For more information, see [the official documentation](https://yandex.cloud/docs/kms/concepts/).\n\n" +
+			"~> Ephemeral resources are available in Terraform v1.10 and later.\n",
+		Attributes: map[string]schema.Attribute{
+			"key_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the symmetric KMS key to use for decryption.",
+				Required:            true,
+			},
+			"ciphertext": schema.StringAttribute{
+				MarkdownDescription: "CipherText to be decrypted, encoded with `standard` base64 alphabet as defined in RFC 4648 section 4.",
+				Required:            true,
+			},
+			"aad_context": schema.StringAttribute{
+				MarkdownDescription: "Additional authenticated data (AAD context) that was used for encryption.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(8192),
+				},
+			},
+			"plaintext": schema.StringAttribute{
+				MarkdownDescription: "Decrypted plaintext.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (e *secretPlaintextEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var model secretPlaintextModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ciphertext, err := base64.StdEncoding.DecodeString(model.Ciphertext.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ciphertext"),
+			"Invalid ciphertext",
+			"Ciphertext must be encoded with standard base64 alphabet: "+err.Error(),
+		)
+		return
+	}
+
+	result, err := e.providerConfig.SDK.KMSCrypto().SymmetricCrypto().Decrypt(ctx, &kms.SymmetricDecryptRequest{
+		KeyId:      model.KeyID.ValueString(),
+		Ciphertext: ciphertext,
+		AadContext: []byte(model.AADContext.ValueString()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Open ephemeral resource",
+			"Error while requesting API to decrypt data with KMS symmetric key: "+err.Error(),
+		)
+		return
+	}
+
+	model.Plaintext = types.StringValue(string(result.GetPlaintext()))
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &model)...)
+}
@@ -0,0 +1,190 @@
+package kubernetes_cluster_auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/k8s/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/kubeconfig"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/timestamp"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+const (
+	endpointTypeExternal = "external"
+	endpointTypeInternal = "internal"
+)
+
+type clusterAuthModel struct {
+	ClusterID            types.String `tfsdk:"cluster_id"`
+	EndpointType         types.String `tfsdk:"endpoint_type"`
+	AuthMode             types.String `tfsdk:"auth_mode"`
+	ExecProfile          types.String `tfsdk:"exec_profile"`
+	Endpoint             types.String `tfsdk:"endpoint"`
+	ClusterCACertificate types.String `tfsdk:"cluster_ca_certificate"`
+	Token                types.String `tfsdk:"token"`
+	ExpiresAt            types.String `tfsdk:"expires_at"`
+	Kubeconfig           types.String `tfsdk:"kubeconfig"`
+}
+
+type clusterAuthEphemeralResource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewEphemeralResource() ephemeral.EphemeralResource {
+	return &clusterAuthEphemeralResource{}
+}
+
+func (e *clusterAuthEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kubernetes_cluster_auth"
+}
+
+func (e *clusterAuthEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.providerConfig = providerConfig
+}
+
+func (e *clusterAuthEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Gets the credentials to access a Managed Kubernetes cluster: the endpoint, the CA certificate, a short-lived IAM token and a rendered kubeconfig. Nothing is persisted to the plan or state. For more information, see [the official documentation](https://yandex.cloud/docs/managed-kubernetes/operations/connect/).\n\n" +
+			"~> Ephemeral resources are available in Terraform v1.10 and later.\n",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Kubernetes cluster.",
+				Required:            true,
+			},
+			"endpoint_type": schema.StringAttribute{
+				MarkdownDescription: "The endpoint of the cluster master to use: `external` or `internal`. Default is `external`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(endpointTypeExternal, endpointTypeInternal),
+				},
+			},
+			"auth_mode": schema.StringAttribute{
+				MarkdownDescription: "How `kubeconfig` authenticates: `token` embeds `token`, `exec` runs `yc k8s create-token` on each request. Default is `token`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(kubeconfig.AuthModeToken, kubeconfig.AuthModeExec),
+				},
+			},
+			"exec_profile": schema.StringAttribute{
+				MarkdownDescription: "The yc CLI profile used in the `exec` auth mode. The active profile is used if omitted.",
+				Optional:            true,
+			},
+			"endpoint": schema.StringAttribute{
+				MarkdownDescription: "The URL of the cluster master endpoint.",
+				Computed:            true,
+			},
+			"cluster_ca_certificate": schema.StringAttribute{
+				MarkdownDescription: "The PEM-encoded CA certificate of the cluster.",
+				Computed:            true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "The IAM token to use as a bearer token.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "The token expiration timestamp in [RFC3339](https://www.ietf.org/rfc/rfc3339.txt) text format.",
+				Computed:            true,
+			},
+			"kubeconfig": schema.StringAttribute{
+				MarkdownDescription: "The kubeconfig in YAML format with a single cluster, user and context.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (e *clusterAuthEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var model clusterAuthModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if model.EndpointType.ValueString() == "" {
+		model.EndpointType = types.StringValue(endpointTypeExternal)
+	}
+	if model.AuthMode.ValueString() == "" {
+		model.AuthMode = types.StringValue(kubeconfig.AuthModeToken)
+	}
+
+	cluster, err := e.providerConfig.SDK.Kubernetes().Cluster().Get(ctx, &k8s.GetClusterRequest{
+		ClusterId: model.ClusterID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Open ephemeral resource",
+			"Error while requesting API to get Kubernetes cluster: "+err.Error(),
+		)
+		return
+	}
+
+	endpoint := cluster.GetMaster().GetEndpoints().GetExternalV4Endpoint()
+	if model.EndpointType.ValueString() == endpointTypeInternal {
+		endpoint = cluster.GetMaster().GetEndpoints().GetInternalV4Endpoint()
+	}
+	if endpoint == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("endpoint_type"),
+			"Failed to Open ephemeral resource",
+			fmt.Sprintf("Kubernetes cluster %q has no %s endpoint", cluster.GetId(), model.EndpointType.ValueString()),
+		)
+		return
+	}
+
+	token, err := e.providerConfig.SDK.CreateIAMToken(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Open ephemeral resource",
+			"Error while requesting API to create IAM token: "+err.Error(),
+		)
+		return
+	}
+
+	caCertificate := cluster.GetMaster().GetMasterAuth().GetClusterCaCertificate()
+	config, err := kubeconfig.Render(kubeconfig.Cluster{
+		ID:            cluster.GetId(),
+		Name:          cluster.GetName(),
+		Endpoint:      endpoint,
+		CACertificate: caCertificate,
+	}, kubeconfig.Options{
+		AuthMode: model.AuthMode.ValueString(),
+		Token:    token.GetIamToken(),
+		Profile:  model.ExecProfile.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Open ephemeral resource", "Error while rendering kubeconfig: "+err.Error())
+		return
+	}
+
+	model.Endpoint = types.StringValue(endpoint)
+	model.ClusterCACertificate = types.StringValue(caCertificate)
+	model.Token = types.StringValue(token.GetIamToken())
+	model.ExpiresAt = types.StringValue(timestamp.Get(token.GetExpiresAt()))
+	model.Kubeconfig = types.StringValue(config)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &model)...)
+}
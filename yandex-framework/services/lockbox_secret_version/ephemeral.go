@@ -0,0 +1,115 @@
+package lockbox_secret_version
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+type secretVersionEphemeralResource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewEphemeralResource() ephemeral.EphemeralResource {
+	return &secretVersionEphemeralResource{}
+}
+
+func (e *secretVersionEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_lockbox_secret_version"
+}
+
+func (e *secretVersionEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.providerConfig = providerConfig
+}
+
+func (e *secretVersionEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get the payload of a Yandex Cloud Lockbox secret version without persisting it to the plan or state. For more information, see [the official documentation](https://yandex.cloud/docs/lockbox/).\n\n" +
+			"~> Ephemeral resources are available in Terraform v1.10 and later.\n",
+		Attributes: map[string]schema.Attribute{
+			"secret_id": schema.StringAttribute{
+				MarkdownDescription: "The Yandex Cloud Lockbox secret ID.",
+				Required:            true,
+			},
+			"version_id": schema.StringAttribute{
+				MarkdownDescription: "The Yandex Cloud Lockbox secret version ID. The current version of the secret is used if omitted.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"entries": schema.ListNestedAttribute{
+				MarkdownDescription: "List of entries in the Yandex Cloud Lockbox secret version.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							MarkdownDescription: "The key of the entry.",
+							Computed:            true,
+						},
+						"text_value": schema.StringAttribute{
+							MarkdownDescription: "The text value of the entry.",
+							Computed:            true,
+							Sensitive:           true,
+						},
+					},
+				},
+			},
+			"entries_map": schema.MapAttribute{
+				MarkdownDescription: "Text values of the entries keyed by entry key.",
+				Computed:            true,
+				Sensitive:           true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (e *secretVersionEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var model secretVersionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Lockbox secret version payload", map[string]interface{}{
+		"secret_id":  model.SecretID.ValueString(),
+		"version_id": model.VersionID.ValueString(),
+	})
+
+	payload, err := e.providerConfig.SDK.LockboxPayload().Payload().Get(ctx, &lockbox.GetPayloadRequest{
+		SecretId:  model.SecretID.ValueString(),
+		VersionId: model.VersionID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Open ephemeral resource",
+			"Error while requesting API to get Lockbox secret version payload: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(payloadToModel(payload, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &model)...)
+}
@@ -0,0 +1,91 @@
+package lockbox_secret_version_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func testAccProviderFactoriesWithEcho() map[string]func() (tfprotov6.ProviderServer, error) {
+	factories := map[string]func() (tfprotov6.ProviderServer, error){
+		"echo": echoprovider.NewProviderServer(),
+	}
+	for name, factory := range test.AccProviderFactories {
+		factories[name] = factory
+	}
+	return factories
+}
+
+func TestAccLockboxSecretVersionEphemeral_basic(t *testing.T) {
+	t.Parallel()
+
+	secretName := acctest.RandomWithPrefix("tf-lockbox-ephemeral")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactoriesWithEcho(),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLockboxSecretVersionEphemeralConfig(secretName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("k1"),
+						knownvalue.StringExact("v1"),
+					),
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("k2"),
+						knownvalue.StringExact("v2"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccLockboxSecretVersionEphemeralConfig(name string) string {
+	return fmt.Sprintf(`
+resource "yandex_lockbox_secret" "foo" {
+  name = "%s"
+}
+
+resource "yandex_lockbox_secret_version" "foo" {
+  secret_id = yandex_lockbox_secret.foo.id
+  entries {
+    key        = "k1"
+    text_value = "v1"
+  }
+  entries {
+    key        = "k2"
+    text_value = "v2"
+  }
+}
+
+ephemeral "yandex_lockbox_secret_version" "foo" {
+  secret_id  = yandex_lockbox_secret.foo.id
+  version_id = yandex_lockbox_secret_version.foo.id
+}
+
+provider "echo" {
+  data = ephemeral.yandex_lockbox_secret_version.foo.entries_map
+}
+
+resource "echo" "test" {}
+`, name)
+}
@@ -0,0 +1,47 @@
+package lockbox_secret_version
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
+)
+
+type secretVersionModel struct {
+	SecretID   types.String `tfsdk:"secret_id"`
+	VersionID  types.String `tfsdk:"version_id"`
+	Entries    types.List   `tfsdk:"entries"`
+	EntriesMap types.Map    `tfsdk:"entries_map"`
+}
+
+var entryType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"key":        types.StringType,
+		"text_value": types.StringType,
+	},
+}
+
+func payloadToModel(payload *lockbox.Payload, model *secretVersionModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	entries := make([]attr.Value, 0, len(payload.GetEntries()))
+	entriesMap := make(map[string]attr.Value, len(payload.GetEntries()))
+	for _, entry := range payload.GetEntries() {
+		value, d := types.ObjectValue(entryType.AttrTypes, map[string]attr.Value{
+			"key":        types.StringValue(entry.GetKey()),
+			"text_value": types.StringValue(entry.GetTextValue()),
+		})
+		diags.Append(d...)
+		entries = append(entries, value)
+		entriesMap[entry.GetKey()] = types.StringValue(entry.GetTextValue())
+	}
+
+	var d diag.Diagnostics
+	model.VersionID = types.StringValue(payload.GetVersionId())
+	model.Entries, d = types.ListValue(entryType, entries)
+	diags.Append(d...)
+	model.EntriesMap, d = types.MapValue(types.StringType, entriesMap)
+	diags.Append(d...)
+
+	return diags
+}
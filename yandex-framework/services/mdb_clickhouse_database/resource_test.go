@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
 	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
@@ -79,6 +80,40 @@ func TestAccMDBClickHouseDatabase_basic(t *testing.T) {
 	})
 }
 
+func TestAccMDBClickHouseDatabase_identity(t *testing.T) {
+	t.Parallel()
+
+	clusterName := acctest.RandomWithPrefix("tf-clickhouse-database-identity")
+	description := "ClickHouse database terraform resource identity test"
+
+	dbResource := formatResourceName(chDBResourceName1)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckMDBClickHouseDatabaseDestroy,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceMDBClickHouseDatabaseBasicConfig(clusterName, description, []string{chDBResourceName1}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity(dbResource, map[string]knownvalue.Check{
+						"cluster_id": knownvalue.NotNull(),
+						"name":       knownvalue.StringExact(chDBResourceName1),
+					}),
+				},
+			},
+			{
+				ResourceName:    dbResource,
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
 func testAccDataSourceMDBClickHouseDatabaseBasicConfig(name, description string, dbNames []string) string {
 	result := testAccMDBClickHouseClusterConfigMain(name, description)
 	for _, dbName := range dbNames {
@@ -32,6 +32,8 @@ type ResourceUser struct {
 	ClusterID         types.String   `tfsdk:"cluster_id"`
 	Name              types.String   `tfsdk:"name"`
 	Password          types.String   `tfsdk:"password"`
+	PasswordWO        types.String   `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64    `tfsdk:"password_wo_version"`
 	GeneratePassword  types.Bool     `tfsdk:"generate_password"`
 	Permissions       types.Set      `tfsdk:"permission"`
 	Settings          types.Object   `tfsdk:"settings"`
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/writeonly"
 	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
 )
 
@@ -25,6 +27,7 @@ const (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &bindingResource{}
 var _ resource.ResourceWithImportState = &bindingResource{}
+var _ resource.ResourceWithIdentity = &bindingResource{}
 
 type bindingResource struct {
 	providerConfig *provider_config.Config
@@ -89,6 +92,7 @@ func (r *bindingResource) Read(ctx context.Context, req resource.ReadRequest, re
 	state.Id = types.StringValue(resourceid.Construct(cid, userName))
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resourceid.ClusterResource.SetIdentity(ctx, resp.Identity, state.Id.ValueString())...)
 }
 
 func (r *bindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -113,10 +117,14 @@ func (r *bindingResource) Create(ctx context.Context, req resource.CreateRequest
 	userSpec, diags := userFromState(ctx, &plan)
 	log.Printf("[DEBUG] User spec from state: %v\n", userSpec)
 
+	password, d := writeonly.Resolve(ctx, req.Config, "password", plan.Password)
+	diags.Append(d...)
+	userSpec.Password = password.ValueString()
+
 	if !isValidPasswordConfiguration(userSpec) {
 		resp.Diagnostics.AddError(
 			"Invalid user configuration",
-			"must specify either password or generate_password",
+			"must specify either password, password_wo or generate_password",
 		)
 	}
 
@@ -138,6 +146,7 @@ func (r *bindingResource) Create(ctx context.Context, req resource.CreateRequest
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resourceid.ClusterResource.SetIdentity(ctx, resp.Identity, plan.Id.ValueString())...)
 }
 
 func getUpdatePaths(plan, state *ResourceUser) []string {
@@ -179,10 +188,17 @@ func (r *bindingResource) Update(ctx context.Context, req resource.UpdateRequest
 	userPlan, diags := userFromState(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 
+	password, diags := writeonly.Resolve(ctx, req.Config, "password", plan.Password)
+	resp.Diagnostics.Append(diags...)
+	userPlan.Password = password.ValueString()
+
+	passwordWOChanged, diags := writeonly.VersionChanged(ctx, req.Plan, req.State, "password")
+	resp.Diagnostics.Append(diags...)
+
 	if !isValidPasswordConfiguration(userPlan) {
 		resp.Diagnostics.AddError(
 			"Invalid user configuration",
-			"must specify either password or generate_password",
+			"must specify either password, password_wo or generate_password",
 		)
 	}
 
@@ -190,6 +206,9 @@ func (r *bindingResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 	updatePaths := getUpdatePaths(&plan, &state)
+	if passwordWOChanged && !slices.Contains(updatePaths, "password") {
+		updatePaths = append(updatePaths, "password")
+	}
 
 	if len(updatePaths) == 0 {
 		return
@@ -230,8 +249,16 @@ func (r *bindingResource) Delete(ctx context.Context, req resource.DeleteRequest
 	deleteUser(ctx, r.providerConfig.SDK, &resp.Diagnostics, cid, userName)
 }
 
+func (r *bindingResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceid.ClusterResource.IdentitySchema()
+}
+
 func (r *bindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	clusterId, userName, err := resourceid.Deconstruct(req.ID)
+	importID := resourceid.ClusterResource.ImportID(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	clusterId, userName, err := resourceid.Deconstruct(importID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
@@ -12,12 +12,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/writeonly"
 )
 
 func UserSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		MarkdownDescription: "Manages a ClickHouse user within the Yandex.Cloud. For more information, see [the official documentation](https://cloud.yandex.com/docs/managed-clickhouse/concepts).",
-		Attributes: map[string]schema.Attribute{
+		Attributes: writeonly.AddAttributes(map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				MarkdownDescription: common.ResourceDescriptions["id"],
 				Computed:            true,
@@ -45,13 +46,13 @@ func UserSchema(ctx context.Context) schema.Schema {
 				Sensitive:           true,
 			},
 			"generate_password": schema.BoolAttribute{
-				MarkdownDescription: "Generate password using Connection Manager. Allowed values: `true` or `false`. It's used only during user creation and is ignored during updating.\n\n~> **Must specify either password or generate_password**.\n",
+				MarkdownDescription: "Generate password using Connection Manager. Allowed values: `true` or `false`. It's used only during user creation and is ignored during updating.\n\n~> **Must specify either password, password_wo or generate_password**.\n",
 				Optional:            true,
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
 			},
 			"connection_manager": ConnectionManagerSchema(),
-		},
+		}, "password"),
 		Blocks: map[string]schema.Block{
 			"permission": PermissionSchema(),
 			"quota":      QuotasSchema(),
@@ -112,6 +112,7 @@ func (r *bindingResource) Read(ctx context.Context, req resource.ReadRequest, re
 	state.Id = types.StringValue(resourceid.Construct(cid, dbName))
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resourceid.ClusterResource.SetIdentity(ctx, resp.Identity, state.Id.ValueString())...)
 }
 
 func (r *bindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -132,6 +133,7 @@ func (r *bindingResource) Create(ctx context.Context, req resource.CreateRequest
 	plan.Id = types.StringValue(resourceid.Construct(cid, dbName))
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resourceid.ClusterResource.SetIdentity(ctx, resp.Identity, plan.Id.ValueString())...)
 }
 
 // Update when cluster_id changed
@@ -152,8 +154,16 @@ func (r *bindingResource) Delete(ctx context.Context, req resource.DeleteRequest
 	deleteDatabase(ctx, r.providerConfig.SDK, &resp.Diagnostics, cid, dbName)
 }
 
+func (r *bindingResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceid.ClusterResource.IdentitySchema()
+}
+
 func (r *bindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	clusterId, dbName, err := resourceid.Deconstruct(req.ID)
+	importID := resourceid.ClusterResource.ImportID(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	clusterId, dbName, err := resourceid.Deconstruct(importID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
@@ -17,6 +17,12 @@ type User struct {
 	Permission types.Set    `tfsdk:"permission"`
 }
 
+type ResourceUser struct {
+	User
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+}
+
 type Permission struct {
 	DatabaseName types.String `tfsdk:"database_name"`
 	Roles        types.Set    `tfsdk:"roles"`
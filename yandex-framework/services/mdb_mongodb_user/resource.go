@@ -3,17 +3,23 @@ package mdb_mongodb_user
 import (
 	"context"
 	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mongodb/v1"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/validate"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/writeonly"
 	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
 	"google.golang.org/grpc/codes"
 )
@@ -52,7 +58,7 @@ func (r *bindingResource) Schema(_ context.Context,
 	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a MongoDB user within the Yandex Cloud. For more information, see [the official documentation](https://yandex.cloud/docs/managed-mongodb/).",
-		Attributes: map[string]schema.Attribute{
+		Attributes: writeonly.AddAttributes(map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				MarkdownDescription: common.ResourceDescriptions["id"],
 				Computed:            true,
@@ -75,11 +81,14 @@ func (r *bindingResource) Schema(_ context.Context,
 				},
 			},
 			"password": schema.StringAttribute{
-				MarkdownDescription: "The password of the user.",
-				Required:            true,
+				MarkdownDescription: "The password of the user.\n\n~> **Must specify either password or password_wo**.\n",
+				Optional:            true,
 				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot(writeonly.Name("password"))),
+				},
 			},
-		},
+		}, "password"),
 		Blocks: map[string]schema.Block{
 			"permission": schema.SetNestedBlock{
 				MarkdownDescription: "Set of permissions granted to the user.",
@@ -102,7 +111,7 @@ func (r *bindingResource) Schema(_ context.Context,
 }
 
 func (r *bindingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state User
+	var state ResourceUser
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -128,7 +137,7 @@ func (r *bindingResource) Read(ctx context.Context, req resource.ReadRequest, re
 		)
 		return
 	}
-	resp.Diagnostics.Append(userToState(user, &state)...)
+	resp.Diagnostics.Append(userToState(user, &state.User)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -136,10 +145,11 @@ func (r *bindingResource) Read(ctx context.Context, req resource.ReadRequest, re
 	state.Id = types.StringValue(resourceid.Construct(cid, userName))
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resourceid.ClusterResource.SetIdentity(ctx, resp.Identity, state.Id.ValueString())...)
 }
 
 func (r *bindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan User
+	var plan ResourceUser
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -147,11 +157,14 @@ func (r *bindingResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	cid := plan.ClusterID.ValueString()
-	userPlan, diags := userFromState(ctx, &plan)
+	userPlan, diags := userFromState(ctx, &plan.User)
+	resp.Diagnostics.Append(diags...)
+	password, diags := writeonly.Resolve(ctx, req.Config, "password", plan.Password)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	userPlan.Password = password.ValueString()
 
 	createUser(ctx, r.providerConfig.SDK, &resp.Diagnostics, cid, userPlan)
 	if resp.Diagnostics.HasError() {
@@ -161,6 +174,7 @@ func (r *bindingResource) Create(ctx context.Context, req resource.CreateRequest
 	plan.Id = types.StringValue(resourceid.Construct(cid, userPlan.Name))
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resourceid.ClusterResource.SetIdentity(ctx, resp.Identity, plan.Id.ValueString())...)
 }
 
 func getUpdatePaths(plan, state *mongodb.UserSpec) []string {
@@ -175,8 +189,8 @@ func getUpdatePaths(plan, state *mongodb.UserSpec) []string {
 }
 
 func (r *bindingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan User
-	var state User
+	var plan ResourceUser
+	var state ResourceUser
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -184,14 +198,23 @@ func (r *bindingResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	cid := plan.ClusterID.ValueString()
-	userState, diags := userFromState(ctx, &state)
+	userState, diags := userFromState(ctx, &state.User)
+	resp.Diagnostics.Append(diags...)
+	userPlan, diags := userFromState(ctx, &plan.User)
 	resp.Diagnostics.Append(diags...)
-	userPlan, diags := userFromState(ctx, &plan)
+	password, diags := writeonly.Resolve(ctx, req.Config, "password", plan.Password)
+	resp.Diagnostics.Append(diags...)
+	passwordWOChanged, diags := writeonly.VersionChanged(ctx, req.Plan, req.State, "password")
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	userPlan.Password = password.ValueString()
+
 	updatePaths := getUpdatePaths(userPlan, userState)
+	if passwordWOChanged && !slices.Contains(updatePaths, "password") {
+		updatePaths = append(updatePaths, "password")
+	}
 
 	if len(updatePaths) > 0 {
 		updateUser(ctx, r.providerConfig.SDK, &resp.Diagnostics, cid, userPlan, updatePaths)
@@ -206,7 +229,7 @@ func (r *bindingResource) Update(ctx context.Context, req resource.UpdateRequest
 }
 
 func (r *bindingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state User
+	var state ResourceUser
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -218,8 +241,16 @@ func (r *bindingResource) Delete(ctx context.Context, req resource.DeleteRequest
 	deleteUser(ctx, r.providerConfig.SDK, &resp.Diagnostics, cid, dbName)
 }
 
+func (r *bindingResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceid.ClusterResource.IdentitySchema()
+}
+
 func (r *bindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	clusterId, userName, err := resourceid.Deconstruct(req.ID)
+	importID := resourceid.ClusterResource.ImportID(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	clusterId, userName, err := resourceid.Deconstruct(importID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
@@ -231,8 +262,8 @@ func (r *bindingResource) ImportState(ctx context.Context, req resource.ImportSt
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	var state User
-	resp.Diagnostics.Append(userToState(user, &state)...)
+	var state ResourceUser
+	resp.Diagnostics.Append(userToState(user, &state.User)...)
 
 	diags := resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
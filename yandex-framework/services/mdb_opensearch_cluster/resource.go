@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	ycsdk "github.com/yandex-cloud/go-sdk"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
 	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_opensearch_cluster/legacy"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_opensearch_cluster/log"
@@ -42,6 +43,7 @@ const (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &openSearchClusterResource{}
 var _ resource.ResourceWithImportState = &openSearchClusterResource{}
+var _ resource.ResourceWithIdentity = &openSearchClusterResource{}
 var _ resource.ResourceWithUpgradeState = &openSearchClusterResource{}
 var _ resource.ResourceWithModifyPlan = &openSearchClusterResource{}
 
@@ -76,9 +78,18 @@ func (o *openSearchClusterResource) Configure(_ context.Context, req resource.Co
 	o.providerConfig = providerConfig
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (o *openSearchClusterResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceid.ID.IdentitySchema()
+}
+
 // ImportState implements resource.ResourceWithImportState.
 func (o *openSearchClusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	id := resourceid.ID.ImportID(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
 
 func (o *openSearchClusterResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
@@ -219,6 +230,7 @@ func (o *openSearchClusterResource) Create(ctx context.Context, req resource.Cre
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resourceid.ID.SetIdentity(ctx, resp.Identity, plan.ID.ValueString())...)
 	tflog.Debug(ctx, "Finished creating OpenSearch Cluster", log.IdFromModel(&plan))
 }
 
@@ -262,6 +274,7 @@ func (o *openSearchClusterResource) Read(ctx context.Context, req resource.ReadR
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resourceid.ID.SetIdentity(ctx, resp.Identity, state.ID.ValueString())...)
 	tflog.Debug(ctx, "Finished reading OpenSearch Cluster", log.IdFromModel(&state))
 }
 
@@ -29,6 +29,7 @@ import (
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/datasize"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
 	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
 	"golang.org/x/exp/maps"
 )
@@ -466,6 +467,7 @@ func (r *clusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 	d := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(d...)
+	resp.Diagnostics.Append(resourceid.ID.SetIdentity(ctx, resp.Identity, state.Id.ValueString())...)
 }
 
 func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -502,6 +504,7 @@ func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest
 	r.refreshResourceState(ctx, &plan, &resp.Diagnostics)
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resourceid.ID.SetIdentity(ctx, resp.Identity, plan.Id.ValueString())...)
 }
 
 func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -572,8 +575,16 @@ func (r *clusterResource) Delete(ctx context.Context, req resource.DeleteRequest
 	postgresqlApi.DeleteCluster(ctx, r.providerConfig.SDK, &resp.Diagnostics, cid)
 }
 
+func (r *clusterResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceid.ID.IdentitySchema()
+}
+
 func (r *clusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	id := resourceid.ID.ImportID(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
 
 func (r *clusterResource) refreshResourceState(ctx context.Context, state *Cluster, respDiagnostics *diag.Diagnostics) {
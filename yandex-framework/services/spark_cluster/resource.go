@@ -16,6 +16,8 @@ import (
 	ycsdk "github.com/yandex-cloud/go-sdk"
 
 	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
 )
 
 const (
@@ -27,6 +29,7 @@ const (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &sparkClusterResource{}
 var _ resource.ResourceWithImportState = &sparkClusterResource{}
+var _ resource.ResourceWithIdentity = &sparkClusterResource{}
 var _ resource.ResourceWithValidateConfig = &sparkClusterResource{}
 
 func NewResource() resource.Resource {
@@ -99,6 +102,7 @@ func (a *sparkClusterResource) Create(ctx context.Context, req resource.CreateRe
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resourceid.ID.SetIdentity(ctx, resp.Identity, plan.Id.ValueString())...)
 
 	tflog.Debug(ctx, "Finished creating Spark cluster", clusterIDLogField(clusterID))
 }
@@ -159,6 +163,7 @@ func (a *sparkClusterResource) Read(ctx context.Context, req resource.ReadReques
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resourceid.ID.SetIdentity(ctx, resp.Identity, state.Id.ValueString())...)
 	tflog.Debug(ctx, "Finished reading Spark cluster", clusterIDLogField(clusterID))
 }
 
@@ -198,8 +203,16 @@ func (a *sparkClusterResource) Schema(ctx context.Context, req resource.SchemaRe
 	})
 }
 
+func (r *sparkClusterResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceid.ID.IdentitySchema()
+}
+
 func (r *sparkClusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	id := resourceid.ID.ImportID(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
 
 func (r *sparkClusterResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -111,14 +111,23 @@ func UpdateSecurityGroupRules(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Di
 	} else if deleteRuleID != "" {
 		tflog.Debug(ctx, "Deleting VPC SecurityGroupRule", map[string]interface{}{"security_group_binding": sgID, "id": deleteRuleID})
 	}
-	req := vpc.UpdateSecurityGroupRulesRequest{
-		SecurityGroupId: sgID,
-	}
+	var addRules []*vpc.SecurityGroupRuleSpec
 	if addRule != nil {
-		req.AdditionRuleSpecs = []*vpc.SecurityGroupRuleSpec{addRule}
+		addRules = []*vpc.SecurityGroupRuleSpec{addRule}
 	}
+	var deleteRuleIDs []string
 	if deleteRuleID != "" {
-		req.DeletionRuleIds = []string{deleteRuleID}
+		deleteRuleIDs = []string{deleteRuleID}
+	}
+	return ReplaceSecurityGroupRules(ctx, sdk, diag, sgID, addRules, deleteRuleIDs)
+}
+
+// ReplaceSecurityGroupRules adds and deletes rules of the security group in a single operation.
+func ReplaceSecurityGroupRules(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, sgID string, addRules []*vpc.SecurityGroupRuleSpec, deleteRuleIDs []string) *vpc.UpdateSecurityGroupMetadata {
+	req := vpc.UpdateSecurityGroupRulesRequest{
+		SecurityGroupId:   sgID,
+		AdditionRuleSpecs: addRules,
+		DeletionRuleIds:   deleteRuleIDs,
 	}
 	op, err := retry.ConflictingOperation(ctx, sdk, func() (*operation.Operation, error) {
 		return sdk.VPC().SecurityGroup().UpdateRules(ctx, &req)
@@ -160,3 +169,62 @@ func UpdateSecurityGroupRules(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Di
 
 	return meta
 }
+
+func CreateSecurityGroup(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, req *vpc.CreateSecurityGroupRequest) string {
+	tflog.Debug(ctx, "Creating VPC SecurityGroup", map[string]interface{}{"name": req.GetName()})
+	op, err := sdk.WrapOperation(sdk.VPC().SecurityGroup().Create(ctx, req))
+	if err != nil {
+		diag.AddError(
+			"Failed to Create resource",
+			"Error while requesting API to create Security Group: "+err.Error(),
+		)
+		return ""
+	}
+
+	metadata, err := op.Metadata()
+	if err != nil {
+		diag.AddError(
+			"Failed to Create resource",
+			"Error while getting Security Group create operation metadata: "+err.Error(),
+		)
+		return ""
+	}
+	meta, ok := metadata.(*vpc.CreateSecurityGroupMetadata)
+	if !ok {
+		diag.AddError(
+			"Failed to Create resource",
+			"Could not get Security Group ID from create operation metadata",
+		)
+		return ""
+	}
+
+	if err = op.Wait(ctx); err != nil {
+		diag.AddError(
+			"Failed to Create resource",
+			"Error while waiting for operation to create Security Group: "+err.Error(),
+		)
+	}
+	return meta.GetSecurityGroupId()
+}
+
+func UpdateSecurityGroup(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, req *vpc.UpdateSecurityGroupRequest) {
+	tflog.Debug(ctx, "Updating VPC SecurityGroup", map[string]interface{}{"id": req.GetSecurityGroupId(), "update_mask": req.GetUpdateMask().GetPaths()})
+	op, err := retry.ConflictingOperation(ctx, sdk, func() (*operation.Operation, error) {
+		return sdk.VPC().SecurityGroup().Update(ctx, req)
+	})
+
+	if err != nil {
+		diag.AddError(
+			"Failed to Update resource",
+			"Error while requesting API to update Security Group: "+err.Error(),
+		)
+		return
+	}
+
+	if err = op.Wait(ctx); err != nil {
+		diag.AddError(
+			"Failed to Update resource",
+			"Error while waiting for operation to update Security Group: "+err.Error(),
+		)
+	}
+}
@@ -110,7 +110,7 @@ func (g *securityGroupDataSource) Read(ctx context.Context, req datasource.ReadR
 	}
 
 	state.ID = types.StringValue(sgID)
-	updateState(ctx, g.providerConfig.SDK, &state.securityGroupModel, &resp.Diagnostics, false)
+	updateState(ctx, g.providerConfig.SDK, &state.securityGroupModel, &resp.Diagnostics, false, nil)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -2,6 +2,8 @@ package vpc_security_group
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -9,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/timestamp"
+	"google.golang.org/protobuf/proto"
 )
 
 type securityGroupModel struct {
@@ -25,6 +28,12 @@ type securityGroupModel struct {
 	Egress      types.Set      `tfsdk:"egress"`
 }
 
+type securityGroupResourceModel struct {
+	securityGroupModel
+
+	IgnoreExternalRules types.Bool `tfsdk:"ignore_external_rules"`
+}
+
 type securityGroupDataSourceModel struct {
 	securityGroupModel
 
@@ -47,25 +56,200 @@ var ruleType = types.ObjectType{
 	},
 }
 
+type securityGroupRuleModel struct {
+	ID               types.String `tfsdk:"id"`
+	Description      types.String `tfsdk:"description"`
+	Labels           types.Map    `tfsdk:"labels"`
+	Protocol         types.String `tfsdk:"protocol"`
+	Port             types.Int64  `tfsdk:"port"`
+	FromPort         types.Int64  `tfsdk:"from_port"`
+	ToPort           types.Int64  `tfsdk:"to_port"`
+	V4CidrBlocks     types.List   `tfsdk:"v4_cidr_blocks"`
+	V6CidrBlocks     types.List   `tfsdk:"v6_cidr_blocks"`
+	SecurityGroupID  types.String `tfsdk:"security_group_id"`
+	PredefinedTarget types.String `tfsdk:"predefined_target"`
+}
+
+// ruleBody is the part of a rule which cannot be changed without recreating the rule.
+// Rules with equal bodies are considered the same rule.
+type ruleBody struct {
+	protocol         string
+	fromPort         int64
+	toPort           int64
+	v4CidrBlocks     string
+	v6CidrBlocks     string
+	securityGroupID  string
+	predefinedTarget string
+}
+
+func ruleBodyFromModel(ctx context.Context, r *securityGroupRuleModel) ruleBody {
+	body := ruleBody{
+		protocol:         strings.ToUpper(r.Protocol.ValueString()),
+		fromPort:         -1,
+		toPort:           -1,
+		v4CidrBlocks:     strings.Join(listToStrings(ctx, r.V4CidrBlocks), ","),
+		v6CidrBlocks:     strings.Join(listToStrings(ctx, r.V6CidrBlocks), ","),
+		securityGroupID:  r.SecurityGroupID.ValueString(),
+		predefinedTarget: r.PredefinedTarget.ValueString(),
+	}
+	if ports, err := ExpandRulePorts(r.Port.ValueInt64(), r.FromPort.ValueInt64(), r.ToPort.ValueInt64()); err == nil && ports != nil {
+		body.fromPort, body.toPort = ports.FromPort, ports.ToPort
+	}
+	return body
+}
+
+func ruleBodyFromAPI(rule *vpc.SecurityGroupRule) ruleBody {
+	v4Cidrs, v6Cidrs := SplitCidrs(rule.GetCidrBlocks())
+	body := ruleBody{
+		protocol:         strings.ToUpper(rule.GetProtocolName()),
+		fromPort:         -1,
+		toPort:           -1,
+		v4CidrBlocks:     strings.Join(v4Cidrs, ","),
+		v6CidrBlocks:     strings.Join(v6Cidrs, ","),
+		securityGroupID:  rule.GetSecurityGroupId(),
+		predefinedTarget: rule.GetPredefinedTarget(),
+	}
+	if ports := rule.GetPorts(); ports != nil {
+		body.fromPort, body.toPort = ports.FromPort, ports.ToPort
+	}
+	return body
+}
+
+func listToStrings(ctx context.Context, l types.List) []string {
+	if l.IsNull() || l.IsUnknown() {
+		return nil
+	}
+	var values []string
+	l.ElementsAs(ctx, &values, false)
+	return values
+}
+
+func rulesFromSet(ctx context.Context, set types.Set) ([]securityGroupRuleModel, diag.Diagnostics) {
+	if set.IsNull() || set.IsUnknown() {
+		return nil, nil
+	}
+	var rules []securityGroupRuleModel
+	diags := set.ElementsAs(ctx, &rules, false)
+	return rules, diags
+}
+
+func rulesToSet(ctx context.Context, rules []securityGroupRuleModel) (types.Set, diag.Diagnostics) {
+	if rules == nil {
+		rules = []securityGroupRuleModel{}
+	}
+	return types.SetValueFrom(ctx, ruleType, rules)
+}
+
+// ruleIDs returns the IDs of the rules of the sets.
+func ruleIDs(ctx context.Context, sets ...types.Set) (map[string]bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	ids := make(map[string]bool)
+	for _, set := range sets {
+		rules, d := rulesFromSet(ctx, set)
+		diags.Append(d...)
+		for _, rule := range rules {
+			if id := rule.ID.ValueString(); id != "" {
+				ids[id] = true
+			}
+		}
+	}
+	return ids, diags
+}
+
+// withoutExternalRules returns the security group without the rules for which isExternal returns true.
+func withoutExternalRules(sg *vpc.SecurityGroup, isExternal func(ruleID string) bool) *vpc.SecurityGroup {
+	if isExternal == nil {
+		return sg
+	}
+	sg = proto.Clone(sg).(*vpc.SecurityGroup)
+	rules := sg.Rules[:0]
+	for _, rule := range sg.GetRules() {
+		if !isExternal(rule.GetId()) {
+			rules = append(rules, rule)
+		}
+	}
+	sg.Rules = rules
+	return sg
+}
+
+// matchPlannedRules keeps the identity of the rules of the prior state in the planned rules,
+// so that only the changed rules are shown in the plan instead of all of them. A planned
+// rule takes the identity of a prior rule with the same body, preferring one with the same
+// description and labels. The protocol is compared case-insensitively, and a rule that differs
+// from the prior one only in the protocol case keeps the prior value to avoid a spurious diff.
+func matchPlannedRules(ctx context.Context, planned, prior []securityGroupRuleModel) {
+	used := make([]bool, len(prior))
+	for _, sameMetadata := range []bool{true, false} {
+		for i := range planned {
+			if !planned[i].ID.IsUnknown() {
+				continue
+			}
+			body := ruleBodyFromModel(ctx, &planned[i])
+			for j := range prior {
+				if used[j] || ruleBodyFromModel(ctx, &prior[j]) != body {
+					continue
+				}
+				if sameMetadata && !ruleMetadataEqual(&planned[i], &prior[j]) {
+					continue
+				}
+				used[j] = true
+				copyComputedRuleAttributes(&planned[i], &prior[j])
+				break
+			}
+		}
+	}
+}
+
+func ruleMetadataEqual(planned, prior *securityGroupRuleModel) bool {
+	if planned.Description.ValueString() != prior.Description.ValueString() {
+		return false
+	}
+	return planned.Labels.IsUnknown() || planned.Labels.Equal(prior.Labels) ||
+		len(planned.Labels.Elements()) == 0 && len(prior.Labels.Elements()) == 0
+}
+
+func copyComputedRuleAttributes(planned, prior *securityGroupRuleModel) {
+	planned.ID = prior.ID
+	if strings.EqualFold(planned.Protocol.ValueString(), prior.Protocol.ValueString()) {
+		planned.Protocol = prior.Protocol
+	}
+	if planned.Labels.IsUnknown() {
+		planned.Labels = prior.Labels
+	}
+	if planned.V4CidrBlocks.IsUnknown() {
+		planned.V4CidrBlocks = prior.V4CidrBlocks
+	}
+	if planned.V6CidrBlocks.IsUnknown() {
+		planned.V6CidrBlocks = prior.V6CidrBlocks
+	}
+	if planned.SecurityGroupID.IsUnknown() {
+		planned.SecurityGroupID = prior.SecurityGroupID
+	}
+	if planned.PredefinedTarget.IsUnknown() {
+		planned.PredefinedTarget = prior.PredefinedTarget
+	}
+}
+
 func securityGroupToState(ctx context.Context, sg *vpc.SecurityGroup, state *securityGroupModel) diag.Diagnostics {
+	state.ID = types.StringValue(sg.GetId())
 	state.FolderID = types.StringValue(sg.GetFolderId())
 	state.NetworkID = types.StringValue(sg.GetNetworkId())
 	state.Status = types.StringValue(sg.GetStatus().String())
 	state.CreatedAt = types.StringValue(timestamp.Get(sg.GetCreatedAt()))
-	state.Name = types.StringValue(sg.GetName())
-	if state.Description.IsUnknown() || sg.GetDescription() != "" {
+	if !state.Name.IsNull() || sg.GetName() != "" {
+		state.Name = types.StringValue(sg.GetName())
+	}
+	if !state.Description.IsNull() || sg.GetDescription() != "" {
 		state.Description = types.StringValue(sg.GetDescription())
 	}
 
-	if state.Labels.IsUnknown() || sg.Labels != nil {
-		labels, diags := types.MapValueFrom(ctx, types.StringType, sg.Labels)
-		if diags.HasError() {
-			return diags
-		}
-		state.Labels = labels
+	labels, diags := flattenLabels(ctx, sg.GetLabels(), state.Labels)
+	if diags.HasError() {
+		return diags
 	}
+	state.Labels = labels
 
-	var ingress, egress, diags = flattenRules(ctx, sg.GetRules())
+	ingress, egress, diags := flattenRules(ctx, sg.GetRules(), state.Ingress, state.Egress)
 	if diags.HasError() {
 		return diags
 	}
@@ -75,72 +259,231 @@ func securityGroupToState(ctx context.Context, sg *vpc.SecurityGroup, state *sec
 	return diags
 }
 
-func flattenRules(ctx context.Context, rules []*vpc.SecurityGroupRule) (types.Set, types.Set, diag.Diagnostics) {
-	var ingressValues []attr.Value
-	var egressValues []attr.Value
+// flattenLabels keeps the prior labels when both they and the labels returned by the API are empty.
+func flattenLabels(ctx context.Context, labels map[string]string, prior types.Map) (types.Map, diag.Diagnostics) {
+	if len(labels) == 0 && !prior.IsUnknown() && len(prior.Elements()) == 0 {
+		return prior, nil
+	}
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	return types.MapValueFrom(ctx, types.StringType, labels)
+}
 
+// flattenRules converts the rules to the ingress and egress sets. The rules of the prior sets are
+// used to keep the values which are equal to the API ones but are written in another way, e.g.
+// the protocol in lower case or a single port as a range.
+func flattenRules(ctx context.Context, rules []*vpc.SecurityGroupRule, priorIngress, priorEgress types.Set) (types.Set, types.Set, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	for _, rule := range rules {
-		labels, diagnostics := types.MapValueFrom(ctx, types.StringType, rule.Labels)
-		diags.Append(diagnostics...)
-		if diags.HasError() {
-			continue
-		}
-
-		port, fromPort, toPort := FlattenRulePorts(rule)
-
-		v4Cidrs, v6Cidrs := SplitCidrs(rule.GetCidrBlocks())
+	prior := make(map[vpc.SecurityGroupRule_Direction][]securityGroupRuleModel, 2)
+	for direction, set := range map[vpc.SecurityGroupRule_Direction]types.Set{
+		vpc.SecurityGroupRule_INGRESS: priorIngress,
+		vpc.SecurityGroupRule_EGRESS:  priorEgress,
+	} {
+		priorRules, d := rulesFromSet(ctx, set)
+		diags.Append(d...)
+		prior[direction] = priorRules
+	}
+	if diags.HasError() {
+		return types.SetNull(ruleType), types.SetNull(ruleType), diags
+	}
 
-		v4CidrsValue, diagnostics := NullableStringSliceToList(ctx, v4Cidrs)
-		diags.Append(diagnostics...)
-		if diags.HasError() {
-			continue
+	used := make(map[*securityGroupRuleModel]bool)
+	findPrior := func(rule *vpc.SecurityGroupRule) *securityGroupRuleModel {
+		candidates := prior[rule.GetDirection()]
+		for i := range candidates {
+			if candidates[i].ID.ValueString() == rule.GetId() {
+				used[&candidates[i]] = true
+				return &candidates[i]
+			}
 		}
-		v6CidrsValue, diagnostics := NullableStringSliceToList(ctx, v6Cidrs)
-		diags.Append(diagnostics...)
-		if diags.HasError() {
-			continue
+		body := ruleBodyFromAPI(rule)
+		for i := range candidates {
+			if used[&candidates[i]] || !candidates[i].ID.IsUnknown() && !candidates[i].ID.IsNull() {
+				continue
+			}
+			if ruleBodyFromModel(ctx, &candidates[i]) == body {
+				used[&candidates[i]] = true
+				return &candidates[i]
+			}
 		}
+		return nil
+	}
 
-		ruleValue, diagnostics := types.ObjectValue(ruleType.AttrTypes, map[string]attr.Value{
-			"id":                types.StringValue(rule.Id),
-			"description":       types.StringValue(rule.Description),
-			"labels":            labels,
-			"protocol":          types.StringValue(rule.ProtocolName),
-			"port":              types.Int64Value(port),
-			"from_port":         types.Int64Value(fromPort),
-			"to_port":           types.Int64Value(toPort),
-			"v4_cidr_blocks":    v4CidrsValue,
-			"v6_cidr_blocks":    v6CidrsValue,
-			"security_group_id": types.StringValue(rule.GetSecurityGroupId()),
-			"predefined_target": types.StringValue(rule.GetPredefinedTarget()),
-		})
-		diags.Append(diagnostics...)
+	var ingress, egress []securityGroupRuleModel
+	for _, rule := range rules {
+		ruleModel, d := flattenRule(ctx, rule, findPrior(rule))
+		diags.Append(d...)
 		if diags.HasError() {
 			continue
 		}
 
 		switch rule.GetDirection() {
 		case vpc.SecurityGroupRule_INGRESS:
-			ingressValues = append(ingressValues, ruleValue)
+			ingress = append(ingress, ruleModel)
 		case vpc.SecurityGroupRule_EGRESS:
-			egressValues = append(egressValues, ruleValue)
+			egress = append(egress, ruleModel)
+		}
+	}
+	if diags.HasError() {
+		return types.SetNull(ruleType), types.SetNull(ruleType), diags
+	}
+
+	ingressSet, d := rulesToSet(ctx, ingress)
+	diags.Append(d...)
+	egressSet, d := rulesToSet(ctx, egress)
+	diags.Append(d...)
+
+	return ingressSet, egressSet, diags
+}
+
+func flattenRule(ctx context.Context, rule *vpc.SecurityGroupRule, prior *securityGroupRuleModel) (securityGroupRuleModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if prior == nil {
+		prior = &securityGroupRuleModel{Description: types.StringNull()}
+	}
+
+	// The labels of a rule are computed, so they are never null.
+	labels := rule.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labelsValue, d := types.MapValueFrom(ctx, types.StringType, labels)
+	diags.Append(d...)
+
+	v4Cidrs, v6Cidrs := SplitCidrs(rule.GetCidrBlocks())
+	v4CidrsValue, d := NullableStringSliceToList(ctx, v4Cidrs)
+	diags.Append(d...)
+	v6CidrsValue, d := NullableStringSliceToList(ctx, v6Cidrs)
+	diags.Append(d...)
+
+	ruleModel := securityGroupRuleModel{
+		ID:               types.StringValue(rule.GetId()),
+		Description:      types.StringValue(rule.GetDescription()),
+		Labels:           labelsValue,
+		Protocol:         types.StringValue(rule.GetProtocolName()),
+		V4CidrBlocks:     v4CidrsValue,
+		V6CidrBlocks:     v6CidrsValue,
+		SecurityGroupID:  types.StringValue(rule.GetSecurityGroupId()),
+		PredefinedTarget: types.StringValue(rule.GetPredefinedTarget()),
+	}
+	if rule.GetDescription() == "" && prior.Description.IsNull() {
+		ruleModel.Description = types.StringNull()
+	}
+	if strings.EqualFold(prior.Protocol.ValueString(), rule.GetProtocolName()) {
+		ruleModel.Protocol = prior.Protocol
+	}
+
+	port, fromPort, toPort := FlattenRulePorts(rule)
+	ruleModel.Port = types.Int64Value(port)
+	ruleModel.FromPort = types.Int64Value(fromPort)
+	ruleModel.ToPort = types.Int64Value(toPort)
+	if priorPorts, err := ExpandRulePorts(prior.Port.ValueInt64(), prior.FromPort.ValueInt64(), prior.ToPort.ValueInt64()); err == nil &&
+		!prior.Port.IsNull() && !prior.Port.IsUnknown() && proto.Equal(priorPorts, rule.GetPorts()) {
+		ruleModel.Port, ruleModel.FromPort, ruleModel.ToPort = prior.Port, prior.FromPort, prior.ToPort
+	}
+
+	return ruleModel, diags
+}
+
+// expandRuleSpec converts the rule to the API specification of a new rule.
+func expandRuleSpec(ctx context.Context, direction vpc.SecurityGroupRule_Direction, rule *securityGroupRuleModel) (*vpc.SecurityGroupRuleSpec, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	ports, err := ExpandRulePorts(rule.Port.ValueInt64(), rule.FromPort.ValueInt64(), rule.ToPort.ValueInt64())
+	if err != nil {
+		diags.AddError(
+			"Failed to construct PortRange",
+			fmt.Sprintf("Error while constructing PortRange: %s", err.Error()),
+		)
+		return nil, diags
+	}
+
+	spec := &vpc.SecurityGroupRuleSpec{
+		Description: rule.Description.ValueString(),
+		Direction:   direction,
+		Ports:       ports,
+		Protocol: &vpc.SecurityGroupRuleSpec_ProtocolName{
+			ProtocolName: strings.ToUpper(rule.Protocol.ValueString()),
+		},
+	}
+	if !rule.Labels.IsNull() && !rule.Labels.IsUnknown() {
+		labels := make(map[string]string, len(rule.Labels.Elements()))
+		diags.Append(rule.Labels.ElementsAs(ctx, &labels, false)...)
+		spec.SetLabels(labels)
+	}
+	if target := rule.SecurityGroupID.ValueString(); target != "" {
+		spec.SetSecurityGroupId(target)
+	}
+	if target := rule.PredefinedTarget.ValueString(); target != "" {
+		spec.SetPredefinedTarget(target)
+	}
+	v4Cidrs, v6Cidrs := listToStrings(ctx, rule.V4CidrBlocks), listToStrings(ctx, rule.V6CidrBlocks)
+	if len(v4Cidrs) > 0 || len(v6Cidrs) > 0 {
+		spec.SetCidrBlocks(&vpc.CidrBlocks{
+			V4CidrBlocks: v4Cidrs,
+			V6CidrBlocks: v6Cidrs,
+		})
+	}
+
+	return spec, diags
+}
+
+// expandRuleSpecs converts the ingress and egress rules to the API specifications.
+func expandRuleSpecs(ctx context.Context, ingress, egress types.Set) ([]*vpc.SecurityGroupRuleSpec, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var specs []*vpc.SecurityGroupRuleSpec
+
+	for direction, set := range map[vpc.SecurityGroupRule_Direction]types.Set{
+		vpc.SecurityGroupRule_INGRESS: ingress,
+		vpc.SecurityGroupRule_EGRESS:  egress,
+	} {
+		rules, d := rulesFromSet(ctx, set)
+		diags.Append(d...)
+		for i := range rules {
+			spec, d := expandRuleSpec(ctx, direction, &rules[i])
+			diags.Append(d...)
+			if spec != nil {
+				specs = append(specs, spec)
+			}
 		}
 	}
 
-	ingress, diagnostics := types.SetValue(ruleType, ingressValues)
-	diags.Append(diagnostics...)
-	egress, diagnostics := types.SetValue(ruleType, egressValues)
-	diags.Append(diagnostics...)
+	return specs, diags
+}
 
-	return ingress, egress, diags
+func expandLabels(ctx context.Context, labels types.Map) (map[string]string, diag.Diagnostics) {
+	result := make(map[string]string, len(labels.Elements()))
+	if labels.IsNull() || labels.IsUnknown() {
+		return result, nil
+	}
+	diags := labels.ElementsAs(ctx, &result, false)
+	return result, diags
 }
 
 func SplitCidrs(cidrs *vpc.CidrBlocks) ([]string, []string) {
 	return cidrs.GetV4CidrBlocks(), cidrs.GetV6CidrBlocks()
 }
 
+func ExpandRulePorts(port, fromPort, toPort int64) (*vpc.PortRange, error) {
+	if port == -1 && fromPort == -1 && toPort == -1 {
+		return nil, nil
+	}
+
+	if port != -1 {
+		if fromPort != -1 || toPort != -1 {
+			return nil, fmt.Errorf("cannot set from_port/to_port with port")
+		}
+		fromPort = port
+		toPort = port
+	} else if fromPort == -1 || toPort == -1 {
+		return nil, fmt.Errorf("port or from_port + to_port must be defined")
+	}
+
+	return &vpc.PortRange{FromPort: fromPort, ToPort: toPort}, nil
+}
+
 func FlattenRulePorts(g *vpc.SecurityGroupRule) (port, fromPort, toPort int64) {
 	port = -1
 	fromPort = -1
@@ -0,0 +1,209 @@
+package vpc_security_group
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+)
+
+func testRuleModel(id types.String, protocol string, port int64, cidrs ...string) securityGroupRuleModel {
+	v4Cidrs, _ := types.ListValueFrom(context.Background(), types.StringType, cidrs)
+	return securityGroupRuleModel{
+		ID:               id,
+		Description:      types.StringNull(),
+		Labels:           types.MapUnknown(types.StringType),
+		Protocol:         types.StringValue(protocol),
+		Port:             types.Int64Value(port),
+		FromPort:         types.Int64Value(-1),
+		ToPort:           types.Int64Value(-1),
+		V4CidrBlocks:     v4Cidrs,
+		V6CidrBlocks:     types.ListUnknown(types.StringType),
+		SecurityGroupID:  types.StringUnknown(),
+		PredefinedTarget: types.StringUnknown(),
+	}
+}
+
+func TestRuleBody(t *testing.T) {
+	ctx := context.Background()
+
+	rule := testRuleModel(types.StringUnknown(), "tcp", 443, "10.0.0.0/24")
+	rangeRule := rule
+	rangeRule.Port = types.Int64Value(-1)
+	rangeRule.FromPort = types.Int64Value(443)
+	rangeRule.ToPort = types.Int64Value(443)
+
+	apiRule := &vpc.SecurityGroupRule{
+		Direction:    vpc.SecurityGroupRule_INGRESS,
+		ProtocolName: "TCP",
+		Ports:        &vpc.PortRange{FromPort: 443, ToPort: 443},
+		Target: &vpc.SecurityGroupRule_CidrBlocks{
+			CidrBlocks: &vpc.CidrBlocks{V4CidrBlocks: []string{"10.0.0.0/24"}},
+		},
+	}
+
+	assert.Equal(t, ruleBodyFromAPI(apiRule), ruleBodyFromModel(ctx, &rule))
+	assert.Equal(t, ruleBodyFromModel(ctx, &rule), ruleBodyFromModel(ctx, &rangeRule))
+
+	anyPort := testRuleModel(types.StringUnknown(), "ANY", -1, "10.0.0.0/24")
+	assert.Equal(t, ruleBodyFromAPI(&vpc.SecurityGroupRule{
+		ProtocolName: "ANY",
+		Target: &vpc.SecurityGroupRule_CidrBlocks{
+			CidrBlocks: &vpc.CidrBlocks{V4CidrBlocks: []string{"10.0.0.0/24"}},
+		},
+	}), ruleBodyFromModel(ctx, &anyPort))
+}
+
+func TestMatchPlannedRules(t *testing.T) {
+	ctx := context.Background()
+
+	prior := []securityGroupRuleModel{
+		testRuleModel(types.StringValue("rule1"), "TCP", 80, "10.0.0.0/24"),
+		testRuleModel(types.StringValue("rule2"), "TCP", 443, "10.0.0.0/24"),
+		testRuleModel(types.StringValue("rule3"), "TCP", 443, "10.0.0.0/24"),
+	}
+	for i := range prior {
+		prior[i].Labels = types.MapNull(types.StringType)
+		prior[i].V6CidrBlocks = types.ListNull(types.StringType)
+		prior[i].SecurityGroupID = types.StringValue("")
+		prior[i].PredefinedTarget = types.StringValue("")
+	}
+	prior[2].Description = types.StringValue("https")
+
+	planned := []securityGroupRuleModel{
+		testRuleModel(types.StringUnknown(), "tcp", 8080, "10.0.0.0/24"),
+		testRuleModel(types.StringUnknown(), "tcp", 443, "10.0.0.0/24"),
+		testRuleModel(types.StringUnknown(), "tcp", 80, "10.0.0.0/24"),
+		testRuleModel(types.StringUnknown(), "tcp", 443, "10.0.0.0/24"),
+	}
+	planned[3].Description = types.StringValue("https")
+
+	matchPlannedRules(ctx, planned, prior)
+
+	assert.True(t, planned[0].ID.IsUnknown())
+	assert.True(t, planned[0].SecurityGroupID.IsUnknown())
+	assert.Equal(t, types.StringValue("tcp"), planned[0].Protocol)
+	assert.Equal(t, types.StringValue("TCP"), planned[1].Protocol)
+	assert.Equal(t, types.StringValue("rule2"), planned[1].ID)
+	assert.Equal(t, types.StringValue("rule1"), planned[2].ID)
+	assert.Equal(t, types.StringValue("rule3"), planned[3].ID)
+	assert.Equal(t, types.StringValue("https"), planned[3].Description)
+	assert.True(t, planned[2].Labels.IsNull())
+	assert.True(t, planned[2].V6CidrBlocks.IsNull())
+	assert.Equal(t, types.StringValue(""), planned[2].PredefinedTarget)
+}
+
+func TestMatchPlannedRulesChangedDescription(t *testing.T) {
+	ctx := context.Background()
+
+	prior := []securityGroupRuleModel{
+		testRuleModel(types.StringValue("rule1"), "TCP", 80, "10.0.0.0/24"),
+	}
+	planned := []securityGroupRuleModel{
+		testRuleModel(types.StringUnknown(), "TCP", 80, "10.0.0.0/24"),
+	}
+	planned[0].Description = types.StringValue("http")
+
+	matchPlannedRules(ctx, planned, prior)
+
+	assert.Equal(t, types.StringValue("rule1"), planned[0].ID)
+	assert.Equal(t, types.StringValue("http"), planned[0].Description)
+}
+
+func TestFlattenRules(t *testing.T) {
+	ctx := context.Background()
+
+	priorRule := testRuleModel(types.StringUnknown(), "tcp", -1, "10.0.0.0/24")
+	priorRule.FromPort = types.Int64Value(443)
+	priorRule.ToPort = types.Int64Value(443)
+	priorIngress, diags := rulesToSet(ctx, []securityGroupRuleModel{priorRule})
+	require.False(t, diags.HasError())
+
+	ingress, egress, diags := flattenRules(ctx, []*vpc.SecurityGroupRule{
+		{
+			Id:           "rule1",
+			Direction:    vpc.SecurityGroupRule_INGRESS,
+			ProtocolName: "TCP",
+			Ports:        &vpc.PortRange{FromPort: 443, ToPort: 443},
+			Target: &vpc.SecurityGroupRule_CidrBlocks{
+				CidrBlocks: &vpc.CidrBlocks{V4CidrBlocks: []string{"10.0.0.0/24"}},
+			},
+		},
+		{
+			Id:           "rule2",
+			Direction:    vpc.SecurityGroupRule_EGRESS,
+			Description:  "any",
+			ProtocolName: "ANY",
+			Target: &vpc.SecurityGroupRule_PredefinedTarget{
+				PredefinedTarget: "self_security_group",
+			},
+		},
+	}, priorIngress, types.SetNull(ruleType))
+	require.False(t, diags.HasError())
+
+	ingressRules, diags := rulesFromSet(ctx, ingress)
+	require.False(t, diags.HasError())
+	require.Len(t, ingressRules, 1)
+	assert.Equal(t, types.StringValue("rule1"), ingressRules[0].ID)
+	assert.Equal(t, types.StringValue("tcp"), ingressRules[0].Protocol)
+	assert.Equal(t, types.Int64Value(-1), ingressRules[0].Port)
+	assert.Equal(t, types.Int64Value(443), ingressRules[0].FromPort)
+	assert.True(t, ingressRules[0].Description.IsNull())
+	assert.False(t, ingressRules[0].Labels.IsNull())
+	assert.Empty(t, ingressRules[0].Labels.Elements())
+	assert.True(t, ingressRules[0].V6CidrBlocks.IsNull())
+
+	egressRules, diags := rulesFromSet(ctx, egress)
+	require.False(t, diags.HasError())
+	require.Len(t, egressRules, 1)
+	assert.Equal(t, types.StringValue("rule2"), egressRules[0].ID)
+	assert.Equal(t, types.StringValue("any"), egressRules[0].Description)
+	assert.Equal(t, types.StringValue("ANY"), egressRules[0].Protocol)
+	assert.Equal(t, types.Int64Value(-1), egressRules[0].Port)
+	assert.Equal(t, types.StringValue("self_security_group"), egressRules[0].PredefinedTarget)
+	assert.True(t, egressRules[0].V4CidrBlocks.IsNull())
+	assert.False(t, egressRules[0].Labels.IsNull())
+}
+
+func TestRuleIDs(t *testing.T) {
+	ctx := context.Background()
+
+	ingress, diags := rulesToSet(ctx, []securityGroupRuleModel{
+		testRuleModel(types.StringValue("rule1"), "TCP", 443, "10.0.0.0/24"),
+		testRuleModel(types.StringUnknown(), "TCP", 80, "10.0.0.0/24"),
+	})
+	require.False(t, diags.HasError())
+	egress, diags := rulesToSet(ctx, []securityGroupRuleModel{
+		testRuleModel(types.StringValue("rule2"), "ANY", -1, "0.0.0.0/0"),
+	})
+	require.False(t, diags.HasError())
+
+	ids, diags := ruleIDs(ctx, ingress, egress, types.SetNull(ruleType))
+	require.False(t, diags.HasError())
+	assert.Equal(t, map[string]bool{"rule1": true, "rule2": true}, ids)
+}
+
+func TestWithoutExternalRules(t *testing.T) {
+	sg := &vpc.SecurityGroup{
+		Id: "sg",
+		Rules: []*vpc.SecurityGroupRule{
+			{Id: "rule1"},
+			{Id: "external"},
+			{Id: "rule2"},
+		},
+	}
+
+	assert.Same(t, sg, withoutExternalRules(sg, nil))
+
+	filtered := withoutExternalRules(sg, func(ruleID string) bool { return ruleID == "external" })
+	var ids []string
+	for _, rule := range filtered.GetRules() {
+		ids = append(ids, rule.GetId())
+	}
+	assert.Equal(t, []string{"rule1", "rule2"}, ids)
+	assert.Equal(t, "sg", filtered.GetId())
+	assert.Len(t, sg.GetRules(), 3)
+}
@@ -3,6 +3,7 @@ package vpc_security_group
 import (
 	"context"
 	"fmt"
+	"maps"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -22,14 +24,23 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
 	ycsdk "github.com/yandex-cloud/go-sdk"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
 	"github.com/yandex-cloud/terraform-provider-yandex/common/defaultschema"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/globallock"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/validate"
 	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
 	sg_api "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/vpc_security_group/api"
+	"google.golang.org/genproto/protobuf/field_mask"
 )
 
-const YandexVPCSecurityGroupDefaultTimeout = 3 * time.Minute
+const (
+	YandexVPCSecurityGroupDefaultTimeout = 3 * time.Minute
+
+	securityGroupSchemaVersion = 1
+)
 
 var (
 	groupResourceAttributes = map[string]schema.Attribute{
@@ -81,10 +92,7 @@ var (
 		},
 		"protocol": schema.StringAttribute{
 			MarkdownDescription: "Specific network protocol. Can be one of `ANY`, `TCP`, `UDP`, `ICMP` or `IPV6_ICMP`.",
-			Optional:            true,
-			PlanModifiers: []planmodifier.String{
-				stringplanmodifier.UseStateForUnknown(),
-			},
+			Required:            true,
 		},
 		"port": schema.Int64Attribute{
 			MarkdownDescription: "Port number (if applied to a single port).",
@@ -203,9 +211,13 @@ var (
 		},
 	}
 
-	_ resource.Resource                = &securityGroupResource{}
-	_ resource.ResourceWithConfigure   = &securityGroupResource{}
-	_ resource.ResourceWithImportState = &securityGroupResource{}
+	_ resource.Resource                 = &securityGroupResource{}
+	_ resource.ResourceWithConfigure    = &securityGroupResource{}
+	_ resource.ResourceWithImportState  = &securityGroupResource{}
+	_ resource.ResourceWithIdentity     = &securityGroupResource{}
+	_ resource.ResourceWithModifyPlan   = &securityGroupResource{}
+	_ resource.ResourceWithUpgradeState = &securityGroupResource{}
+	_ resource.ResourceWithMoveState    = &securityGroupResource{}
 )
 
 type securityGroupResource struct {
@@ -221,9 +233,27 @@ func NewResource() resource.Resource {
 
 func (g *securityGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	tflog.Debug(ctx, "Initializing VPC SecurityGroup schema")
-	resp.Schema = schema.Schema{
+	resp.Schema = securityGroupSchema(ctx, securityGroupSchemaVersion)
+}
+
+// securityGroupSchema returns the schema of the given version. The schema of version 0 is the schema
+// of the SDKv2 implementation of the resource, which has the same attributes.
+func securityGroupSchema(ctx context.Context, version int64) schema.Schema {
+	attributes := groupResourceAttributes
+	if version > 0 {
+		attributes = maps.Clone(groupResourceAttributes)
+		attributes["ignore_external_rules"] = schema.BoolAttribute{
+			MarkdownDescription: "If `true`, the rules of the security group which are not in its state, e.g. the rules managed by `yandex_vpc_security_group_rule` resources, are neither read into `ingress` and `egress` nor deleted. On import all rules are read. Default is `false`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		}
+	}
+
+	return schema.Schema{
+		Version:             version,
 		MarkdownDescription: "Manages `Security Group` within the Yandex Cloud. For more information, see [Documentation](https://yandex.cloud/docs/vpc/concepts/security-groups).\n\n~> Either one `port` argument or both `from_port` and `to_port` arguments can be specified.\n\n~> If `port` or `from_port`/`to_port` aren't specified or set by -1, ANY port will be sent.\n\n~> Can't use specified port if protocol is one of `ICMP` or `IPV6_ICMP`.\n\n~> One of arguments `v4_cidr_blocks`/`v6_cidr_blocks` or `predefined_target` or `security_group_id` must be specified.\n\n",
-		Attributes:          groupResourceAttributes,
+		Attributes:          attributes,
 		Blocks: map[string]schema.Block{
 			"ingress": schema.SetNestedBlock{
 				MarkdownDescription: "A list of `Security Group rules` for network traffic in `Ingress direction`.",
@@ -246,34 +276,315 @@ func (g *securityGroupResource) Schema(ctx context.Context, req resource.SchemaR
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (g *securityGroupResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceid.ID.IdentitySchema()
+}
+
 func (g *securityGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	id := resourceid.ID.ImportID(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// ModifyPlan keeps the identity of the unchanged rules, so that a change of a single rule
+// is not shown as a replacement of all rules of the set.
+func (g *securityGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state securityGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, direction := range []struct {
+		path           path.Path
+		planned, prior types.Set
+	}{
+		{path.Root("ingress"), plan.Ingress, state.Ingress},
+		{path.Root("egress"), plan.Egress, state.Egress},
+	} {
+		if direction.planned.IsUnknown() {
+			continue
+		}
+
+		planned, diags := rulesFromSet(ctx, direction.planned)
+		resp.Diagnostics.Append(diags...)
+		prior, diags := rulesFromSet(ctx, direction.prior)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		matchPlannedRules(ctx, planned, prior)
+
+		rules, diags := rulesToSet(ctx, planned)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, direction.path, rules)...)
+	}
 }
 
 func (g *securityGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	//TODO implement me
-	panic("implement me")
+	var plan securityGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, YandexVPCSecurityGroupDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	folderID, d := validate.FolderID(plan.FolderID, &g.providerConfig.ProviderState)
+	resp.Diagnostics.Append(d)
+	labels, diags := expandLabels(ctx, plan.Labels)
+	resp.Diagnostics.Append(diags...)
+	ruleSpecs, diags := expandRuleSpecs(ctx, plan.Ingress, plan.Egress)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sgID := sg_api.CreateSecurityGroup(ctx, g.providerConfig.SDK, &resp.Diagnostics, &vpc.CreateSecurityGroupRequest{
+		FolderId:    folderID,
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		Labels:      labels,
+		NetworkId:   plan.NetworkID.ValueString(),
+		RuleSpecs:   ruleSpecs,
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = types.StringValue(sgID)
+
+	updateState(ctx, g.providerConfig.SDK, &plan.securityGroupModel, &resp.Diagnostics, false, nil)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resourceid.ID.SetIdentity(ctx, resp.Identity, sgID)...)
 }
 
 func (g *securityGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state securityGroupModel
+	var state securityGroupResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	updateState(ctx, g.providerConfig.SDK, &state, &resp.Diagnostics, false)
+	// The imported state has no value, so all rules are read.
+	if state.IgnoreExternalRules.IsNull() {
+		state.IgnoreExternalRules = types.BoolValue(false)
+	}
+	var isExternal func(ruleID string) bool
+	if state.IgnoreExternalRules.ValueBool() {
+		owned, diags := ruleIDs(ctx, state.Ingress, state.Egress)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		isExternal = func(ruleID string) bool { return !owned[ruleID] }
+	}
+
+	updateState(ctx, g.providerConfig.SDK, &state.securityGroupModel, &resp.Diagnostics, true, isExternal)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state.ID.IsUnknown() {
+		tflog.Warn(ctx, "VPC SecurityGroup not found, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resourceid.ID.SetIdentity(ctx, resp.Identity, state.ID.ValueString())...)
 }
 
 func (g *securityGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	//TODO implement me
-	panic("implement me")
+	var plan, state securityGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sgID := state.ID.ValueString()
+	mutexKV := globallock.GetMutexKV()
+	mutexKV.Lock(sgID)
+	defer mutexKV.Unlock(sgID)
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, YandexVPCSecurityGroupDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	updateReq := &vpc.UpdateSecurityGroupRequest{
+		SecurityGroupId: sgID,
+		UpdateMask:      &field_mask.FieldMask{},
+	}
+	if !plan.Name.Equal(state.Name) {
+		updateReq.Name = plan.Name.ValueString()
+		updateReq.UpdateMask.Paths = append(updateReq.UpdateMask.Paths, "name")
+	}
+	if !plan.Description.Equal(state.Description) {
+		updateReq.Description = plan.Description.ValueString()
+		updateReq.UpdateMask.Paths = append(updateReq.UpdateMask.Paths, "description")
+	}
+	if !plan.Labels.Equal(state.Labels) {
+		labels, diags := expandLabels(ctx, plan.Labels)
+		resp.Diagnostics.Append(diags...)
+		updateReq.Labels = labels
+		updateReq.UpdateMask.Paths = append(updateReq.UpdateMask.Paths, "labels")
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(updateReq.UpdateMask.Paths) > 0 {
+		sg_api.UpdateSecurityGroup(ctx, g.providerConfig.SDK, &resp.Diagnostics, updateReq)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	external := updateRules(ctx, g.providerConfig.SDK, &resp.Diagnostics, sgID, &plan.securityGroupModel, &state.securityGroupModel, plan.IgnoreExternalRules.ValueBool())
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateState(ctx, g.providerConfig.SDK, &plan.securityGroupModel, &resp.Diagnostics, false, func(ruleID string) bool { return external[ruleID] })
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resourceid.ID.SetIdentity(ctx, resp.Identity, sgID)...)
+}
+
+// updateRules brings the rules of the security group to the planned ones. The rules which keep their
+// identity in the plan are updated in place, the other rules are replaced in a single request.
+// With ignoreExternal, the rules which are not in the prior state are kept, and their IDs are returned.
+func updateRules(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, sgID string, plan, state *securityGroupModel, ignoreExternal bool) map[string]bool {
+	sg := sg_api.ReadSecurityGroup(ctx, sdk, diag, sgID)
+	if diag.HasError() {
+		return nil
+	}
+
+	external := make(map[string]bool)
+	if ignoreExternal {
+		owned, diags := ruleIDs(ctx, state.Ingress, state.Egress)
+		diag.Append(diags...)
+		if diag.HasError() {
+			return nil
+		}
+		for _, rule := range sg.GetRules() {
+			if !owned[rule.GetId()] {
+				external[rule.GetId()] = true
+			}
+		}
+	}
+
+	existing := make(map[string]*vpc.SecurityGroupRule, len(sg.GetRules()))
+	for _, rule := range sg.GetRules() {
+		existing[rule.GetId()] = rule
+	}
+
+	kept := make(map[string]bool)
+	var addRules []*vpc.SecurityGroupRuleSpec
+	for direction, set := range map[vpc.SecurityGroupRule_Direction]types.Set{
+		vpc.SecurityGroupRule_INGRESS: plan.Ingress,
+		vpc.SecurityGroupRule_EGRESS:  plan.Egress,
+	} {
+		rules, diags := rulesFromSet(ctx, set)
+		diag.Append(diags...)
+		if diag.HasError() {
+			return nil
+		}
+
+		for i := range rules {
+			rule := &rules[i]
+			current, ok := existing[rule.ID.ValueString()]
+			if ok && !kept[current.GetId()] && current.GetDirection() == direction &&
+				ruleBodyFromAPI(current) == ruleBodyFromModel(ctx, rule) {
+				kept[current.GetId()] = true
+				updateRuleMetadata(ctx, sdk, diag, sgID, current, rule)
+				if diag.HasError() {
+					return nil
+				}
+				continue
+			}
+
+			spec, diags := expandRuleSpec(ctx, direction, rule)
+			diag.Append(diags...)
+			if diag.HasError() {
+				return nil
+			}
+			addRules = append(addRules, spec)
+		}
+	}
+
+	var deleteRuleIDs []string
+	for _, rule := range sg.GetRules() {
+		if !kept[rule.GetId()] && !external[rule.GetId()] {
+			deleteRuleIDs = append(deleteRuleIDs, rule.GetId())
+		}
+	}
+
+	if len(addRules) == 0 && len(deleteRuleIDs) == 0 {
+		return external
+	}
+	tflog.Debug(ctx, "Replacing VPC SecurityGroup rules", map[string]interface{}{
+		"id":      sgID,
+		"added":   len(addRules),
+		"deleted": deleteRuleIDs,
+	})
+	sg_api.ReplaceSecurityGroupRules(ctx, sdk, diag, sgID, addRules, deleteRuleIDs)
+	return external
+}
+
+func updateRuleMetadata(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, sgID string, current *vpc.SecurityGroupRule, rule *securityGroupRuleModel) {
+	req := &vpc.UpdateSecurityGroupRuleRequest{
+		SecurityGroupId: sgID,
+		RuleId:          current.GetId(),
+		UpdateMask:      &field_mask.FieldMask{},
+	}
+
+	if rule.Description.ValueString() != current.GetDescription() {
+		req.Description = rule.Description.ValueString()
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "description")
+	}
+	if !rule.Labels.IsUnknown() {
+		labels, diags := expandLabels(ctx, rule.Labels)
+		diag.Append(diags...)
+		if !maps.Equal(labels, current.GetLabels()) {
+			req.Labels = labels
+			req.UpdateMask.Paths = append(req.UpdateMask.Paths, "labels")
+		}
+	}
+	if diag.HasError() || len(req.UpdateMask.Paths) == 0 {
+		return
+	}
+
+	sg_api.UpdateSecurityGroupRuleMetadata(ctx, sdk, diag, req)
 }
 
 func (g *securityGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state securityGroupModel
+	var state securityGroupResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -306,7 +617,9 @@ func (g *securityGroupResource) Configure(ctx context.Context, req resource.Conf
 	g.providerConfig = providerConfig
 }
 
-func updateState(ctx context.Context, sdk *ycsdk.SDK, state *securityGroupModel, diag *diag.Diagnostics, createIfMissing bool) {
+// updateState sets the state to the security group read from the API. The rules for which isExternal
+// returns true are left out of the state.
+func updateState(ctx context.Context, sdk *ycsdk.SDK, state *securityGroupModel, diag *diag.Diagnostics, createIfMissing bool, isExternal func(ruleID string) bool) {
 	sgID := state.ID.ValueString()
 	tflog.Debug(ctx, "Reading VPC SecurityGroup", map[string]interface{}{"id": sgID})
 	sg := sg_api.ReadSecurityGroup(ctx, sdk, diag, sgID)
@@ -330,6 +643,6 @@ func updateState(ctx context.Context, sdk *ycsdk.SDK, state *securityGroupModel,
 	tflog.Debug(ctx, fmt.Sprintf("updateState: VPC SecurityGroup state: %+v", state))
 	tflog.Debug(ctx, fmt.Sprintf("updateState: Received VPC SecurityGroup data: %+v", sg))
 
-	diags := securityGroupToState(ctx, sg, state)
+	diags := securityGroupToState(ctx, withoutExternalRules(sg, isExternal), state)
 	diag.Append(diags...)
 }
@@ -79,8 +79,6 @@ func sweepVPCSecurityGroupOnce(conf *provider_config.Config, id string) error {
 }
 
 func TestAccVPCSecurityGroup_UpgradeFromSDKv2(t *testing.T) {
-	t.Skip()
-
 	networkName := acctest.RandomWithPrefix("vpc-sg-upgrade-provider")
 	sg1Name := acctest.RandomWithPrefix("vpc-sg-upgrade-provider")
 
@@ -125,8 +123,6 @@ func TestAccVPCSecurityGroup_UpgradeFromSDKv2(t *testing.T) {
 }
 
 func TestAccVPCSecurityGroup_basic(t *testing.T) {
-	t.Skip()
-
 	var securityGroup vpc.SecurityGroup
 
 	networkName := acctest.RandomWithPrefix("vpc-sg-basic")
@@ -143,7 +139,7 @@ func TestAccVPCSecurityGroup_basic(t *testing.T) {
 					testAccCheckVPCSecurityGroupExists("yandex_vpc_security_group.sg1", &securityGroup),
 					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg1", "ingress.#", "1"),
 					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg1", "egress.#", "1"),
-					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg1", "ingress.0.protocol", "TCP"),
+					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg1", "ingress.0.protocol", "tcp"),
 					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg1", "ingress.0.port", "8080"),
 					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg1", "egress.0.protocol", "ANY"),
 					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg1", "egress.0.port", "-1"),
@@ -156,14 +152,24 @@ func TestAccVPCSecurityGroup_basic(t *testing.T) {
 				ResourceName:      "yandex_vpc_security_group.sg1",
 				ImportState:       true,
 				ImportStateVerify: true,
+				// The imported rules carry the protocol as returned by the API, while the
+				// configuration uses lowercase, so the rules are compared by the plan below.
+				ImportStateVerifyIgnore: []string{"ingress"},
+				ImportStatePersist:      true,
+			},
+			{
+				Config: testAccVPCSecurityGroupBasic(networkName, sg1Name),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
 			},
 		},
 	})
 }
 
 func TestAccVPCSecurityGroup_update(t *testing.T) {
-	t.Skip()
-
 	var securityGroup vpc.SecurityGroup
 	var securityGroup2 vpc.SecurityGroup
 
@@ -181,7 +187,7 @@ func TestAccVPCSecurityGroup_update(t *testing.T) {
 					testAccCheckVPCSecurityGroupExists("yandex_vpc_security_group.sg1", &securityGroup),
 					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg1", "ingress.#", "1"),
 					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg1", "egress.#", "1"),
-					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg1", "ingress.0.protocol", "TCP"),
+					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg1", "ingress.0.protocol", "tcp"),
 					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg1", "ingress.0.port", "8080"),
 					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg1", "egress.0.protocol", "ANY"),
 					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg1", "egress.0.port", "-1"),
@@ -205,14 +211,20 @@ func TestAccVPCSecurityGroup_update(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckVPCSecurityGroupExists("yandex_vpc_security_group.sg1", &securityGroup),
 					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg1", "ingress.#", "2"),
-					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg1", "ingress.0.protocol", "ICMP"),
-					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg1", "ingress.0.port", "-1"),
-					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg1", "ingress.0.to_port", "-1"),
-					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg1", "ingress.0.from_port", "-1"),
-					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg1", "ingress.1.protocol", "ANY"),
-					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg1", "ingress.1.port", "-1"),
-					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg1", "ingress.1.to_port", "-1"),
-					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg1", "ingress.1.from_port", "-1"),
+					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg1", "egress.#", "0"),
+					resource.TestCheckTypeSetElemNestedAttrs("yandex_vpc_security_group.sg1", "ingress.*", map[string]string{
+						"protocol":  "icmp",
+						"port":      "-1",
+						"from_port": "-1",
+						"to_port":   "-1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("yandex_vpc_security_group.sg1", "ingress.*", map[string]string{
+						"description": "rule2 description2",
+						"protocol":    "ANY",
+						"port":        "-1",
+						"from_port":   "-1",
+						"to_port":     "-1",
+					}),
 					test.AccCheckCreatedAtAttr("yandex_vpc_security_group.sg1"),
 				),
 			},
@@ -220,11 +232,75 @@ func TestAccVPCSecurityGroup_update(t *testing.T) {
 				ResourceName:      "yandex_vpc_security_group.sg1",
 				ImportState:       true,
 				ImportStateVerify: true,
+				// The imported rules carry the protocol as returned by the API, while the
+				// configuration uses lowercase, so the rules are compared by the plan below.
+				ImportStateVerifyIgnore: []string{"ingress"},
+				ImportStatePersist:      true,
+			},
+			{
+				Config: testAccVPCSecurityGroupBasic2(networkName, sg1Name),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
 			},
 		},
 	})
 }
 
+func TestAccVPCSecurityGroup_ignoreExternalRules(t *testing.T) {
+	var securityGroup vpc.SecurityGroup
+
+	networkName := acctest.RandomWithPrefix("vpc-sg-external")
+	sg1Name := acctest.RandomWithPrefix("vpc-sg-external")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckVPCSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupExternalRules(networkName, sg1Name, 8080),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCSecurityGroupExists("yandex_vpc_security_group.sg1", &securityGroup),
+					testAccCheckVPCSecurityGroupRulesCount(&securityGroup, 2),
+					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg1", "ignore_external_rules", "true"),
+					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg1", "ingress.#", "1"),
+					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg1", "ingress.0.port", "8080"),
+				),
+			},
+			{
+				// The standalone rule is neither shown in the plan nor deleted by the update of the inline rules.
+				Config: testAccVPCSecurityGroupExternalRules(networkName, sg1Name, 8081),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCSecurityGroupExists("yandex_vpc_security_group.sg1", &securityGroup),
+					testAccCheckVPCSecurityGroupRulesCount(&securityGroup, 2),
+					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg1", "ingress.#", "1"),
+					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg1", "ingress.0.port", "8081"),
+				),
+			},
+			{
+				Config: testAccVPCSecurityGroupExternalRules(networkName, sg1Name, 8081),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckVPCSecurityGroupRulesCount(securityGroup *vpc.SecurityGroup, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(securityGroup.GetRules()) != expected {
+			return fmt.Errorf("security group has %d rules, expected %d", len(securityGroup.GetRules()), expected)
+		}
+		return nil
+	}
+}
+
 func testAccCheckVPCSecurityGroupExists(name string, securityGroup *vpc.SecurityGroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -343,6 +419,35 @@ resource "yandex_vpc_security_group" "sg1" {
 `, networkName, sg1Name, test.GetExampleFolderID())
 }
 
+func testAccVPCSecurityGroupExternalRules(networkName, sg1Name string, port int) string {
+	return fmt.Sprintf(`
+resource "yandex_vpc_network" "foo" {
+  name = "%s"
+}
+
+resource "yandex_vpc_security_group" "sg1" {
+  name                  = "%s"
+  network_id            = "${yandex_vpc_network.foo.id}"
+  folder_id             = "%s"
+  ignore_external_rules = true
+
+  ingress {
+    protocol       = "TCP"
+    v4_cidr_blocks = ["10.0.1.0/24"]
+    port           = %d
+  }
+}
+
+resource "yandex_vpc_security_group_rule" "sgr1" {
+  security_group_binding = yandex_vpc_security_group.sg1.id
+  direction              = "ingress"
+  protocol               = "TCP"
+  v4_cidr_blocks         = ["10.0.2.0/24"]
+  port                   = 443
+}
+`, networkName, sg1Name, test.GetExampleFolderID(), port)
+}
+
 func testAccCheckVPCSecurityGroupDestroy(s *terraform.State) error {
 	config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
 
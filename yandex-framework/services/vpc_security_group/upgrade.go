@@ -0,0 +1,91 @@
+package vpc_security_group
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// UpgradeState implements resource.ResourceWithUpgradeState.
+func (g *securityGroupResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	priorSchema := securityGroupSchema(ctx, 0)
+	return map[int64]resource.StateUpgrader{
+		// State upgrade from the SDKv2 implementation of the resource (0) to 1 (Schema.Version)
+		0: {
+			PriorSchema: &priorSchema,
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				resp.Diagnostics.Append(upgradeStateFromV0(ctx, req.State, &resp.State)...)
+			},
+		},
+	}
+}
+
+// MoveState implements resource.ResourceWithMoveState. It allows to move the resource managed by the
+// SDKv2 implementation with the `moved` block, e.g. from another local name of the provider.
+func (g *securityGroupResource) MoveState(ctx context.Context) []resource.StateMover {
+	sourceSchema := securityGroupSchema(ctx, 0)
+	return []resource.StateMover{
+		{
+			SourceSchema: &sourceSchema,
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != "yandex_vpc_security_group" || req.SourceSchemaVersion != 0 || req.SourceState == nil {
+					return
+				}
+
+				tflog.Debug(ctx, "Moving VPC SecurityGroup state", map[string]interface{}{
+					"source_provider": req.SourceProviderAddress,
+				})
+				resp.Diagnostics.Append(upgradeStateFromV0(ctx, req.SourceState, &resp.TargetState)...)
+			},
+		},
+	}
+}
+
+// upgradeStateFromV0 converts the state written by the SDKv2 implementation, which stores empty
+// strings and maps instead of null values for the unset optional attributes.
+func upgradeStateFromV0(ctx context.Context, from *tfsdk.State, to *tfsdk.State) diag.Diagnostics {
+	var state securityGroupModel
+	diags := from.Get(ctx, &state)
+	if diags.HasError() {
+		return diags
+	}
+
+	state.Name = nullIfEmpty(state.Name)
+	state.Description = nullIfEmpty(state.Description)
+	if len(state.Labels.Elements()) == 0 {
+		state.Labels = types.MapNull(types.StringType)
+	}
+
+	for _, set := range []*types.Set{&state.Ingress, &state.Egress} {
+		rules, d := rulesFromSet(ctx, *set)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		for i := range rules {
+			rules[i].Description = nullIfEmpty(rules[i].Description)
+		}
+		*set, d = rulesToSet(ctx, rules)
+		diags.Append(d...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(to.Set(ctx, &securityGroupResourceModel{
+		securityGroupModel:  state,
+		IgnoreExternalRules: types.BoolValue(false),
+	})...)
+	return diags
+}
+
+func nullIfEmpty(s types.String) types.String {
+	if s.ValueString() == "" {
+		return types.StringNull()
+	}
+	return s
+}
@@ -38,6 +38,7 @@ var (
 	_ resource.Resource                   = &securityGroupRuleResource{}
 	_ resource.ResourceWithConfigure      = &securityGroupRuleResource{}
 	_ resource.ResourceWithImportState    = &securityGroupRuleResource{}
+	_ resource.ResourceWithIdentity       = &securityGroupRuleResource{}
 	_ resource.ResourceWithValidateConfig = &securityGroupRuleResource{}
 )
 
@@ -241,8 +242,16 @@ func (r *securityGroupRuleResource) Schema(ctx context.Context, req resource.Sch
 	}
 }
 
+func (r *securityGroupRuleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceid.SecurityGroupRule.IdentitySchema()
+}
+
 func (r *securityGroupRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	sgID, ruleID, err := resourceid.Deconstruct(req.ID)
+	importID := resourceid.SecurityGroupRule.ImportID(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	sgID, ruleID, err := resourceid.Deconstruct(importID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
@@ -310,6 +319,7 @@ func (r *securityGroupRuleResource) Create(ctx context.Context, req resource.Cre
 
 	updateRuleState(ctx, r.providerConfig.SDK, &plan, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resourceid.SecurityGroupRule.SetIdentity(ctx, resp.Identity, ruleResourceID(&plan))...)
 }
 
 func (r *securityGroupRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -322,6 +332,7 @@ func (r *securityGroupRuleResource) Read(ctx context.Context, req resource.ReadR
 	updateRuleState(ctx, r.providerConfig.SDK, &state, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resourceid.SecurityGroupRule.SetIdentity(ctx, resp.Identity, ruleResourceID(&state))...)
 }
 
 func (r *securityGroupRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -402,6 +413,7 @@ func (r *securityGroupRuleResource) Update(ctx context.Context, req resource.Upd
 
 	updateRuleState(ctx, r.providerConfig.SDK, &plan, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resourceid.SecurityGroupRule.SetIdentity(ctx, resp.Identity, ruleResourceID(&plan))...)
 }
 
 func (r *securityGroupRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -474,6 +486,11 @@ func (r *securityGroupRuleResource) Configure(ctx context.Context, req resource.
 	r.providerConfig = providerConfig
 }
 
+// ruleResourceID returns the composite <security_group_id>:<rule_id> identifier used for import.
+func ruleResourceID(state *securityGroupRuleModel) string {
+	return resourceid.SecurityGroupRule.Construct(state.SecurityGroupBinding.ValueString(), state.ID.ValueString())
+}
+
 func updateRuleState(ctx context.Context, sdk *ycsdk.SDK, state *securityGroupRuleModel, diag *diag.Diagnostics) {
 	sgID := state.SecurityGroupBinding.ValueString()
 	ruleID := state.ID.ValueString()
@@ -0,0 +1,197 @@
+package yandex
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/albroute"
+)
+
+// validateALBRegexes checks that all `regex` string matches nested into the value, e.g. the path
+// match of a route or the header match of an RBAC principal, are valid RE2 regular expressions.
+func validateALBRegexes(key string, value interface{}) []error {
+	var errs []error
+	switch v := value.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			if k == "regex" {
+				if expr, _ := v[k].(string); expr != "" {
+					if err := albroute.ValidateRegex(expr); err != nil {
+						errs = append(errs, fmt.Errorf("%s.regex: %w", key, err))
+					}
+				}
+				continue
+			}
+			errs = append(errs, validateALBRegexes(key+"."+k, v[k])...)
+		}
+	case []interface{}:
+		for i, item := range v {
+			errs = append(errs, validateALBRegexes(fmt.Sprintf("%s.%d", key, i), item)...)
+		}
+	}
+	return errs
+}
+
+// validateALBHostRewrite checks that the route action does not set both `host_rewrite` and `auto_host_rewrite`.
+func validateALBHostRewrite(key string, actions interface{}) error {
+	for i, action := range albBlocks(actions) {
+		action, ok := action.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		hostRewrite, _ := action["host_rewrite"].(string)
+		autoHostRewrite, _ := action["auto_host_rewrite"].(bool)
+		if hostRewrite != "" && autoHostRewrite {
+			return fmt.Errorf("%s.%d: only one of host_rewrite or auto_host_rewrite can be specified", key, i)
+		}
+	}
+	return nil
+}
+
+// validateALBRoute checks the route for the mistakes which the API reports only on apply. The prefix is
+// the key of the route in the resource, e.g. `route.0.`, or empty for the route resource.
+func validateALBRoute(prefix string, route map[string]interface{}) []error {
+	var errs []error
+	for _, key := range []string{"http_route", "grpc_route", "route_options"} {
+		errs = append(errs, validateALBRegexes(prefix+key, route[key])...)
+	}
+
+	for i, httpRoute := range albBlocks(route["http_route"]) {
+		if httpRoute, ok := httpRoute.(map[string]interface{}); ok {
+			key := fmt.Sprintf("%shttp_route.%d.http_route_action", prefix, i)
+			if err := validateALBHostRewrite(key, httpRoute["http_route_action"]); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	for i, grpcRoute := range albBlocks(route["grpc_route"]) {
+		if grpcRoute, ok := grpcRoute.(map[string]interface{}); ok {
+			key := fmt.Sprintf("%sgrpc_route.%d.grpc_route_action", prefix, i)
+			if err := validateALBHostRewrite(key, grpcRoute["grpc_route_action"]); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errs
+}
+
+// validateALBShadowedRoutes checks that every route of the virtual host can match a request, i.e. no earlier
+// route matches all its requests. The routes with unknown matches are not checked.
+func validateALBShadowedRoutes(routes []interface{}, known func(key string) bool) []error {
+	matches := make([]*apploadbalancer.Route, len(routes))
+	for i, route := range routes {
+		if route, ok := route.(map[string]interface{}); ok {
+			matches[i] = albRouteMatchFromMap(fmt.Sprintf("route.%d.", i), route, known)
+		}
+	}
+
+	var errs []error
+	for _, s := range albroute.FindShadowed(matches) {
+		errs = append(errs, fmt.Errorf(
+			"route.%d (%q) never matches any request, because route.%d (%q) before it matches all its requests",
+			s.RouteIndex, s.RouteName, s.ShadowedBy, matches[s.ShadowedBy].GetName(),
+		))
+	}
+	return errs
+}
+
+// albRouteMatchFromMap builds a route with the matches of the `route` block only, which is enough to
+// find the shadowed routes. It returns nil if any of the matches is unknown.
+func albRouteMatchFromMap(prefix string, route map[string]interface{}, known func(key string) bool) *apploadbalancer.Route {
+	result := &apploadbalancer.Route{}
+	result.Name, _ = route["name"].(string)
+
+	if httpRoutes := albBlocks(route["http_route"]); len(httpRoutes) > 0 {
+		httpRoute, _ := httpRoutes[0].(map[string]interface{})
+		key := prefix + "http_route.0.http_match"
+		if !known(key) {
+			return nil
+		}
+
+		match := &apploadbalancer.HttpRouteMatch{}
+		if httpMatches := albBlocks(httpRoute["http_match"]); len(httpMatches) > 0 {
+			httpMatch, _ := httpMatches[0].(map[string]interface{})
+			path, ok := albStringMatchFromMap(key+".0.path", httpMatch["path"], known)
+			if !ok || !known(key+".0.http_method") {
+				return nil
+			}
+			match.Path = path
+			if methods, ok := httpMatch["http_method"].(*schema.Set); ok {
+				for _, method := range methods.List() {
+					match.HttpMethod = append(match.HttpMethod, method.(string))
+				}
+			}
+		}
+
+		result.SetHttp(&apploadbalancer.HttpRoute{Match: match})
+		return result
+	}
+
+	if grpcRoutes := albBlocks(route["grpc_route"]); len(grpcRoutes) > 0 {
+		grpcRoute, _ := grpcRoutes[0].(map[string]interface{})
+		key := prefix + "grpc_route.0.grpc_match"
+		if !known(key) {
+			return nil
+		}
+
+		match := &apploadbalancer.GrpcRouteMatch{}
+		if grpcMatches := albBlocks(grpcRoute["grpc_match"]); len(grpcMatches) > 0 {
+			grpcMatch, _ := grpcMatches[0].(map[string]interface{})
+			fqmn, ok := albStringMatchFromMap(key+".0.fqmn", grpcMatch["fqmn"], known)
+			if !ok {
+				return nil
+			}
+			match.Fqmn = fqmn
+		}
+
+		result.SetGrpc(&apploadbalancer.GrpcRoute{Match: match})
+		return result
+	}
+
+	return nil
+}
+
+// albStringMatchFromMap converts the `path` or `fqmn` block. It returns false if the match is unknown.
+func albStringMatchFromMap(key string, value interface{}, known func(key string) bool) (*apploadbalancer.StringMatch, bool) {
+	matches := albBlocks(value)
+	if len(matches) == 0 {
+		return nil, known(key)
+	}
+	match, _ := matches[0].(map[string]interface{})
+
+	result := &apploadbalancer.StringMatch{}
+	for _, kind := range []string{"exact", "prefix", "regex"} {
+		if !known(key + ".0." + kind) {
+			return nil, false
+		}
+	}
+	exact, _ := match["exact"].(string)
+	prefix, _ := match["prefix"].(string)
+	regex, _ := match["regex"].(string)
+	switch {
+	case exact != "":
+		result.SetExactMatch(exact)
+	case prefix != "":
+		result.SetPrefixMatch(prefix)
+	case regex != "":
+		result.SetRegexMatch(regex)
+	default:
+		return nil, true
+	}
+	return result, true
+}
+
+// albBlocks returns the blocks of a nested block list, or nil if the value is not a list.
+func albBlocks(value interface{}) []interface{} {
+	result, _ := value.([]interface{})
+	return result
+}
@@ -0,0 +1,93 @@
+package yandex
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitALBValidateRoute(t *testing.T) {
+	t.Parallel()
+
+	type M = map[string]interface{}
+	type S = []interface{}
+
+	t.Run("valid", func(t *testing.T) {
+		errs := validateALBRoute("route.0.", M{
+			"http_route": S{M{
+				"http_match":        S{M{"path": S{M{"regex": "/api/v[0-9]+/.*"}}}},
+				"http_route_action": S{M{"host_rewrite": "api.internal", "auto_host_rewrite": false}},
+			}},
+		})
+		assert.Empty(t, errs)
+	})
+
+	t.Run("invalid-regex", func(t *testing.T) {
+		errs := validateALBRoute("route.1.", M{
+			"grpc_route": S{M{
+				"grpc_match": S{M{"fqmn": S{M{"regex": "/helloworld.(Greeter"}}}},
+			}},
+			"route_options": S{M{"rbac": S{M{"principals": S{M{"and_principals": S{M{"header": S{M{
+				"name":  "x-user",
+				"value": S{M{"regex": "[a-z"}},
+			}}}}}}}}}},
+		})
+		require.Len(t, errs, 2)
+		assert.Contains(t, errs[0].Error(), "route.1.grpc_route.0.grpc_match.0.fqmn.0.regex")
+		assert.Contains(t, errs[1].Error(), "route.1.route_options.0.rbac.0.principals.0.and_principals.0.header.0.value.0.regex")
+	})
+
+	t.Run("host-rewrite", func(t *testing.T) {
+		errs := validateALBRoute("", M{
+			"http_route": S{M{
+				"http_route_action": S{M{"host_rewrite": "api.internal", "auto_host_rewrite": true}},
+			}},
+		})
+		require.Len(t, errs, 1)
+		assert.EqualError(t, errs[0], "http_route.0.http_route_action.0: only one of host_rewrite or auto_host_rewrite can be specified")
+	})
+}
+
+func TestUnitALBValidateShadowedRoutes(t *testing.T) {
+	t.Parallel()
+
+	type M = map[string]interface{}
+	type S = []interface{}
+
+	httpRoute := func(name string, path M, methods ...interface{}) M {
+		match := M{"http_method": schema.NewSet(schema.HashString, methods)}
+		if path != nil {
+			match["path"] = S{path}
+		}
+		return M{"name": name, "http_route": S{M{"http_match": S{match}}}}
+	}
+	routes := S{
+		httpRoute("api", M{"prefix": "/api/"}),
+		httpRoute("api-users", M{"exact": "/api/users"}),
+		httpRoute("upload", M{"prefix": "/upload"}, "POST"),
+		httpRoute("upload-get", M{"prefix": "/upload/files"}, "GET"),
+		httpRoute("items", M{"regex": "/items/.*"}),
+		httpRoute("item", M{"exact": "/items/1"}),
+		M{"name": "grpc", "grpc_route": S{M{"grpc_match": S{M{"fqmn": S{M{"prefix": "/"}}}}}}},
+		M{"name": "grpc-greeter", "grpc_route": S{M{"grpc_match": S{M{"fqmn": S{M{"exact": "/helloworld.Greeter/SayHello"}}}}}}},
+	}
+
+	errs := validateALBShadowedRoutes(routes, func(string) bool { return true })
+	require.Len(t, errs, 2)
+	assert.EqualError(t, errs[0], `route.1 ("api-users") never matches any request, because route.0 ("api") before it matches all its requests`)
+	assert.EqualError(t, errs[1], `route.7 ("grpc-greeter") never matches any request, because route.6 ("grpc") before it matches all its requests`)
+
+	unknown := func(key string) bool { return key != "route.0.http_route.0.http_match.0.path.0.prefix" }
+	errs = validateALBShadowedRoutes(routes, unknown)
+	require.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Error(), "route.7")
+
+	errs = validateALBShadowedRoutes(S{
+		httpRoute("default", nil),
+		httpRoute("api", M{"prefix": "/api/"}, "GET"),
+	}, func(string) bool { return true })
+	require.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Error(), `route.1 ("api")`)
+}
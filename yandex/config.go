@@ -26,18 +26,12 @@ import (
 	"google.golang.org/grpc/metadata"
 
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/config"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/iamtoken"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/logging"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/workloadidentity"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/ycprofile"
 )
 
-type iamToken struct {
-	Token     string
-	expiresAt time.Time
-}
-
-func (t iamToken) IsValid() bool {
-	return t.Token != "" && t.expiresAt.After(time.Now())
-}
-
 type Config struct {
 	Endpoint                       string
 	FolderID                       string
@@ -66,6 +60,13 @@ type Config struct {
 	SharedCredentialsFile string
 	Profile               string
 
+	// ImpersonateServiceAccountID is the service account the provider acts as. Its IAM tokens
+	// are issued with the credentials the provider is configured with.
+	ImpersonateServiceAccountID string
+
+	// WorkloadIdentity is set when the provider authenticates by exchanging JWT of the CI job for IAM token.
+	WorkloadIdentity *workloadidentity.Config
+
 	// contextWithClientTraceID is a context that has client-trace-id in its metadata
 	// It is initialized from stopContext at the same time as ycsdk.SDK
 	contextWithClientTraceID context.Context
@@ -74,7 +75,10 @@ type Config struct {
 	sdk               *ycsdk.SDK
 	sharedCredentials *SharedCredentials
 	defaultS3Client   *s3.Client
-	iamToken          *iamToken
+	iamTokenSource    *iamtoken.Source
+
+	// cliProfile is the yc CLI profile that authenticates the provider when no credentials are configured.
+	cliProfile *ycprofile.Profile
 }
 
 // this function return context with added client trace id
@@ -103,6 +107,12 @@ func (c *Config) initAndValidate(stopContext context.Context, terraformVersion s
 	if err != nil {
 		return err
 	}
+	if c.ImpersonateServiceAccountID != "" {
+		// Tokens of the impersonated service account are issued with the resolved credentials by the SDK itself.
+		credentials = iamtoken.NewImpersonatedCredentials(credentials, c.ImpersonateServiceAccountID, func() iamtoken.IAMTokenClient {
+			return c.sdk.IAM().IamToken()
+		})
+	}
 
 	yandexSDKConfig := &ycsdk.Config{
 		Credentials: credentials,
@@ -152,6 +162,9 @@ func (c *Config) initAndValidate(stopContext context.Context, terraformVersion s
 		return err
 	}
 
+	// S3 and YMQ clients authenticate with tokens of the same credentials as the SDK.
+	c.iamTokenSource = iamtoken.NewSDKSource(c.sdk)
+
 	err = c.initSharedCredentials()
 	if err != nil {
 		return err
@@ -161,7 +174,8 @@ func (c *Config) initAndValidate(stopContext context.Context, terraformVersion s
 }
 
 func (c *Config) initSharedCredentials() error {
-	if c.SharedCredentialsFile == "" {
+	// yc CLI config is applied to the provider settings by providerConfigure.
+	if c.SharedCredentialsFile == "" || ycprofile.IsConfigFile(c.SharedCredentialsFile) {
 		return nil
 	}
 
@@ -187,21 +201,23 @@ func (c *Config) initializeDefaultS3Client(ctx context.Context) (err error) {
 	}
 
 	accessKey, secretKey := c.resolveStorageAccessKeys()
-	iamToken, err := c.getIAMToken(ctx)
-	if err != nil {
-		log.Println("[WARN] Failed to get IAM token for default storage client:", err)
-		iamToken = ""
-	}
-
-	if (accessKey == "" || secretKey == "") && iamToken == "" {
-		return nil
+	if accessKey == "" || secretKey == "" {
+		// The token is requested for every storage request, here we only check that it can be issued.
+		if _, err := c.getIAMToken(ctx); err != nil {
+			log.Println("[WARN] Failed to get IAM token for default storage client:", err)
+			return nil
+		}
 	}
 
-	c.defaultS3Client, err = s3.NewClient(ctx, accessKey, secretKey, iamToken, c.StorageEndpoint)
+	c.defaultS3Client, err = s3.NewClient(ctx, accessKey, secretKey, c.getIAMToken, c.StorageEndpoint)
 	return err
 }
 
 func (c *Config) credentials() (ycsdk.Credentials, error) {
+	if c.WorkloadIdentity != nil {
+		return workloadidentity.NewCredentials(*c.WorkloadIdentity)
+	}
+
 	if c.ServiceAccountKeyFileOrContent != "" {
 		contents, _, err := pathOrContents(c.ServiceAccountKeyFileOrContent)
 		if err != nil {
@@ -222,33 +238,23 @@ func (c *Config) credentials() (ycsdk.Credentials, error) {
 		return ycsdk.OAuthToken(c.Token), nil
 	}
 
+	if c.cliProfile != nil && c.cliProfile.HasCredentials() {
+		return c.cliProfile.Credentials()
+	}
+
 	if sa := ycsdk.InstanceServiceAccount(); checkServiceAccountAvailable(c.Context(), sa) {
 		return sa, nil
 	}
 
 	return nil, fmt.Errorf(
-		"one of 'token' or 'service_account_key_file' should be specified; if you are inside compute instance, you can attach service account to it in order to authenticate via instance service account",
+		"one of 'token', 'service_account_key_file', 'workload_identity' or yc CLI 'profile' should be specified; if you are inside compute instance, you can attach service account to it in order to authenticate via instance service account",
 	)
 }
 
+// getIAMToken returns IAM token of the provider credentials. The token is cached and shared
+// by all resources, it is refreshed in background before it expires.
 func (c *Config) getIAMToken(ctx context.Context) (string, error) {
-	if c.iamToken != nil && c.iamToken.IsValid() {
-		return c.iamToken.Token, nil
-	}
-
-	resp, err := c.sdk.CreateIAMToken(ctx)
-	if err != nil {
-		return "", fmt.Errorf("failed to get IAM token: %w", err)
-	}
-
-	c.iamToken = &iamToken{
-		Token: resp.IamToken,
-	}
-	if resp.ExpiresAt != nil && resp.ExpiresAt.IsValid() {
-		c.iamToken.expiresAt = resp.ExpiresAt.AsTime()
-	}
-
-	return c.iamToken.Token, nil
+	return c.iamTokenSource.Token(ctx)
 }
 
 func iamKeyFromJSONContent(content string) (*iamkey.Key, error) {
@@ -25,9 +25,9 @@ func TestAccDataSourceALBLoadBalancer_byID(t *testing.T) {
 	var loadBalancer apploadbalancer.LoadBalancer
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckALBLoadBalancerDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckALBLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDataSourceALBLoadBalancerConfigByID(albName, albDesc),
@@ -54,9 +54,9 @@ func TestAccDataSourceALBLoadBalancer_byName(t *testing.T) {
 	var loadBalancer apploadbalancer.LoadBalancer
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckALBLoadBalancerDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckALBLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDataSourceALBLoadBalancerConfigByName(albName, albDesc),
@@ -85,9 +85,9 @@ func TestAccDataSourceALBLoadBalancer_streamListener(t *testing.T) {
 	listenerPath := ""
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckALBLoadBalancerDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckALBLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testALBLoadBalancerConfig_basic(albResource),
@@ -151,9 +151,9 @@ func TestAccDataSourceALBLoadBalancer_httpListenerWithHTTP2Options(t *testing.T)
 	listenerPath := ""
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckALBLoadBalancerDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckALBLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testALBLoadBalancerConfig_basic(albResource),
@@ -214,9 +214,9 @@ func TestAccDataSourceALBLoadBalancer_httpListenerWithAllowHTTP10(t *testing.T)
 	listenerPath := ""
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckALBLoadBalancerDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckALBLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testALBLoadBalancerConfig_basic(albResource),
@@ -277,9 +277,9 @@ func TestAccDataSourceALBLoadBalancer_httpListenerWithRewriteRequestID(t *testin
 	listenerPath := ""
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckALBLoadBalancerDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckALBLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testALBLoadBalancerConfig_basic(albResource),
@@ -339,9 +339,9 @@ func TestAccDataSourceALBLoadBalancer_httpListenerWithRedirects(t *testing.T) {
 	listenerPath := ""
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckALBLoadBalancerDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckALBLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testALBLoadBalancerConfig_basic(albResource),
@@ -392,9 +392,9 @@ func TestAccDataSourceALBLoadBalancer_tlsListenerWithStreamHandler(t *testing.T)
 	listenerPath := ""
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckALBLoadBalancerDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckALBLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testALBLoadBalancerConfig_basic(albResource),
@@ -458,9 +458,9 @@ func TestAccDataSourceALBLoadBalancer_tlsListenerWithHTTP2Options(t *testing.T)
 	listenerPath := ""
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckALBLoadBalancerDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckALBLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testALBLoadBalancerConfig_basic(albResource),
@@ -521,9 +521,9 @@ func TestAccDataSourceALBLoadBalancer_tlsListenerWithAllowHTTP10(t *testing.T) {
 	listenerPath := ""
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckALBLoadBalancerDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckALBLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testALBLoadBalancerConfig_basic(albResource),
@@ -584,9 +584,9 @@ func TestAccDataSourceALBLoadBalancer_tlsListenerRewriteRequestID(t *testing.T)
 	listenerPath := ""
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckALBLoadBalancerDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckALBLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testALBLoadBalancerConfig_basic(albResource),
@@ -644,9 +644,9 @@ func TestAccDataSourceALBLoadBalancer_logOptions(t *testing.T) {
 	var rulesPath string
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckALBLoadBalancerDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckALBLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testALBLoadBalancerConfig_basic(albResource),
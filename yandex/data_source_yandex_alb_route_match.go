@@ -0,0 +1,253 @@
+package yandex
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/albroute"
+)
+
+func dataSourceYandexALBRouteMatch() *schema.Resource {
+	return &schema.Resource{
+		Description: "Evaluates the routes of an [Application Load Balancer Virtual Host](https://yandex.cloud/docs/application-load-balancer/concepts/http-router) against a sample request locally, without calling the API. " +
+			"It returns the route which would match the request and the action which would be applied, so routing can be checked before an apply, e.g. with `terraform test`.\n\n" +
+			"The `authority` and `route` arguments have the same schema as in the `yandex_alb_virtual_host` resource. Routes are matched *in-order*: the first matching route wins.\n\n" +
+			"~> Regular expressions must match the whole path. gRPC routes match only the requests with the `content-type` header starting with `application/grpc`.\n",
+		Read: dataSourceYandexALBRouteMatchRead,
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"authority": {
+				Type:        schema.TypeSet,
+				Description: resourceYandexALBVirtualHost().Schema["authority"].Description,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+			},
+
+			"route": resourceYandexALBVirtualHost().Schema["route"],
+
+			"request": {
+				Type:        schema.TypeList,
+				Description: "The sample request to match.",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authority": {
+							Type:        schema.TypeString,
+							Description: "The host with an optional port, i.e. the `Host` or `:authority` header of the request.",
+							Required:    true,
+						},
+						"path": {
+							Type:        schema.TypeString,
+							Description: "The path of the request with an optional query string.",
+							Required:    true,
+						},
+						"method": {
+							Type:        schema.TypeString,
+							Description: "The HTTP method of the request. Default is `GET`.",
+							Optional:    true,
+							Default:     "GET",
+						},
+						"scheme": {
+							Type:        schema.TypeString,
+							Description: "The scheme of the request, used to build the redirect URL. Default is `http`.",
+							Optional:    true,
+							Default:     "http",
+						},
+						"headers": {
+							Type:        schema.TypeMap,
+							Description: "The headers of the request.",
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"authority_matched": {
+				Type:        schema.TypeBool,
+				Description: "Whether the authority of the request matches the `authority` of the virtual host.",
+				Computed:    true,
+			},
+
+			"matched": {
+				Type:        schema.TypeBool,
+				Description: "Whether a route matches the request.",
+				Computed:    true,
+			},
+
+			"route_index": {
+				Type:        schema.TypeInt,
+				Description: "The index of the matched route in `route`, or `-1` if no route matches.",
+				Computed:    true,
+			},
+
+			"route_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the matched route.",
+				Computed:    true,
+			},
+
+			"action": {
+				Type:        schema.TypeString,
+				Description: "The action of the matched route. One of `http_route_action`, `redirect_action`, `direct_response_action`, `grpc_route_action` or `grpc_status_response_action`.",
+				Computed:    true,
+			},
+
+			"backend_group_id": {
+				Type:        schema.TypeString,
+				Description: "The backend group the request is routed to.",
+				Computed:    true,
+			},
+
+			"rewritten_path": {
+				Type:        schema.TypeString,
+				Description: "The path forwarded to the backend group after `prefix_rewrite`, without the query string.",
+				Computed:    true,
+			},
+
+			"rewritten_authority": {
+				Type:        schema.TypeString,
+				Description: "The authority forwarded to the backend group after `host_rewrite`. Empty with `auto_host_rewrite`.",
+				Computed:    true,
+			},
+
+			"auto_host_rewrite": {
+				Type:        schema.TypeBool,
+				Description: "Whether the authority is rewritten to the host of the backend.",
+				Computed:    true,
+			},
+
+			"redirect_url": {
+				Type:        schema.TypeString,
+				Description: "The URL the request is redirected to.",
+				Computed:    true,
+			},
+
+			"redirect_response_code": {
+				Type:        schema.TypeString,
+				Description: "The response code of the redirect.",
+				Computed:    true,
+			},
+
+			"direct_response_status": {
+				Type:        schema.TypeInt,
+				Description: "The HTTP status code of the direct response.",
+				Computed:    true,
+			},
+
+			"direct_response_body": {
+				Type:        schema.TypeString,
+				Description: "The body of the direct response.",
+				Computed:    true,
+			},
+
+			"grpc_status": {
+				Type:        schema.TypeString,
+				Description: "The gRPC status of the gRPC status response.",
+				Computed:    true,
+			},
+
+			"shadowed_routes": {
+				Type:        schema.TypeList,
+				Description: "The routes which never match any request because an earlier route matches all their requests, e.g. an exact route after a prefix route with the prefix of its path. Routes with `regex` matches are not checked.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"route_index": {
+							Type:        schema.TypeInt,
+							Description: "The index of the shadowed route.",
+							Computed:    true,
+						},
+						"route_name": {
+							Type:        schema.TypeString,
+							Description: "The name of the shadowed route.",
+							Computed:    true,
+						},
+						"shadowed_by": {
+							Type:        schema.TypeInt,
+							Description: "The index of the earlier route which matches all requests of the shadowed route.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceYandexALBRouteMatchRead(d *schema.ResourceData, meta interface{}) error {
+	routes, err := expandALBRoutes(d)
+	if err != nil {
+		return fmt.Errorf("error expanding routes while matching ALB routes: %w", err)
+	}
+
+	authority, err := expandALBStringListFromSchemaSet(d.Get("authority"))
+	if err != nil {
+		return fmt.Errorf("error expanding authority while matching ALB routes: %w", err)
+	}
+
+	req := albroute.Request{
+		Scheme:    d.Get("request.0.scheme").(string),
+		Authority: d.Get("request.0.authority").(string),
+		Method:    d.Get("request.0.method").(string),
+		Path:      d.Get("request.0.path").(string),
+		Headers:   make(map[string]string),
+	}
+	for name, value := range d.Get("request.0.headers").(map[string]interface{}) {
+		req.Headers[name] = value.(string)
+	}
+
+	result, err := albroute.Match(authority, routes, req)
+	if err != nil {
+		return fmt.Errorf("error matching ALB routes: %w", err)
+	}
+
+	var shadowed []map[string]interface{}
+	for _, s := range albroute.FindShadowed(routes) {
+		shadowed = append(shadowed, map[string]interface{}{
+			"route_index": s.RouteIndex,
+			"route_name":  s.RouteName,
+			"shadowed_by": s.ShadowedBy,
+		})
+	}
+
+	d.SetId(albRouteMatchID(req))
+	d.Set("authority_matched", result.AuthorityMatched)
+	d.Set("matched", result.RouteIndex >= 0)
+	d.Set("route_index", result.RouteIndex)
+	d.Set("route_name", result.RouteName)
+	d.Set("action", result.Action)
+	d.Set("backend_group_id", result.BackendGroupID)
+	d.Set("rewritten_path", result.Path)
+	d.Set("rewritten_authority", result.Authority)
+	d.Set("auto_host_rewrite", result.AutoHostRewrite)
+	d.Set("redirect_url", result.RedirectURL)
+	d.Set("redirect_response_code", result.RedirectResponseCode)
+	d.Set("direct_response_status", result.DirectResponseStatus)
+	d.Set("direct_response_body", result.DirectResponseBody)
+	d.Set("grpc_status", result.GRPCStatus)
+	return d.Set("shadowed_routes", shadowed)
+}
+
+// albRouteMatchID identifies the data source by the sample request.
+func albRouteMatchID(req albroute.Request) string {
+	headers := make([]string, 0, len(req.Headers))
+	for name, value := range req.Headers {
+		headers = append(headers, name+"="+value)
+	}
+	sort.Strings(headers)
+
+	sum := sha256.Sum256([]byte(strings.Join(append([]string{
+		req.Scheme, req.Authority, req.Method, req.Path,
+	}, headers...), "\n")))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,91 @@
+package yandex
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitALBRouteMatchRead(t *testing.T) {
+	t.Parallel()
+
+	type M = map[string]interface{}
+	type S = []interface{}
+
+	routes := S{
+		M{
+			"name": "api",
+			"http_route": S{
+				M{
+					"http_match": S{M{"path": S{M{"prefix": "/api/"}}}},
+					"http_route_action": S{
+						M{
+							"backend_group_id": "bg-api",
+							"prefix_rewrite":   "/v1/",
+						},
+					},
+				},
+			},
+		},
+		M{
+			"name": "api-users",
+			"http_route": S{
+				M{
+					"http_match": S{M{"path": S{M{"exact": "/api/users"}}}},
+					"direct_response_action": S{
+						M{
+							"status": 404,
+							"body":   "never matches",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("matched", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, dataSourceYandexALBRouteMatch().Schema, M{
+			"authority": S{"example.com"},
+			"route":     routes,
+			"request":   S{M{"authority": "example.com", "path": "/api/users?limit=1"}},
+		})
+		require.NoError(t, dataSourceYandexALBRouteMatchRead(d, nil))
+
+		assert.NotEmpty(t, d.Id())
+		assert.Equal(t, true, d.Get("authority_matched"))
+		assert.Equal(t, true, d.Get("matched"))
+		assert.Equal(t, 0, d.Get("route_index"))
+		assert.Equal(t, "api", d.Get("route_name"))
+		assert.Equal(t, "http_route_action", d.Get("action"))
+		assert.Equal(t, "bg-api", d.Get("backend_group_id"))
+		assert.Equal(t, "/v1/users", d.Get("rewritten_path"))
+		assert.Equal(t, "example.com", d.Get("rewritten_authority"))
+		assert.Equal(t, 1, d.Get("shadowed_routes.#"))
+		assert.Equal(t, 1, d.Get("shadowed_routes.0.route_index"))
+		assert.Equal(t, "api-users", d.Get("shadowed_routes.0.route_name"))
+		assert.Equal(t, 0, d.Get("shadowed_routes.0.shadowed_by"))
+	})
+
+	t.Run("authority-not-matched", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, dataSourceYandexALBRouteMatch().Schema, M{
+			"authority": S{"example.com"},
+			"route":     routes,
+			"request":   S{M{"authority": "example.org", "path": "/api/users"}},
+		})
+		require.NoError(t, dataSourceYandexALBRouteMatchRead(d, nil))
+
+		assert.Equal(t, false, d.Get("authority_matched"))
+		assert.Equal(t, false, d.Get("matched"))
+		assert.Equal(t, -1, d.Get("route_index"))
+	})
+
+	t.Run("invalid-path", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, dataSourceYandexALBRouteMatch().Schema, M{
+			"route":   routes,
+			"request": S{M{"authority": "example.com", "path": "api"}},
+		})
+		assert.Error(t, dataSourceYandexALBRouteMatchRead(d, nil))
+	})
+}
@@ -2,10 +2,10 @@ package yandex
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
 )
 
 const (
@@ -301,11 +301,6 @@ func dataSourceStringMatch() *schema.Schema {
 	}
 }
 
-func retrieveDataFromVirtualHostID(id string) (string, string) {
-	attrs := strings.Split(id, "/")
-	return attrs[0], attrs[1]
-}
-
 func dataSourceYandexALBVirtualHostRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	ctx := config.Context()
@@ -325,9 +320,12 @@ func dataSourceYandexALBVirtualHostRead(d *schema.ResourceData, meta interface{}
 	virtualHostID, virtualHostIDOk := d.GetOk("virtual_host_id")
 
 	if virtualHostIDOk {
-		httpRouterID, virtualHostName = retrieveDataFromVirtualHostID(virtualHostID.(string))
+		httpRouterID, virtualHostName, err = resourceid.DeconstructVirtualHostID(virtualHostID.(string))
+		if err != nil {
+			return err
+		}
 	} else {
-		virtualHostID = httpRouterID + "/" + virtualHostName
+		virtualHostID = resourceid.VirtualHost.Construct(httpRouterID, virtualHostName)
 	}
 
 	virtualHost, err := config.sdk.ApplicationLoadBalancer().VirtualHost().Get(ctx, &apploadbalancer.GetVirtualHostRequest{
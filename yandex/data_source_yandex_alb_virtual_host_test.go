@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
 )
 
 const albVirtualHostDataSourceResource = "data.yandex_alb_virtual_host.test-virtual-host-ds"
@@ -360,7 +361,11 @@ func testAccDataSourceALBVirtualHostExists(n string, virtualHost *apploadbalance
 			httpRouterID = ds.Primary.Attributes["http_router_id"]
 			virtualHostName = ds.Primary.Attributes["name"]
 		} else {
-			httpRouterID, virtualHostName = retrieveDataFromVirtualHostID(ds.Primary.ID)
+			var err error
+			httpRouterID, virtualHostName, err = resourceid.DeconstructVirtualHostID(ds.Primary.ID)
+			if err != nil {
+				return err
+			}
 		}
 
 		config := testAccProvider.Meta().(*Config)
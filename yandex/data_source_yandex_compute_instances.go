@@ -0,0 +1,142 @@
+package yandex
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+)
+
+func dataSourceYandexComputeInstances() *schema.Resource {
+	item := &schema.Resource{
+		Schema: listItemCommonSchema(),
+	}
+	item.Schema["zone"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: common.ResourceDescriptions["zone"],
+		Computed:    true,
+	}
+	item.Schema["platform_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The type of virtual machine.",
+		Computed:    true,
+	}
+	item.Schema["status"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The status of the instance.",
+		Computed:    true,
+	}
+	item.Schema["fqdn"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The fully qualified DNS name of this instance.",
+		Computed:    true,
+	}
+	item.Schema["service_account_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "ID of the service account authorized for this instance.",
+		Computed:    true,
+	}
+	item.Schema["network_interface"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Network interfaces of the instance.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"index": {
+					Type:        schema.TypeString,
+					Description: "The index of the network interface.",
+					Computed:    true,
+				},
+				"subnet_id": {
+					Type:        schema.TypeString,
+					Description: "ID of the subnet to which the network interface is attached.",
+					Computed:    true,
+				},
+				"ip_address": {
+					Type:        schema.TypeString,
+					Description: "The private IP address of the network interface.",
+					Computed:    true,
+				},
+				"nat_ip_address": {
+					Type:        schema.TypeString,
+					Description: "The public IP address of the network interface.",
+					Computed:    true,
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Description: "Get information about Yandex Compute instances in the folder. For more information, see [the official documentation](https://yandex.cloud/docs/compute/concepts/vm).",
+		Read:        dataSourceYandexComputeInstancesRead,
+		Schema:      listDataSourceSchema("instances", "List of the instances.", item),
+	}
+}
+
+func dataSourceYandexComputeInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx := config.Context()
+
+	folderID, err := getFolderID(d, config)
+	if err != nil {
+		return err
+	}
+	filter := d.Get("filter").(string)
+	selector := listDataSourceLabelSelector(d)
+
+	var instances []map[string]interface{}
+	it := config.sdk.Compute().Instance().InstanceIterator(ctx, &compute.ListInstancesRequest{
+		FolderId: folderID,
+		Filter:   filter,
+	})
+	for it.Next() {
+		instance := it.Value()
+		if !matchLabels(selector, instance.Labels) {
+			continue
+		}
+		instances = append(instances, flattenComputeInstancesItem(instance))
+	}
+	if err := it.Error(); err != nil {
+		return fmt.Errorf("error while listing instances in folder %q: %w", folderID, err)
+	}
+
+	d.Set("folder_id", folderID)
+	if err := d.Set("instances", instances); err != nil {
+		return err
+	}
+	d.SetId(listDataSourceID(folderID, filter, selector))
+
+	return nil
+}
+
+func flattenComputeInstancesItem(instance *compute.Instance) map[string]interface{} {
+	var networkInterfaces []map[string]interface{}
+	for _, iface := range instance.NetworkInterfaces {
+		networkInterface := map[string]interface{}{
+			"index":     iface.Index,
+			"subnet_id": iface.SubnetId,
+		}
+		if address := iface.GetPrimaryV4Address(); address != nil {
+			networkInterface["ip_address"] = address.Address
+			networkInterface["nat_ip_address"] = address.GetOneToOneNat().GetAddress()
+		}
+		networkInterfaces = append(networkInterfaces, networkInterface)
+	}
+
+	return map[string]interface{}{
+		"id":                 instance.Id,
+		"name":               instance.Name,
+		"description":        instance.Description,
+		"folder_id":          instance.FolderId,
+		"labels":             instance.Labels,
+		"created_at":         getTimestamp(instance.CreatedAt),
+		"zone":               instance.ZoneId,
+		"platform_id":        instance.PlatformId,
+		"status":             strings.ToLower(instance.Status.String()),
+		"fqdn":               instance.Fqdn,
+		"service_account_id": instance.ServiceAccountId,
+		"network_interface":  networkInterfaces,
+	}
+}
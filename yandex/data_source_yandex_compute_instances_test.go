@@ -0,0 +1,57 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceComputeInstances_filter(t *testing.T) {
+	t.Parallel()
+
+	instanceName := fmt.Sprintf("data-instances-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckComputeInstanceDestroy,
+			testAccCheckYandexKmsSymmetricKeyAllDestroyed,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceComputeInstanceResourceConfig(instanceName) + computeInstancesDataConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.yandex_compute_instances.by_filter", "folder_id", getExampleFolderID()),
+					resource.TestCheckResourceAttr("data.yandex_compute_instances.by_filter", "instances.#", "1"),
+					resource.TestCheckResourceAttrPair("data.yandex_compute_instances.by_filter", "instances.0.id", "yandex_compute_instance.foo", "id"),
+					resource.TestCheckResourceAttr("data.yandex_compute_instances.by_filter", "instances.0.name", instanceName),
+					resource.TestCheckResourceAttr("data.yandex_compute_instances.by_filter", "instances.0.zone", "ru-central1-a"),
+					resource.TestCheckResourceAttr("data.yandex_compute_instances.by_filter", "instances.0.status", "running"),
+					resource.TestCheckResourceAttr("data.yandex_compute_instances.by_filter", "instances.0.labels.my_key", "my_value"),
+					resource.TestCheckResourceAttr("data.yandex_compute_instances.by_filter", "instances.0.network_interface.#", "1"),
+					resource.TestCheckResourceAttrPair("data.yandex_compute_instances.by_filter", "instances.0.network_interface.0.subnet_id", "yandex_vpc_subnet.inst-test-subnet", "id"),
+					resource.TestCheckResourceAttr("data.yandex_compute_instances.by_labels", "instances.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const computeInstancesDataConfig = `
+data "yandex_compute_instances" "by_filter" {
+  filter = "name=\"${yandex_compute_instance.foo.name}\""
+  labels = {
+    my_key = "my_value"
+  }
+}
+
+data "yandex_compute_instances" "by_labels" {
+  filter = "name=\"${yandex_compute_instance.foo.name}\""
+  labels = {
+    my_key = "other_value"
+  }
+}
+`
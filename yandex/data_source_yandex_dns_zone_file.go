@@ -0,0 +1,164 @@
+package yandex
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/zonefile"
+)
+
+const yandexDnsZoneFileDefaultTTL = 3600
+
+func dataSourceYandexDnsZoneFile() *schema.Resource {
+	return &schema.Resource{
+		Description: "Converts between DNS zone files in the [RFC 1035](https://www.rfc-editor.org/rfc/rfc1035#section-5) format and DNS record sets.\n\n" +
+			"With `content` the zone file is parsed locally into `recordsets`, which can be used to create `yandex_dns_recordset` resources. " +
+			"With `dns_zone_id` the record sets of the DNS Zone are read and rendered as a zone file into `content`.\n\n" +
+			"~> One of `content` or `dns_zone_id` should be specified.\n\n" +
+			"~> The `$INCLUDE` and `$GENERATE` directives are not supported. The character strings of multi-string TXT records are concatenated into a single value.\n",
+		Read: dataSourceYandexDnsZoneFileRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(yandexDnsDefaultTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"content": {
+				Type:         schema.TypeString,
+				Description:  "The zone file to parse. If `dns_zone_id` is specified, the zone file rendered from the record sets of the DNS Zone.",
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"content", "dns_zone_id"},
+			},
+
+			"dns_zone_id": {
+				Type:         schema.TypeString,
+				Description:  "The ID of the DNS Zone to render as a zone file.",
+				Optional:     true,
+				ExactlyOneOf: []string{"content", "dns_zone_id"},
+			},
+
+			"origin": {
+				Type:        schema.TypeString,
+				Description: "The domain relative names in `content` belong to until the `$ORIGIN` directive. If `dns_zone_id` is specified, the zone of the DNS Zone.",
+				Optional:    true,
+				Computed:    true,
+			},
+
+			"default_ttl": {
+				Type:         schema.TypeInt,
+				Description:  "The TTL of records in `content` without TTL before the `$TTL` directive. Default is `3600`.",
+				Optional:     true,
+				Default:      yandexDnsZoneFileDefaultTTL,
+				ValidateFunc: validation.IntBetween(0, zonefile.MaxTTL),
+			},
+
+			"recordsets": {
+				Type:        schema.TypeList,
+				Description: "The record sets of the zone in the order of their first occurrence in `content`.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The fully qualified DNS name of the record set.",
+							Computed:    true,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Description: resourceYandexDnsRecordSet().Schema["type"].Description,
+							Computed:    true,
+						},
+						"ttl": {
+							Type:        schema.TypeInt,
+							Description: resourceYandexDnsRecordSet().Schema["ttl"].Description,
+							Computed:    true,
+						},
+						"data": {
+							Type:        schema.TypeList,
+							Description: resourceYandexDnsRecordSet().Schema["data"].Description,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceYandexDnsZoneFileRead(d *schema.ResourceData, meta interface{}) error {
+	var (
+		recordSets []zonefile.RecordSet
+		origin     = d.Get("origin").(string)
+		content    = d.Get("content").(string)
+		err        error
+	)
+
+	if zoneID, ok := d.GetOk("dns_zone_id"); ok {
+		origin, recordSets, err = readDnsZoneRecordSets(zoneID.(string), meta)
+		if err != nil {
+			return err
+		}
+		content = zonefile.Render(origin, recordSets)
+		d.SetId(zoneID.(string))
+	} else {
+		recordSets, err = zonefile.Parse(content, origin, int64(d.Get("default_ttl").(int)))
+		if err != nil {
+			return fmt.Errorf("error parsing zone file: %s", err)
+		}
+		sum := sha256.Sum256([]byte(content))
+		d.SetId(hex.EncodeToString(sum[:]))
+	}
+
+	flattened := make([]map[string]interface{}, 0, len(recordSets))
+	for _, rs := range recordSets {
+		flattened = append(flattened, map[string]interface{}{
+			"name": rs.Name,
+			"type": rs.Type,
+			"ttl":  rs.TTL,
+			"data": rs.Data,
+		})
+	}
+
+	d.Set("content", content)
+	d.Set("origin", origin)
+	return d.Set("recordsets", flattened)
+}
+
+func readDnsZoneRecordSets(zoneID string, meta interface{}) (string, []zonefile.RecordSet, error) {
+	config := meta.(*Config)
+	sdk := getSDK(config)
+	ctx := config.Context()
+
+	zone, err := sdk.DNS().DnsZone().Get(ctx, &dns.GetDnsZoneRequest{
+		DnsZoneId: zoneID,
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("error reading DnsZone %q: %s", zoneID, err)
+	}
+
+	list, err := listDnsZoneRecordSets(ctx, config, zoneID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	recordSets := make([]zonefile.RecordSet, 0, len(list))
+	for _, rs := range list {
+		recordSets = append(recordSets, zonefile.RecordSet{
+			Name: rs.Name,
+			Type: rs.Type,
+			TTL:  rs.Ttl,
+			Data: rs.Data,
+		})
+	}
+
+	return zone.Zone, recordSets, nil
+}
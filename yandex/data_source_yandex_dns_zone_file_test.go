@@ -0,0 +1,77 @@
+package yandex
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceDNSZoneFile_basic(t *testing.T) {
+	t.Parallel()
+
+	zoneName := acctest.RandomWithPrefix("tf-dns-zone")
+	fqdn := acctest.RandomWithPrefix("tf-test") + ".dnstest.test."
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDnsZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDnsZoneFileConfig(zoneName, fqdn),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.yandex_dns_zone_file.parsed", "recordsets.#", "3"),
+					resource.TestCheckResourceAttr("data.yandex_dns_zone_file.parsed", "recordsets.0.name", "srv."+fqdn),
+					resource.TestCheckResourceAttr("data.yandex_dns_zone_file.parsed", "recordsets.0.ttl", "200"),
+					resource.TestCheckResourceAttr("data.yandex_dns_zone_file.parsed", "recordsets.0.data.#", "2"),
+					resource.TestCheckResourceAttr("data.yandex_dns_zone_file.parsed", "recordsets.2.data.0", "v=spf1 -all"),
+					resource.TestCheckResourceAttr("data.yandex_dns_zone_file.rendered", "origin", fqdn),
+					resource.TestMatchResourceAttr("data.yandex_dns_zone_file.rendered", "content",
+						regexp.MustCompile(`(?m)^srv\t200\tIN\tA\t10\.1\.0\.1$`)),
+					resource.TestMatchResourceAttr("data.yandex_dns_zone_file.rendered", "content",
+						regexp.MustCompile(`(?m)^www\t300\tIN\tCNAME\tsrv\.`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceDnsZoneFileConfig(name, fqdn string) string {
+	return fmt.Sprintf(`
+resource "yandex_dns_zone" "zone1" {
+  name   = "%[1]s"
+  zone   = "%[2]s"
+  public = true
+}
+
+data "yandex_dns_zone_file" "parsed" {
+  origin  = yandex_dns_zone.zone1.zone
+  content = <<-EOT
+    $TTL 300
+    srv 200 IN A 10.1.0.1
+            IN A 10.1.0.2
+    www CNAME srv
+    @   TXT "v=spf1 " "-all"
+  EOT
+}
+
+resource "yandex_dns_recordset" "parsed" {
+  for_each = { for rs in data.yandex_dns_zone_file.parsed.recordsets : "${rs.name} ${rs.type}" => rs }
+
+  zone_id = yandex_dns_zone.zone1.id
+  name    = each.value.name
+  type    = each.value.type
+  ttl     = each.value.ttl
+  data    = each.value.data
+}
+
+data "yandex_dns_zone_file" "rendered" {
+  dns_zone_id = yandex_dns_zone.zone1.id
+
+  depends_on = [yandex_dns_recordset.parsed]
+}
+`, name, fqdn)
+}
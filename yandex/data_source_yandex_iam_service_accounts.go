@@ -0,0 +1,63 @@
+package yandex
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+)
+
+func dataSourceYandexIAMServiceAccounts() *schema.Resource {
+	item := &schema.Resource{
+		Schema: listItemCommonSchema(),
+	}
+
+	return &schema.Resource{
+		Description: "Get information about Yandex IAM service accounts in the folder. For more information, see [the official documentation](https://yandex.cloud/docs/iam/concepts/users/service-accounts).",
+		Read:        dataSourceYandexIAMServiceAccountsRead,
+		Schema:      listDataSourceSchema("service_accounts", "List of the service accounts.", item),
+	}
+}
+
+func dataSourceYandexIAMServiceAccountsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx := config.Context()
+
+	folderID, err := getFolderID(d, config)
+	if err != nil {
+		return err
+	}
+	filter := d.Get("filter").(string)
+	selector := listDataSourceLabelSelector(d)
+
+	var serviceAccounts []map[string]interface{}
+	it := config.sdk.IAM().ServiceAccount().ServiceAccountIterator(ctx, &iam.ListServiceAccountsRequest{
+		FolderId: folderID,
+		Filter:   filter,
+	})
+	for it.Next() {
+		sa := it.Value()
+		if !matchLabels(selector, sa.Labels) {
+			continue
+		}
+		serviceAccounts = append(serviceAccounts, map[string]interface{}{
+			"id":          sa.Id,
+			"name":        sa.Name,
+			"description": sa.Description,
+			"folder_id":   sa.FolderId,
+			"labels":      sa.Labels,
+			"created_at":  getTimestamp(sa.CreatedAt),
+		})
+	}
+	if err := it.Error(); err != nil {
+		return fmt.Errorf("error while listing service accounts in folder %q: %w", folderID, err)
+	}
+
+	d.Set("folder_id", folderID)
+	if err := d.Set("service_accounts", serviceAccounts); err != nil {
+		return err
+	}
+	d.SetId(listDataSourceID(folderID, filter, selector))
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceYandexIAMServiceAccounts_filter(t *testing.T) {
+	accountName := "sa" + acctest.RandString(10)
+	accountDesc := "Service Account desc"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIAMServiceAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataServiceAccountsByFilter(accountName, accountDesc),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.yandex_iam_service_accounts.bar", "service_accounts.#", "1"),
+					resource.TestCheckResourceAttrPair("data.yandex_iam_service_accounts.bar", "service_accounts.0.id",
+						"yandex_iam_service_account.foo", "id"),
+					resource.TestCheckResourceAttr("data.yandex_iam_service_accounts.bar", "service_accounts.0.name", accountName),
+					resource.TestCheckResourceAttr("data.yandex_iam_service_accounts.bar", "service_accounts.0.description", accountDesc),
+					resource.TestCheckResourceAttr("data.yandex_iam_service_accounts.bar", "service_accounts.0.folder_id", getExampleFolderID()),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataServiceAccountsByFilter(name, desc string) string {
+	return fmt.Sprintf(`
+data "yandex_iam_service_accounts" "bar" {
+  filter = "name=\"${yandex_iam_service_account.foo.name}\""
+}
+
+resource "yandex_iam_service_account" "foo" {
+  name        = "%s"
+  description = "%s"
+}
+`, name, desc)
+}
@@ -17,9 +17,9 @@ func TestAccDataSourceMDBElasticsearchCluster_byID(t *testing.T) {
 	randInt := acctest.RandInt()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckMDBElasticsearchClusterDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckMDBElasticsearchClusterDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDataSourceMDBElasticsearchClusterConfig(esName, esDesc, randInt, true),
@@ -39,9 +39,9 @@ func TestAccDataSourceMDBElasticsearchCluster_byName(t *testing.T) {
 	randInt := acctest.RandInt()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckMDBElasticsearchClusterDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckMDBElasticsearchClusterDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDataSourceMDBElasticsearchClusterConfig(esName, esDesc, randInt, false),
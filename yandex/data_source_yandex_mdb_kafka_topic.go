@@ -2,6 +2,8 @@ package yandex
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
 )
 
 func dataSourceYandexMDBKafkaTopic() *schema.Resource {
@@ -21,7 +23,7 @@ func dataSourceYandexMDBKafkaTopic() *schema.Resource {
 func dataSourceYandexMDBKafkaTopicRead(d *schema.ResourceData, meta interface{}) error {
 	clusterID := d.Get("cluster_id").(string)
 	topicName := d.Get("name").(string)
-	topicID := constructResourceId(clusterID, topicName)
+	topicID := resourceid.Construct(clusterID, topicName)
 	d.SetId(topicID)
 	return resourceYandexMDBKafkaTopicRead(d, meta)
 }
@@ -2,6 +2,8 @@ package yandex
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
 )
 
 func dataSourceYandexMDBKafkaUser() *schema.Resource {
@@ -21,7 +23,7 @@ func dataSourceYandexMDBKafkaUser() *schema.Resource {
 func dataSourceYandexMDBKafkaUserRead(d *schema.ResourceData, meta interface{}) error {
 	clusterID := d.Get("cluster_id").(string)
 	userName := d.Get("name").(string)
-	userID := constructResourceId(clusterID, userName)
+	userID := resourceid.Construct(clusterID, userName)
 	d.SetId(userID)
 	return resourceYandexMDBKafkaUserRead(d, meta)
 }
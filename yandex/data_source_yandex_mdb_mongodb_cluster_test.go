@@ -71,9 +71,9 @@ func TestAccDataSourceMDBMongoDBCluster_byName(t *testing.T) {
 	datasourceName := "data.yandex_mdb_mongodb_cluster.bar"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckMDBMongoDBClusterDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckMDBMongoDBClusterDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: makeConfig(t, &configData, nil) + mdbMongoDBClusterByNameConfig,
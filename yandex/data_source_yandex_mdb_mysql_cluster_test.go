@@ -16,9 +16,9 @@ func TestAccDataSourceMDBMySQLCluster_byID(t *testing.T) {
 	mysqlDesc := "MySQL Cluster Terraform Datasource Test"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckMDBMysqlClusterDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckMDBMysqlClusterDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDataSourceMDBMysqlClusterConfig(mysqlName, mysqlDesc, true),
@@ -37,9 +37,9 @@ func TestAccDataSourceMDBMySQLCluster_byName(t *testing.T) {
 	mysqlDesc := "MySQL Cluster Terraform Datasource Test"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckMDBMysqlClusterDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckMDBMysqlClusterDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDataSourceMDBMysqlClusterConfig(mysqlName, mysqlDesc, false),
@@ -2,6 +2,8 @@ package yandex
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
 )
 
 func dataSourceYandexMDBMySQLDatabase() *schema.Resource {
@@ -27,7 +29,7 @@ func dataSourceYandexMDBMySQLDatabase() *schema.Resource {
 func dataSourceYandexMDBMySQLDatabaseRead(d *schema.ResourceData, meta interface{}) error {
 	clusterID := d.Get("cluster_id").(string)
 	dbname := d.Get("name").(string)
-	databaseID := constructResourceId(clusterID, dbname)
+	databaseID := resourceid.Construct(clusterID, dbname)
 	d.SetId(databaseID)
 	return resourceYandexMDBMySQLDatabaseRead(d, meta)
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mysql/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
 )
 
 func TestAccDataSourceMDBMySQLDatabase_basic(t *testing.T) {
@@ -17,9 +18,9 @@ func TestAccDataSourceMDBMySQLDatabase_basic(t *testing.T) {
 
 	clusterName := acctest.RandomWithPrefix("ds-mysql-database")
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckMDBMySQLDatabaseDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckMDBMySQLDatabaseDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDataSourceMDBMySQLDatabaseConfig(clusterName, true),
@@ -104,7 +105,7 @@ func testAccDataSourceMDBMySQLDatabaseCheckResourceIDField(resourceName string)
 			return fmt.Errorf("No ID is set")
 		}
 
-		expectedResourceId := constructResourceId(rs.Primary.Attributes["cluster_id"], rs.Primary.Attributes["name"])
+		expectedResourceId := resourceid.Construct(rs.Primary.Attributes["cluster_id"], rs.Primary.Attributes["name"])
 
 		if expectedResourceId != rs.Primary.ID {
 			return fmt.Errorf("Wrong resource %s id. Expected %s, got %s", resourceName, expectedResourceId, rs.Primary.ID)
@@ -155,7 +156,7 @@ func testAccCheckMDBMySQLDatabaseDestroy(s *terraform.State) error {
 			continue
 		}
 
-		clusterId, dbname, err := deconstructResourceId(rs.Primary.ID)
+		clusterId, dbname, err := resourceid.Deconstruct(rs.Primary.ID)
 		if err != nil {
 			return err
 		}
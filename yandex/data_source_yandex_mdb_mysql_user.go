@@ -2,6 +2,8 @@ package yandex
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
 )
 
 func dataSourceYandexMDBMySQLUser() *schema.Resource {
@@ -93,7 +95,7 @@ func dataSourceYandexMDBMySQLUser() *schema.Resource {
 func dataSourceYandexMDBMySQLUserRead(d *schema.ResourceData, meta interface{}) error {
 	clusterID := d.Get("cluster_id").(string)
 	username := d.Get("name").(string)
-	userID := constructResourceId(clusterID, username)
+	userID := resourceid.Construct(clusterID, username)
 	d.SetId(userID)
 	return resourceYandexMDBMySQLUserRead(d, meta)
 }
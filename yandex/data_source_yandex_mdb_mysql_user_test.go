@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mysql/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
 )
 
 func TestAccDataSourceMDBMySQLUser_basic(t *testing.T) {
@@ -17,9 +18,9 @@ func TestAccDataSourceMDBMySQLUser_basic(t *testing.T) {
 
 	clusterName := acctest.RandomWithPrefix("ds-mysql-user")
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckMDBMySQLUserDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckMDBMySQLUserDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDataSourceMDBMySQLUserConfig(clusterName, true),
@@ -154,7 +155,7 @@ func testAccDataSourceMDBMySQLUserCheckResourceIDField(resourceName string) reso
 			return fmt.Errorf("No ID is set")
 		}
 
-		expectedResourceId := constructResourceId(rs.Primary.Attributes["cluster_id"], rs.Primary.Attributes["name"])
+		expectedResourceId := resourceid.Construct(rs.Primary.Attributes["cluster_id"], rs.Primary.Attributes["name"])
 
 		if expectedResourceId != rs.Primary.ID {
 			return fmt.Errorf("Wrong resource %s id. Expected %s, got %s", resourceName, expectedResourceId, rs.Primary.ID)
@@ -227,7 +228,7 @@ func testAccCheckMDBMySQLUserDestroy(s *terraform.State) error {
 			continue
 		}
 
-		clusterId, username, err := deconstructResourceId(rs.Primary.ID)
+		clusterId, username, err := resourceid.Deconstruct(rs.Primary.ID)
 		if err != nil {
 			return err
 		}
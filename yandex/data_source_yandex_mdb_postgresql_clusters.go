@@ -0,0 +1,93 @@
+package yandex
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+)
+
+func dataSourceYandexMDBPostgreSQLClusters() *schema.Resource {
+	item := &schema.Resource{
+		Schema: listItemCommonSchema(),
+	}
+	item.Schema["network_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "ID of the network to which the PostgreSQL cluster belongs.",
+		Computed:    true,
+	}
+	item.Schema["environment"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Deployment environment of the PostgreSQL cluster.",
+		Computed:    true,
+	}
+	item.Schema["version"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Version of the PostgreSQL cluster.",
+		Computed:    true,
+	}
+	item.Schema["health"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Aggregated health of the cluster.",
+		Computed:    true,
+	}
+	item.Schema["status"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Status of the cluster.",
+		Computed:    true,
+	}
+
+	return &schema.Resource{
+		Description: "Get information about Yandex Managed PostgreSQL clusters in the folder. For more information, see [the official documentation](https://yandex.cloud/docs/managed-postgresql/concepts).",
+		Read:        dataSourceYandexMDBPostgreSQLClustersRead,
+		Schema:      listDataSourceSchema("clusters", "List of the PostgreSQL clusters.", item),
+	}
+}
+
+func dataSourceYandexMDBPostgreSQLClustersRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx := config.Context()
+
+	folderID, err := getFolderID(d, config)
+	if err != nil {
+		return err
+	}
+	filter := d.Get("filter").(string)
+	selector := listDataSourceLabelSelector(d)
+
+	var clusters []map[string]interface{}
+	it := config.sdk.MDB().PostgreSQL().Cluster().ClusterIterator(ctx, &postgresql.ListClustersRequest{
+		FolderId: folderID,
+		Filter:   filter,
+	})
+	for it.Next() {
+		cluster := it.Value()
+		if !matchLabels(selector, cluster.Labels) {
+			continue
+		}
+		clusters = append(clusters, map[string]interface{}{
+			"id":          cluster.Id,
+			"name":        cluster.Name,
+			"description": cluster.Description,
+			"folder_id":   cluster.FolderId,
+			"labels":      cluster.Labels,
+			"created_at":  getTimestamp(cluster.CreatedAt),
+			"network_id":  cluster.NetworkId,
+			"environment": cluster.GetEnvironment().String(),
+			"version":     cluster.GetConfig().GetVersion(),
+			"health":      cluster.GetHealth().String(),
+			"status":      cluster.GetStatus().String(),
+		})
+	}
+	if err := it.Error(); err != nil {
+		return fmt.Errorf("error while listing PostgreSQL clusters in folder %q: %w", folderID, err)
+	}
+
+	d.Set("folder_id", folderID)
+	if err := d.Set("clusters", clusters); err != nil {
+		return err
+	}
+	d.SetId(listDataSourceID(folderID, filter, selector))
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package yandex
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceMDBPostgreSQLClusters_filter(t *testing.T) {
+	t.Parallel()
+
+	version := postgresql_versions[len(postgresql_versions)-1]
+	pgName := acctest.RandomWithPrefix("ds-postgresql-clusters")
+	pgDesc := "PostgreSQL Cluster Terraform Datasource Test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckMDBPGClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBPGClusterConfigMain(pgName, pgDesc, "PRESTABLE", version, false) + mdbPGClustersByFilterConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.yandex_mdb_postgresql_clusters.bar", "clusters.#", "1"),
+					resource.TestCheckResourceAttrPair("data.yandex_mdb_postgresql_clusters.bar", "clusters.0.id",
+						"yandex_mdb_postgresql_cluster.foo", "id"),
+					resource.TestCheckResourceAttr("data.yandex_mdb_postgresql_clusters.bar", "clusters.0.name", pgName),
+					resource.TestCheckResourceAttr("data.yandex_mdb_postgresql_clusters.bar", "clusters.0.description", pgDesc),
+					resource.TestCheckResourceAttr("data.yandex_mdb_postgresql_clusters.bar", "clusters.0.environment", "PRESTABLE"),
+					resource.TestCheckResourceAttr("data.yandex_mdb_postgresql_clusters.bar", "clusters.0.version", version),
+					resource.TestCheckResourceAttrPair("data.yandex_mdb_postgresql_clusters.bar", "clusters.0.network_id",
+						"yandex_mdb_postgresql_cluster.foo", "network_id"),
+				),
+			},
+		},
+	})
+}
+
+const mdbPGClustersByFilterConfig = `
+data "yandex_mdb_postgresql_clusters" "bar" {
+  filter = "name=\"${yandex_mdb_postgresql_cluster.foo.name}\""
+}
+`
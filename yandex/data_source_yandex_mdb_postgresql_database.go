@@ -4,6 +4,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
 )
 
 func dataSourceYandexMDBPostgreSQLDatabase() *schema.Resource {
@@ -75,7 +76,7 @@ func dataSourceYandexMDBPostgreSQLDatabase() *schema.Resource {
 func dataSourceYandexMDBPostgreSQLDatabaseRead(d *schema.ResourceData, meta interface{}) error {
 	clusterID := d.Get("cluster_id").(string)
 	dbname := d.Get("name").(string)
-	databaseID := constructResourceId(clusterID, dbname)
+	databaseID := resourceid.Construct(clusterID, dbname)
 	d.SetId(databaseID)
 	return resourceYandexMDBPostgreSQLDatabaseRead(d, meta)
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
 )
 
 func TestAccDataSourceMDBPostgreSQLDatabase_basic(t *testing.T) {
@@ -19,9 +20,9 @@ func TestAccDataSourceMDBPostgreSQLDatabase_basic(t *testing.T) {
 	description := "PostgreSQL Database Terraform Datasource Test"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckMDBPostgreSQLDatabaseDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckMDBPostgreSQLDatabaseDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDataSourceMDBPostgreSQLDatabaseConfig(clusterName, description, true),
@@ -133,7 +134,7 @@ func testAccDataSourceMDBPGDatabaseCheckResourceIDField(resourceName string) res
 			return fmt.Errorf("No ID is set")
 		}
 
-		expectedResourceId := constructResourceId(rs.Primary.Attributes["cluster_id"], rs.Primary.Attributes["name"])
+		expectedResourceId := resourceid.Construct(rs.Primary.Attributes["cluster_id"], rs.Primary.Attributes["name"])
 
 		if expectedResourceId != rs.Primary.ID {
 			return fmt.Errorf("Wrong resource %s id. Expected %s, got %s", resourceName, expectedResourceId, rs.Primary.ID)
@@ -196,7 +197,7 @@ func testAccCheckMDBPostgreSQLDatabaseDestroy(s *terraform.State) error {
 			continue
 		}
 
-		clusterId, dbname, err := deconstructResourceId(rs.Primary.ID)
+		clusterId, dbname, err := resourceid.Deconstruct(rs.Primary.ID)
 		if err != nil {
 			return err
 		}
@@ -4,6 +4,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
 )
 
 func dataSourceYandexMDBPostgreSQLUser() *schema.Resource {
@@ -91,7 +92,7 @@ func dataSourceYandexMDBPostgreSQLUser() *schema.Resource {
 func dataSourceYandexMDBPostgreSQLUserRead(d *schema.ResourceData, meta interface{}) error {
 	clusterID := d.Get("cluster_id").(string)
 	username := d.Get("name").(string)
-	userID := constructResourceId(clusterID, username)
+	userID := resourceid.Construct(clusterID, username)
 	d.SetId(userID)
 	return resourceYandexMDBPostgreSQLUserRead(d, meta)
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
 )
 
 func TestAccDataSourceMDBPostgreSQLUser_basic(t *testing.T) {
@@ -19,9 +20,9 @@ func TestAccDataSourceMDBPostgreSQLUser_basic(t *testing.T) {
 	description := "PostgreSQL User Terraform Datasource Test"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckMDBPostgreSQLUserDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckMDBPostgreSQLUserDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDataSourceMDBPostgreSQLUserConfig(clusterName, description, true),
@@ -123,7 +124,7 @@ func testAccDataSourceMDBPGUserCheckResourceIDField(resourceName string) resourc
 			return fmt.Errorf("No ID is set")
 		}
 
-		expectedResourceId := constructResourceId(rs.Primary.Attributes["cluster_id"], rs.Primary.Attributes["name"])
+		expectedResourceId := resourceid.Construct(rs.Primary.Attributes["cluster_id"], rs.Primary.Attributes["name"])
 
 		if expectedResourceId != rs.Primary.ID {
 			return fmt.Errorf("Wrong resource %s id. Expected %s, got %s", resourceName, expectedResourceId, rs.Primary.ID)
@@ -180,7 +181,7 @@ func testAccCheckMDBPostgreSQLUserDestroy(s *terraform.State) error {
 			continue
 		}
 
-		clusterId, username, err := deconstructResourceId(rs.Primary.ID)
+		clusterId, username, err := resourceid.Deconstruct(rs.Primary.ID)
 		if err != nil {
 			return err
 		}
@@ -20,9 +20,9 @@ func TestAccDataSourceMDBSQLServerCluster_byID(t *testing.T) {
 	sqlserverDesc := "SQLServer Cluster Terraform Datasource Test"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckMDBSQLServerClusterDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckMDBSQLServerClusterDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDataSourceMDBSQLServerClusterConfig(sqlserverName, sqlserverDesc, true),
@@ -44,9 +44,9 @@ func TestAccDataSourceMDBSQLServerCluster_byName(t *testing.T) {
 	sqlserverDesc := "SQLServer Cluster Terraform Datasource Test"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckMDBSQLServerClusterDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckMDBSQLServerClusterDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDataSourceMDBSQLServerClusterConfig(sqlserverName, sqlserverDesc, false),
@@ -0,0 +1,242 @@
+package yandex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"mime"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/storage/s3"
+)
+
+// storageObjectBodyMaxSize limits the size of the object content read into the `body` attribute.
+const storageObjectBodyMaxSize = 1 << 20
+
+// storageObjectTextContentTypes are content types besides `text/*` whose content is read into the `body` attribute.
+var storageObjectTextContentTypes = map[string]bool{
+	"application/atom+xml":     true,
+	"application/ecmascript":   true,
+	"application/javascript":   true,
+	"application/json":         true,
+	"application/ld+json":      true,
+	"application/rss+xml":      true,
+	"application/x-javascript": true,
+	"application/x-sh":         true,
+	"application/x-yaml":       true,
+	"application/xhtml+xml":    true,
+	"application/xml":          true,
+	"application/yaml":         true,
+	"image/svg+xml":            true,
+}
+
+func dataSourceYandexStorageObject() *schema.Resource {
+	resourceSchema := resourceYandexStorageObject().Schema
+
+	return &schema.Resource{
+		Description: "Get information about an object in Yandex Cloud Object Storage. For more information, see [the official documentation](https://yandex.cloud/docs/storage/concepts/object).\n\n" +
+			"~> The object content is read into `body` only for objects with a human-readable `Content-Type` (`text/*`, `application/json`, etc.) up to 1 MiB in size, so binary or large objects are not loaded into the Terraform state.\n",
+
+		ReadContext: dataSourceYandexStorageObjectRead,
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Description: resourceSchema["bucket"].Description,
+				Required:    true,
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Description: "The name of the object in the bucket.",
+				Required:    true,
+			},
+
+			// Credentials
+			"access_key": {
+				Type:        schema.TypeString,
+				Description: resourceSchema["access_key"].Description,
+				Optional:    true,
+			},
+			"secret_key": {
+				Type:        schema.TypeString,
+				Description: resourceSchema["secret_key"].Description,
+				Optional:    true,
+				Sensitive:   true,
+			},
+
+			// Computed
+			"body": {
+				Type:        schema.TypeString,
+				Description: "The content of the object. It is only set for objects with a human-readable `content_type` up to 1 MiB in size.",
+				Computed:    true,
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Description: resourceSchema["content_type"].Description,
+				Computed:    true,
+			},
+			"content_length": {
+				Type:        schema.TypeInt,
+				Description: "The size of the object content in bytes.",
+				Computed:    true,
+			},
+			"last_modified": {
+				Type:        schema.TypeString,
+				Description: "The date and time in RFC3339 format when the object was last modified.",
+				Computed:    true,
+			},
+			"cache_control": {
+				Type:        schema.TypeString,
+				Description: resourceSchema["cache_control"].Description,
+				Computed:    true,
+			},
+			"content_disposition": {
+				Type:        schema.TypeString,
+				Description: resourceSchema["content_disposition"].Description,
+				Computed:    true,
+			},
+			"content_encoding": {
+				Type:        schema.TypeString,
+				Description: resourceSchema["content_encoding"].Description,
+				Computed:    true,
+			},
+			"content_language": {
+				Type:        schema.TypeString,
+				Description: resourceSchema["content_language"].Description,
+				Computed:    true,
+			},
+			"expires": {
+				Type:        schema.TypeString,
+				Description: resourceSchema["expires"].Description,
+				Computed:    true,
+			},
+			"metadata": {
+				Type:        schema.TypeMap,
+				Description: "A map of user-defined metadata stored with the object in `x-amz-meta-*` headers.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"storage_class": {
+				Type:        schema.TypeString,
+				Description: "The [storage class](https://yandex.cloud/docs/storage/concepts/storage-class) of the object.",
+				Computed:    true,
+			},
+			"kms_key_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the [KMS key](https://yandex.cloud/docs/kms/concepts/key) the object is encrypted with.",
+				Computed:    true,
+			},
+			"object_lock_legal_hold_status": {
+				Type:        schema.TypeString,
+				Description: "The [legal hold status](https://yandex.cloud/docs/storage/concepts/object-lock#types) of the object.",
+				Computed:    true,
+			},
+			"object_lock_mode": {
+				Type:        schema.TypeString,
+				Description: "The type of the object lock.",
+				Computed:    true,
+			},
+			"object_lock_retain_until_date": {
+				Type:        schema.TypeString,
+				Description: "The date and time in RFC3339 format until which the object is locked.",
+				Computed:    true,
+			},
+			"etag": {
+				Type:        schema.TypeString,
+				Description: "The entity tag of the object.",
+				Computed:    true,
+			},
+			"version_id": {
+				Type:        schema.TypeString,
+				Description: resourceSchema["version_id"].Description,
+				Computed:    true,
+			},
+			"tags": {
+				Type:        schema.TypeMap,
+				Description: "The tags of the object. See [Tags](https://yandex.cloud/docs/storage/concepts/tags) for more information.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceYandexStorageObjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	s3Client, err := getS3Client(ctx, d, config)
+	if err != nil {
+		return diag.Errorf("error getting storage client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+
+	object, err := s3Client.GetObject(ctx, bucket, key)
+	if err != nil {
+		if errors.Is(err, s3.ErrObjectNotFound) {
+			return diag.Errorf("storage object %q not found in bucket %q", key, bucket)
+		}
+		return diag.FromErr(err)
+	}
+
+	body := ""
+	switch {
+	case !isStorageObjectTextContent(object.ContentType):
+		log.Printf("[INFO] Ignoring body of storage object %q with content type %q", key, aws.StringValue(object.ContentType))
+	case object.ContentLength > storageObjectBodyMaxSize:
+		log.Printf("[INFO] Ignoring body of storage object %q of %d bytes", key, object.ContentLength)
+	default:
+		content, err := s3Client.ReadObjectBody(ctx, bucket, key, storageObjectBodyMaxSize)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		body = string(content)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", bucket, key))
+	d.Set("body", body)
+	d.Set("content_type", object.ContentType)
+	d.Set("content_length", object.ContentLength)
+	if !object.LastModified.IsZero() {
+		d.Set("last_modified", object.LastModified.Format(time.RFC3339))
+	}
+	d.Set("cache_control", object.CacheControl)
+	d.Set("content_disposition", object.ContentDisposition)
+	d.Set("content_encoding", object.ContentEncoding)
+	d.Set("content_language", object.ContentLanguage)
+	if object.Expires != nil {
+		d.Set("expires", object.Expires.Format(time.RFC3339))
+	}
+	if err := d.Set("metadata", object.Metadata); err != nil {
+		return diag.Errorf("error setting S3 Storage Object metadata: %s", err)
+	}
+	d.Set("storage_class", object.StorageClass)
+	d.Set("kms_key_id", object.KMSKeyID)
+	if object.ObjectLockLegalHoldStatus != nil {
+		d.Set("object_lock_legal_hold_status", *object.ObjectLockLegalHoldStatus)
+	}
+	if object.ObjectRetention != nil {
+		d.Set("object_lock_mode", object.ObjectRetention.Mode)
+		d.Set("object_lock_retain_until_date", object.ObjectRetention.RetainUntilDate.Format(time.RFC3339))
+	}
+	d.Set("etag", object.ETag)
+	d.Set("version_id", object.VersionID)
+	if err := d.Set("tags", s3.TagsToRaw(object.Tags)); err != nil {
+		return diag.Errorf("error setting S3 Storage Object Tagging: %s", err)
+	}
+
+	return nil
+}
+
+func isStorageObjectTextContent(contentType *string) bool {
+	mediaType, _, err := mime.ParseMediaType(aws.StringValue(contentType))
+	if err != nil {
+		return false
+	}
+	return strings.HasPrefix(mediaType, "text/") || storageObjectTextContentTypes[mediaType]
+}
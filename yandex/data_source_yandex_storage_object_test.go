@@ -0,0 +1,157 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceStorageObject_basic(t *testing.T) {
+	dataSourceName := "data.yandex_storage_object.test"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckStorageObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceStorageObjectConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "body", "some_bucket_content"),
+					resource.TestCheckResourceAttr(dataSourceName, "content_type", "text/plain"),
+					resource.TestCheckResourceAttr(dataSourceName, "content_length", "19"),
+					resource.TestCheckResourceAttr(dataSourceName, "etag", "3aa092e6f0fe468e376603aaeb32b5b8"),
+					resource.TestCheckResourceAttr(dataSourceName, "metadata.build", "42"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.env", "test"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "last_modified"),
+					resource.TestCheckResourceAttr("data.yandex_storage_object.binary", "body", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceStorageObjects_basic(t *testing.T) {
+	dataSourceName := "data.yandex_storage_objects.test"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckStorageObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceStorageObjectsConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "keys.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.0", "logs/0"),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.1", "logs/1"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.size", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.storage_class", "STANDARD"),
+					resource.TestCheckResourceAttr(dataSourceName, "common_prefixes.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "common_prefixes.0", "logs/archive/"),
+					resource.TestCheckResourceAttr("data.yandex_storage_objects.limited", "keys.#", "1"),
+					resource.TestCheckResourceAttr("data.yandex_storage_objects.limited", "keys.0", "logs/1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceStorageObjectConfig(randInt int) string {
+	bucketConfig := newBucketConfigBuilder(randInt).asEditor().render()
+
+	return bucketConfig + `
+resource "yandex_storage_object" "test" {
+	bucket = yandex_storage_bucket.test.bucket
+
+	access_key = yandex_iam_service_account_static_access_key.sa-key.access_key
+	secret_key = yandex_iam_service_account_static_access_key.sa-key.secret_key
+
+	key          = "test-key"
+	content      = "some_bucket_content"
+	content_type = "text/plain"
+
+	metadata = {
+		build = "42"
+	}
+	tags = {
+		env = "test"
+	}
+}
+
+resource "yandex_storage_object" "binary" {
+	bucket = yandex_storage_bucket.test.bucket
+
+	access_key = yandex_iam_service_account_static_access_key.sa-key.access_key
+	secret_key = yandex_iam_service_account_static_access_key.sa-key.secret_key
+
+	key            = "binary-key"
+	content_base64 = "AAECAw=="
+	content_type   = "application/octet-stream"
+}
+
+data "yandex_storage_object" "test" {
+	bucket = yandex_storage_object.test.bucket
+	key    = yandex_storage_object.test.key
+
+	access_key = yandex_iam_service_account_static_access_key.sa-key.access_key
+	secret_key = yandex_iam_service_account_static_access_key.sa-key.secret_key
+}
+
+data "yandex_storage_object" "binary" {
+	bucket = yandex_storage_object.binary.bucket
+	key    = yandex_storage_object.binary.key
+
+	access_key = yandex_iam_service_account_static_access_key.sa-key.access_key
+	secret_key = yandex_iam_service_account_static_access_key.sa-key.secret_key
+}
+`
+}
+
+func testAccDataSourceStorageObjectsConfig(randInt int) string {
+	bucketConfig := newBucketConfigBuilder(randInt).asEditor().render()
+
+	objectsConfig := ""
+	for i, key := range []string{"logs/0", "logs/1", "logs/archive/0", "other"} {
+		objectsConfig += fmt.Sprintf(`
+resource "yandex_storage_object" "test%[1]d" {
+	bucket = yandex_storage_bucket.test.bucket
+
+	access_key = yandex_iam_service_account_static_access_key.sa-key.access_key
+	secret_key = yandex_iam_service_account_static_access_key.sa-key.secret_key
+
+	key     = %[2]q
+	content = "log"
+}
+`, i, key)
+	}
+
+	return bucketConfig + objectsConfig + `
+data "yandex_storage_objects" "test" {
+	bucket    = yandex_storage_bucket.test.bucket
+	prefix    = "logs/"
+	delimiter = "/"
+
+	access_key = yandex_iam_service_account_static_access_key.sa-key.access_key
+	secret_key = yandex_iam_service_account_static_access_key.sa-key.secret_key
+
+	depends_on = [yandex_storage_object.test0, yandex_storage_object.test1, yandex_storage_object.test2, yandex_storage_object.test3]
+}
+
+data "yandex_storage_objects" "limited" {
+	bucket      = yandex_storage_bucket.test.bucket
+	prefix      = "logs/"
+	start_after = "logs/0"
+	max_keys    = 1
+
+	access_key = yandex_iam_service_account_static_access_key.sa-key.access_key
+	secret_key = yandex_iam_service_account_static_access_key.sa-key.secret_key
+
+	depends_on = [yandex_storage_object.test0, yandex_storage_object.test1, yandex_storage_object.test2, yandex_storage_object.test3]
+}
+`
+}
@@ -0,0 +1,158 @@
+package yandex
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/storage/s3"
+)
+
+func dataSourceYandexStorageObjects() *schema.Resource {
+	resourceSchema := resourceYandexStorageObject().Schema
+
+	return &schema.Resource{
+		Description: "Get a list of objects in a Yandex Cloud Object Storage bucket. For more information, see [the official documentation](https://yandex.cloud/docs/storage/concepts/object).",
+
+		ReadContext: dataSourceYandexStorageObjectsRead,
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Description: resourceSchema["bucket"].Description,
+				Required:    true,
+			},
+			"prefix": {
+				Type:        schema.TypeString,
+				Description: "Limits the listing to keys that begin with the prefix.",
+				Optional:    true,
+			},
+			"delimiter": {
+				Type:        schema.TypeString,
+				Description: "A character used to group keys. Keys that contain the delimiter after the `prefix` are rolled up into `common_prefixes`.",
+				Optional:    true,
+			},
+			"start_after": {
+				Type:        schema.TypeString,
+				Description: "Lists keys lexicographically after the specified key.",
+				Optional:    true,
+			},
+			"max_keys": {
+				Type:         schema.TypeInt,
+				Description:  "The maximum number of keys and common prefixes to return. The listing is paginated until the limit is reached. Default is `1000`.",
+				Optional:     true,
+				Default:      s3.DefaultListMaxKeys,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			// Credentials
+			"access_key": {
+				Type:        schema.TypeString,
+				Description: resourceSchema["access_key"].Description,
+				Optional:    true,
+			},
+			"secret_key": {
+				Type:        schema.TypeString,
+				Description: resourceSchema["secret_key"].Description,
+				Optional:    true,
+				Sensitive:   true,
+			},
+
+			// Computed
+			"keys": {
+				Type:        schema.TypeList,
+				Description: "The keys of the listed objects.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"common_prefixes": {
+				Type:        schema.TypeList,
+				Description: "The key prefixes rolled up by `delimiter`.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"objects": {
+				Type:        schema.TypeList,
+				Description: "The listed objects.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Description: "The name of the object in the bucket.",
+							Computed:    true,
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Description: "The size of the object content in bytes.",
+							Computed:    true,
+						},
+						"etag": {
+							Type:        schema.TypeString,
+							Description: "The entity tag of the object.",
+							Computed:    true,
+						},
+						"last_modified": {
+							Type:        schema.TypeString,
+							Description: "The date and time in RFC3339 format when the object was last modified.",
+							Computed:    true,
+						},
+						"storage_class": {
+							Type:        schema.TypeString,
+							Description: "The [storage class](https://yandex.cloud/docs/storage/concepts/storage-class) of the object.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceYandexStorageObjectsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	s3Client, err := getS3Client(ctx, d, config)
+	if err != nil {
+		return diag.Errorf("error getting storage client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	list, err := s3Client.ListObjects(ctx, s3.ListObjectsInput{
+		Bucket:     bucket,
+		Prefix:     d.Get("prefix").(string),
+		Delimiter:  d.Get("delimiter").(string),
+		StartAfter: d.Get("start_after").(string),
+		MaxKeys:    d.Get("max_keys").(int),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	keys := make([]string, 0, len(list.Objects))
+	objects := make([]map[string]interface{}, 0, len(list.Objects))
+	for _, object := range list.Objects {
+		keys = append(keys, object.Key)
+		objects = append(objects, map[string]interface{}{
+			"key":           object.Key,
+			"size":          object.Size,
+			"etag":          object.ETag,
+			"last_modified": object.LastModified.Format(time.RFC3339),
+			"storage_class": object.StorageClass,
+		})
+	}
+
+	d.SetId(bucket)
+	if err := d.Set("keys", keys); err != nil {
+		return diag.Errorf("error setting keys: %s", err)
+	}
+	if err := d.Set("common_prefixes", list.CommonPrefixes); err != nil {
+		return diag.Errorf("error setting common_prefixes: %s", err)
+	}
+	if err := d.Set("objects", objects); err != nil {
+		return diag.Errorf("error setting objects: %s", err)
+	}
+
+	return nil
+}
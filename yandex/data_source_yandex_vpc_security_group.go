@@ -24,7 +24,7 @@ func dataSourceYandexVPCSecurityGroup() *schema.Resource {
 
 			"network_id": {
 				Type:        schema.TypeString,
-				Description: resourceYandexVPCSecurityGroup().Schema["network_id"].Description,
+				Description: yandexVPCSecurityGroupSchema()["network_id"].Description,
 				Computed:    true,
 			},
 
@@ -58,7 +58,7 @@ func dataSourceYandexVPCSecurityGroup() *schema.Resource {
 
 			"ingress": {
 				Type:        schema.TypeSet,
-				Description: resourceYandexVPCSecurityGroup().Schema["ingress"].Description,
+				Description: yandexVPCSecurityGroupSchema()["ingress"].Description,
 				Computed:    true,
 				Elem:        dataSourceYandexSecurityGroupRule(),
 				Set:         resourceYandexVPCSecurityGroupRuleHash,
@@ -66,7 +66,7 @@ func dataSourceYandexVPCSecurityGroup() *schema.Resource {
 
 			"egress": {
 				Type:        schema.TypeSet,
-				Description: resourceYandexVPCSecurityGroup().Schema["egress"].Description,
+				Description: yandexVPCSecurityGroupSchema()["egress"].Description,
 				Computed:    true,
 				Elem:        dataSourceYandexSecurityGroupRule(),
 				Set:         resourceYandexVPCSecurityGroupRuleHash,
@@ -74,7 +74,7 @@ func dataSourceYandexVPCSecurityGroup() *schema.Resource {
 
 			"status": {
 				Type:        schema.TypeString,
-				Description: resourceYandexVPCSecurityGroup().Schema["status"].Description,
+				Description: yandexVPCSecurityGroupSchema()["status"].Description,
 				Computed:    true,
 			},
 
@@ -36,9 +36,9 @@ func TestAccDataSourceVPCSecurityGroup_byID(t *testing.T) {
 	var sg vpc.SecurityGroup
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckVPCSecurityGroupDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckVPCSecurityGroupDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDataSourceVPCSecurityGroupConfig(name, desc, true),
@@ -62,9 +62,9 @@ func TestAccDataSourceVPCSecurityGroup_byName(t *testing.T) {
 	var sg vpc.SecurityGroup
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckVPCSecurityGroupDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckVPCSecurityGroupDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDataSourceVPCSecurityGroupConfig(name, desc, false),
@@ -0,0 +1,96 @@
+package yandex
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+)
+
+func dataSourceYandexVPCSubnets() *schema.Resource {
+	item := &schema.Resource{
+		Schema: listItemCommonSchema(),
+	}
+	item.Schema["network_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: resourceYandexVPCSubnet().Schema["network_id"].Description,
+		Computed:    true,
+	}
+	item.Schema["zone"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: common.ResourceDescriptions["zone"],
+		Computed:    true,
+	}
+	item.Schema["route_table_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: resourceYandexVPCSubnet().Schema["route_table_id"].Description,
+		Computed:    true,
+	}
+	item.Schema["v4_cidr_blocks"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: resourceYandexVPCSubnet().Schema["v4_cidr_blocks"].Description,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+	item.Schema["v6_cidr_blocks"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: resourceYandexVPCSubnet().Schema["v6_cidr_blocks"].Description,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+
+	return &schema.Resource{
+		Description: "Get information about Yandex VPC subnets in the folder. For more information, see [the official documentation](https://yandex.cloud/docs/vpc/concepts/network#subnet).",
+		Read:        dataSourceYandexVPCSubnetsRead,
+		Schema:      listDataSourceSchema("subnets", "List of the subnets.", item),
+	}
+}
+
+func dataSourceYandexVPCSubnetsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx := config.Context()
+
+	folderID, err := getFolderID(d, config)
+	if err != nil {
+		return err
+	}
+	filter := d.Get("filter").(string)
+	selector := listDataSourceLabelSelector(d)
+
+	var subnets []map[string]interface{}
+	it := config.sdk.VPC().Subnet().SubnetIterator(ctx, &vpc.ListSubnetsRequest{
+		FolderId: folderID,
+		Filter:   filter,
+	})
+	for it.Next() {
+		subnet := it.Value()
+		if !matchLabels(selector, subnet.Labels) {
+			continue
+		}
+		subnets = append(subnets, map[string]interface{}{
+			"id":             subnet.Id,
+			"name":           subnet.Name,
+			"description":    subnet.Description,
+			"folder_id":      subnet.FolderId,
+			"labels":         subnet.Labels,
+			"created_at":     getTimestamp(subnet.CreatedAt),
+			"network_id":     subnet.NetworkId,
+			"zone":           subnet.ZoneId,
+			"route_table_id": subnet.RouteTableId,
+			"v4_cidr_blocks": subnet.V4CidrBlocks,
+			"v6_cidr_blocks": subnet.V6CidrBlocks,
+		})
+	}
+	if err := it.Error(); err != nil {
+		return fmt.Errorf("error while listing subnets in folder %q: %w", folderID, err)
+	}
+
+	d.Set("folder_id", folderID)
+	if err := d.Set("subnets", subnets); err != nil {
+		return err
+	}
+	d.SetId(listDataSourceID(folderID, filter, selector))
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceVPCSubnets_labels(t *testing.T) {
+	t.Parallel()
+
+	subnetName := acctest.RandomWithPrefix("tf-subnets")
+	selector := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckVPCNetworkDestroy,
+			testAccCheckVPCSubnetDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVPCSubnetsConfig(subnetName, selector),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.yandex_vpc_subnets.bar", "folder_id", getExampleFolderID()),
+					resource.TestCheckResourceAttr("data.yandex_vpc_subnets.bar", "subnets.#", "1"),
+					resource.TestCheckResourceAttrPair("data.yandex_vpc_subnets.bar", "subnets.0.id", "yandex_vpc_subnet.foo1", "id"),
+					resource.TestCheckResourceAttr("data.yandex_vpc_subnets.bar", "subnets.0.name", subnetName+"-1"),
+					resource.TestCheckResourceAttr("data.yandex_vpc_subnets.bar", "subnets.0.zone", "ru-central1-b"),
+					resource.TestCheckResourceAttr("data.yandex_vpc_subnets.bar", "subnets.0.v4_cidr_blocks.#", "1"),
+					resource.TestCheckResourceAttr("data.yandex_vpc_subnets.bar", "subnets.0.v4_cidr_blocks.0", "172.16.1.0/24"),
+					resource.TestCheckResourceAttrPair("data.yandex_vpc_subnets.bar", "subnets.0.network_id", "yandex_vpc_network.foo", "id"),
+					resource.TestCheckResourceAttr("data.yandex_vpc_subnets.by_name", "subnets.#", "1"),
+					resource.TestCheckResourceAttrPair("data.yandex_vpc_subnets.by_name", "subnets.0.id", "yandex_vpc_subnet.foo2", "id"),
+					resource.TestCheckResourceAttr("data.yandex_vpc_subnets.by_name", "subnets.0.zone", "ru-central1-d"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVPCSubnetsConfig(name, selector string) string {
+	return fmt.Sprintf(`
+resource "yandex_vpc_network" "foo" {}
+
+resource "yandex_vpc_subnet" "foo1" {
+  name           = "%[1]s-1"
+  zone           = "ru-central1-b"
+  network_id     = yandex_vpc_network.foo.id
+  v4_cidr_blocks = ["172.16.1.0/24"]
+  labels = {
+    selector = "%[2]s"
+    role     = "public"
+  }
+}
+
+resource "yandex_vpc_subnet" "foo2" {
+  name           = "%[1]s-2"
+  zone           = "ru-central1-d"
+  network_id     = yandex_vpc_network.foo.id
+  v4_cidr_blocks = ["172.16.2.0/24"]
+  labels = {
+    selector = "%[2]s"
+    role     = "private"
+  }
+}
+
+data "yandex_vpc_subnets" "bar" {
+  labels = {
+    selector = "%[2]s"
+    role     = "public"
+  }
+
+  depends_on = [yandex_vpc_subnet.foo1, yandex_vpc_subnet.foo2]
+}
+
+data "yandex_vpc_subnets" "by_name" {
+  filter = "name=\"${yandex_vpc_subnet.foo2.name}\""
+}
+`, name, selector)
+}
@@ -19,9 +19,9 @@ func TestAccDataSourceYandexYDBDatabaseDedicated_byID(t *testing.T) {
 	ydbLocationId := ydbLocationId
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testYandexYDBDatabaseDedicatedDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testYandexYDBDatabaseDedicatedDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testYandexYDBDatabaseDedicatedByID(databaseName, databaseDesc, ydbLocationId),
@@ -45,9 +45,9 @@ func TestAccDataSourceYandexYDBDatabaseDedicated_byName(t *testing.T) {
 	ydbLocationId := ydbLocationId
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testYandexYDBDatabaseDedicatedDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testYandexYDBDatabaseDedicatedDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testYandexYDBDatabaseDedicatedByName(databaseName, databaseDesc, ydbLocationId),
@@ -74,9 +74,9 @@ func TestAccDataSourceYandexYDBDatabaseDedicated_full(t *testing.T) {
 	params.ydbLocationId = ydbLocationId
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testYandexYDBDatabaseDedicatedDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testYandexYDBDatabaseDedicatedDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testYandexYDBDatabaseDedicatedDataSource(params),
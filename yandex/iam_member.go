@@ -14,6 +14,7 @@ import (
 	"google.golang.org/grpc/codes"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
 )
 
 var IamMemberBaseSchema = map[string]*schema.Schema{
@@ -52,18 +53,10 @@ func iamMemberImport(resourceIDParser resourceIDParserFunc) schema.StateContextF
 			return nil, errors.New("Import not supported for this IAM resource")
 		}
 		config := meta.(*Config)
-		s := strings.Fields(d.Id())
-		if len(s) != 3 {
-			d.SetId("")
-			return nil, fmt.Errorf("Wrong number of parts to Member id %s; expected 'resource_name role member'", s)
-		}
-		id, role, member := s[0], s[1], s[2]
-
-		// |member| part must be in TYPE:ID format.
-		chunks := strings.SplitN(member, ":", 2)
-		if len(chunks) != 2 {
+		id, role, member, err := resourceid.ParseIAMMemberImportID(d.Id())
+		if err != nil {
 			d.SetId("")
-			return nil, errors.New("Invalid member spec, must be in TYPE:ID format")
+			return nil, err
 		}
 
 		// Set the ID only to the first part so all IAM types can share the same resourceIDParserFunc.
@@ -71,14 +64,14 @@ func iamMemberImport(resourceIDParser resourceIDParserFunc) schema.StateContextF
 		d.Set("role", role)
 		d.Set("member", member)
 
-		err := resourceIDParser(d, config)
+		err = resourceIDParser(d, config)
 		if err != nil {
 			return nil, err
 		}
 
 		// Set the ID again so that the ID matches the ID it would have if it had been created via TF.
 		// Use the current ID in case it changed in the resourceIDParserFunc.
-		d.SetId(d.Id() + "/" + role + "/" + member)
+		d.SetId(resourceid.IAMMember.Construct(d.Id(), role, member))
 		return []*schema.ResourceData{d}, nil
 	}
 }
@@ -127,7 +120,7 @@ func resourceIamMemberCreate(newUpdaterFunc newResourceIamUpdaterFunc) schema.Cr
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		d.SetId(updater.GetResourceID() + "/" + member.RoleId + "/" + canonicalMember(member))
+		d.SetId(resourceid.IAMMember.Construct(updater.GetResourceID(), member.RoleId, canonicalMember(member)))
 
 		if v, ok := d.GetOk("sleep_after"); ok {
 			time.Sleep(time.Second * time.Duration(v.(int)))
@@ -0,0 +1,306 @@
+// Package albroute evaluates Application Load Balancer virtual host routes against sample
+// requests locally, without calling the API.
+package albroute
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
+)
+
+// Actions of the matched route. The names are the names of the action blocks of the route.
+const (
+	ActionHTTPRoute          = "http_route_action"
+	ActionRedirect           = "redirect_action"
+	ActionDirectResponse     = "direct_response_action"
+	ActionGRPCRoute          = "grpc_route_action"
+	ActionGRPCStatusResponse = "grpc_status_response_action"
+)
+
+// Request is a sample request to match.
+type Request struct {
+	// Scheme is used to build the redirect URL. Default is "http".
+	Scheme string
+	// Authority is the host with an optional port, i.e. the Host or :authority header.
+	Authority string
+	// Method is the HTTP method. Default is "GET".
+	Method string
+	// Path is the request path with an optional query string.
+	Path string
+	// Headers are the request headers. The request is a gRPC one if its content-type
+	// header starts with "application/grpc".
+	Headers map[string]string
+}
+
+// Result describes the route which matches the request and its action.
+type Result struct {
+	// AuthorityMatched reports whether the request authority matches the virtual host.
+	AuthorityMatched bool
+	// RouteIndex is the index of the matched route or -1 if no route matches.
+	RouteIndex int
+	RouteName  string
+	Action     string
+
+	BackendGroupID string
+	// Path is the path forwarded to the backend, after the prefix rewrite.
+	Path string
+	// Authority is the authority forwarded to the backend, after the host rewrite.
+	// It is empty with AutoHostRewrite, since the host is taken from the backend.
+	Authority       string
+	AutoHostRewrite bool
+
+	RedirectURL          string
+	RedirectResponseCode string
+
+	DirectResponseStatus int64
+	DirectResponseBody   string
+
+	GRPCStatus string
+}
+
+// Match returns the first route of the virtual host which matches the request. Routes are matched
+// in order: an HTTP route matches if both its path and its method match, a gRPC route matches gRPC
+// requests with the full method name, i.e. the path, matching its FQMN. Regular expressions must
+// match the whole path.
+func Match(authorities []string, routes []*apploadbalancer.Route, req Request) (Result, error) {
+	result := Result{RouteIndex: -1}
+	if req.Method == "" {
+		req.Method = "GET"
+	}
+	if req.Scheme == "" {
+		req.Scheme = "http"
+	}
+
+	path, query, _ := strings.Cut(req.Path, "?")
+	if !strings.HasPrefix(path, "/") {
+		return result, fmt.Errorf("request path %q should start with /", req.Path)
+	}
+
+	result.AuthorityMatched = MatchAuthority(authorities, req.Authority)
+	if !result.AuthorityMatched {
+		return result, nil
+	}
+
+	grpc := isGRPC(req.Headers)
+	for i, route := range routes {
+		var (
+			matcher *apploadbalancer.StringMatch
+			ok      bool
+			err     error
+		)
+		switch {
+		case route.GetHttp() != nil:
+			matcher = route.GetHttp().GetMatch().GetPath()
+			ok, err = matchHTTPRoute(route.GetHttp().GetMatch(), req.Method, path)
+		case route.GetGrpc() != nil:
+			matcher = route.GetGrpc().GetMatch().GetFqmn()
+			if grpc {
+				ok, err = matchString(matcher, path)
+			}
+		}
+		if err != nil {
+			return result, fmt.Errorf("route %d: %w", i, err)
+		}
+		if !ok {
+			continue
+		}
+
+		result.RouteIndex = i
+		result.RouteName = route.GetName()
+		applyAction(&result, route, matcher, req, path, query)
+		return result, nil
+	}
+
+	return result, nil
+}
+
+// MatchAuthority reports whether the authority matches one of the virtual host authorities.
+// Authorities are matched case-insensitively, with or without the port, and a leading '*'
+// matches any non-empty prefix. An empty list matches all authorities.
+func MatchAuthority(authorities []string, authority string) bool {
+	if len(authorities) == 0 {
+		return true
+	}
+
+	candidates := []string{strings.ToLower(authority)}
+	if host, _, err := net.SplitHostPort(authority); err == nil {
+		candidates = append(candidates, strings.ToLower(host))
+	}
+
+	for _, pattern := range authorities {
+		pattern = strings.ToLower(pattern)
+		for _, candidate := range candidates {
+			if pattern == "*" || pattern == candidate {
+				return true
+			}
+			if suffix, ok := strings.CutPrefix(pattern, "*"); ok &&
+				len(candidate) > len(suffix) && strings.HasSuffix(candidate, suffix) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isGRPC(headers map[string]string) bool {
+	for name, value := range headers {
+		if strings.EqualFold(name, "content-type") {
+			return strings.HasPrefix(strings.ToLower(value), "application/grpc")
+		}
+	}
+	return false
+}
+
+func matchHTTPRoute(match *apploadbalancer.HttpRouteMatch, method, path string) (bool, error) {
+	if methods := match.GetHttpMethod(); len(methods) > 0 && !containsFold(methods, method) {
+		return false, nil
+	}
+	return matchString(match.GetPath(), path)
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchString matches the value with the string matcher. A missing matcher matches any value.
+func matchString(matcher *apploadbalancer.StringMatch, value string) (bool, error) {
+	switch matcher.GetMatch().(type) {
+	case *apploadbalancer.StringMatch_ExactMatch:
+		return value == matcher.GetExactMatch(), nil
+	case *apploadbalancer.StringMatch_PrefixMatch:
+		return strings.HasPrefix(value, matcher.GetPrefixMatch()), nil
+	case *apploadbalancer.StringMatch_RegexMatch:
+		re, err := compileRegex(matcher.GetRegexMatch())
+		if err != nil {
+			return false, err
+		}
+		return re.MatchString(value), nil
+	default:
+		return true, nil
+	}
+}
+
+// ValidateRegex checks that the expression is a valid RE2 regular expression, as used by the
+// regex string matches of routes.
+func ValidateRegex(expr string) error {
+	_, err := compileRegex(expr)
+	return err
+}
+
+func compileRegex(expr string) (*regexp.Regexp, error) {
+	re, err := regexp.Compile("^(?:" + expr + ")$")
+	if err != nil {
+		return nil, fmt.Errorf("invalid regex %q: %w", expr, err)
+	}
+	return re, nil
+}
+
+// replacePath replaces the part of the path matched by the matcher: the prefix for a prefix
+// match and the whole path otherwise.
+func replacePath(matcher *apploadbalancer.StringMatch, path, replacement string) string {
+	switch matcher.GetMatch().(type) {
+	case *apploadbalancer.StringMatch_PrefixMatch:
+		return replacement + strings.TrimPrefix(path, matcher.GetPrefixMatch())
+	case *apploadbalancer.StringMatch_ExactMatch, *apploadbalancer.StringMatch_RegexMatch:
+		return replacement
+	default:
+		return replacement + strings.TrimPrefix(path, "/")
+	}
+}
+
+func applyAction(result *Result, route *apploadbalancer.Route, matcher *apploadbalancer.StringMatch, req Request, path, query string) {
+	result.Path = path
+	result.Authority = req.Authority
+
+	if grpcRoute := route.GetGrpc(); grpcRoute != nil {
+		switch {
+		case grpcRoute.GetRoute() != nil:
+			action := grpcRoute.GetRoute()
+			result.Action = ActionGRPCRoute
+			result.BackendGroupID = action.GetBackendGroupId()
+			applyHostRewrite(result, action.GetHostRewrite(), action.GetAutoHostRewrite())
+		case grpcRoute.GetStatusResponse() != nil:
+			result.Action = ActionGRPCStatusResponse
+			result.GRPCStatus = strings.ToLower(grpcRoute.GetStatusResponse().GetStatus().String())
+		}
+		return
+	}
+
+	httpRoute := route.GetHttp()
+	switch {
+	case httpRoute.GetRoute() != nil:
+		action := httpRoute.GetRoute()
+		result.Action = ActionHTTPRoute
+		result.BackendGroupID = action.GetBackendGroupId()
+		if rewrite := action.GetPrefixRewrite(); rewrite != "" {
+			result.Path = replacePath(matcher, path, rewrite)
+		}
+		applyHostRewrite(result, action.GetHostRewrite(), action.GetAutoHostRewrite())
+	case httpRoute.GetRedirect() != nil:
+		action := httpRoute.GetRedirect()
+		result.Action = ActionRedirect
+		result.RedirectURL = redirectURL(action, matcher, req, path, query)
+		result.RedirectResponseCode = strings.ToLower(action.GetResponseCode().String())
+	case httpRoute.GetDirectResponse() != nil:
+		action := httpRoute.GetDirectResponse()
+		result.Action = ActionDirectResponse
+		result.DirectResponseStatus = action.GetStatus()
+		result.DirectResponseBody = action.GetBody().GetText()
+	}
+}
+
+func applyHostRewrite(result *Result, hostRewrite string, autoHostRewrite bool) {
+	switch {
+	case hostRewrite != "":
+		result.Authority = hostRewrite
+	case autoHostRewrite:
+		result.Authority = ""
+		result.AutoHostRewrite = true
+	}
+}
+
+func redirectURL(action *apploadbalancer.RedirectAction, matcher *apploadbalancer.StringMatch, req Request, path, query string) string {
+	scheme := req.Scheme
+	if action.GetReplaceScheme() != "" {
+		scheme = action.GetReplaceScheme()
+	}
+
+	host, port := req.Authority, ""
+	if h, p, err := net.SplitHostPort(req.Authority); err == nil {
+		host, port = h, p
+	}
+	if action.GetReplaceHost() != "" {
+		host = action.GetReplaceHost()
+	}
+	if action.GetReplacePort() != 0 {
+		port = strconv.FormatInt(action.GetReplacePort(), 10)
+	}
+	if port != "" {
+		host = net.JoinHostPort(host, port)
+	}
+
+	switch action.GetPath().(type) {
+	case *apploadbalancer.RedirectAction_ReplacePath:
+		path = action.GetReplacePath()
+	case *apploadbalancer.RedirectAction_ReplacePrefix:
+		path = replacePath(matcher, path, action.GetReplacePrefix())
+	}
+	if action.GetRemoveQuery() {
+		query = ""
+	}
+
+	redirect := scheme + "://" + host + path
+	if query != "" {
+		redirect += "?" + query
+	}
+	return redirect
+}
@@ -0,0 +1,204 @@
+package albroute
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
+)
+
+func exact(s string) *apploadbalancer.StringMatch {
+	return &apploadbalancer.StringMatch{Match: &apploadbalancer.StringMatch_ExactMatch{ExactMatch: s}}
+}
+
+func prefix(s string) *apploadbalancer.StringMatch {
+	return &apploadbalancer.StringMatch{Match: &apploadbalancer.StringMatch_PrefixMatch{PrefixMatch: s}}
+}
+
+func regex(s string) *apploadbalancer.StringMatch {
+	return &apploadbalancer.StringMatch{Match: &apploadbalancer.StringMatch_RegexMatch{RegexMatch: s}}
+}
+
+func httpRoute(name string, path *apploadbalancer.StringMatch, methods []string, action *apploadbalancer.HttpRouteAction) *apploadbalancer.Route {
+	route := &apploadbalancer.HttpRoute{
+		Match: &apploadbalancer.HttpRouteMatch{Path: path, HttpMethod: methods},
+	}
+	route.SetRoute(action)
+	r := &apploadbalancer.Route{Name: name}
+	r.SetHttp(route)
+	return r
+}
+
+func testRoutes() []*apploadbalancer.Route {
+	redirect := &apploadbalancer.HttpRoute{Match: &apploadbalancer.HttpRouteMatch{Path: prefix("/old/")}}
+	redirect.SetRedirect(&apploadbalancer.RedirectAction{
+		ReplaceScheme: "https",
+		Path:          &apploadbalancer.RedirectAction_ReplacePrefix{ReplacePrefix: "/new/"},
+		ResponseCode:  apploadbalancer.RedirectAction_PERMANENT_REDIRECT,
+	})
+	redirectRoute := &apploadbalancer.Route{Name: "redirect"}
+	redirectRoute.SetHttp(redirect)
+
+	grpc := &apploadbalancer.GrpcRoute{Match: &apploadbalancer.GrpcRouteMatch{Fqmn: prefix("/helloworld.Greeter/")}}
+	grpcAction := &apploadbalancer.GrpcRouteAction{BackendGroupId: "bg-grpc"}
+	grpcAction.SetAutoHostRewrite(true)
+	grpc.SetRoute(grpcAction)
+	grpcRoute := &apploadbalancer.Route{Name: "grpc"}
+	grpcRoute.SetGrpc(grpc)
+
+	health := &apploadbalancer.HttpRoute{Match: &apploadbalancer.HttpRouteMatch{Path: exact("/healthz")}}
+	health.SetDirectResponse(&apploadbalancer.DirectResponseAction{
+		Status: 200,
+		Body:   &apploadbalancer.Payload{Payload: &apploadbalancer.Payload_Text{Text: "ok"}},
+	})
+	healthRoute := &apploadbalancer.Route{Name: "health"}
+	healthRoute.SetHttp(health)
+
+	return []*apploadbalancer.Route{
+		grpcRoute,
+		httpRoute("api-write", prefix("/api/"), []string{"POST", "PUT"}, &apploadbalancer.HttpRouteAction{
+			BackendGroupId: "bg-write",
+			PrefixRewrite:  "/v1/",
+		}),
+		httpRoute("api-item", regex(`/api/items/[0-9]+`), nil, &apploadbalancer.HttpRouteAction{
+			BackendGroupId: "bg-items",
+			PrefixRewrite:  "/item",
+			HostRewriteSpecifier: &apploadbalancer.HttpRouteAction_HostRewrite{
+				HostRewrite: "items.internal",
+			},
+		}),
+		redirectRoute,
+		healthRoute,
+		httpRoute("default", nil, nil, &apploadbalancer.HttpRouteAction{BackendGroupId: "bg-default"}),
+	}
+}
+
+func TestMatch(t *testing.T) {
+	routes := testRoutes()
+
+	for name, tc := range map[string]struct {
+		req      Request
+		expected Result
+	}{
+		"prefix route with method": {
+			req: Request{Authority: "example.com", Method: "post", Path: "/api/users?x=1"},
+			expected: Result{
+				AuthorityMatched: true, RouteIndex: 1, RouteName: "api-write", Action: ActionHTTPRoute,
+				BackendGroupID: "bg-write", Path: "/v1/users", Authority: "example.com",
+			},
+		},
+		"regex route": {
+			req: Request{Authority: "example.com", Path: "/api/items/42"},
+			expected: Result{
+				AuthorityMatched: true, RouteIndex: 2, RouteName: "api-item", Action: ActionHTTPRoute,
+				BackendGroupID: "bg-items", Path: "/item", Authority: "items.internal",
+			},
+		},
+		"regex matches whole path only": {
+			req: Request{Authority: "example.com", Path: "/api/items/42/details"},
+			expected: Result{
+				AuthorityMatched: true, RouteIndex: 5, RouteName: "default", Action: ActionHTTPRoute,
+				BackendGroupID: "bg-default", Path: "/api/items/42/details", Authority: "example.com",
+			},
+		},
+		"redirect": {
+			req: Request{Authority: "example.com:8080", Path: "/old/page?a=b"},
+			expected: Result{
+				AuthorityMatched: true, RouteIndex: 3, RouteName: "redirect", Action: ActionRedirect,
+				Path: "/old/page", Authority: "example.com:8080",
+				RedirectURL: "https://example.com:8080/new/page?a=b", RedirectResponseCode: "permanent_redirect",
+			},
+		},
+		"direct response": {
+			req: Request{Authority: "example.com", Path: "/healthz"},
+			expected: Result{
+				AuthorityMatched: true, RouteIndex: 4, RouteName: "health", Action: ActionDirectResponse,
+				Path: "/healthz", Authority: "example.com", DirectResponseStatus: 200, DirectResponseBody: "ok",
+			},
+		},
+		"grpc": {
+			req: Request{
+				Authority: "example.com", Method: "POST", Path: "/helloworld.Greeter/SayHello",
+				Headers: map[string]string{"Content-Type": "application/grpc+proto"},
+			},
+			expected: Result{
+				AuthorityMatched: true, RouteIndex: 0, RouteName: "grpc", Action: ActionGRPCRoute,
+				BackendGroupID: "bg-grpc", Path: "/helloworld.Greeter/SayHello", AutoHostRewrite: true,
+			},
+		},
+		"grpc route does not match http request": {
+			req: Request{Authority: "example.com", Path: "/helloworld.Greeter/SayHello"},
+			expected: Result{
+				AuthorityMatched: true, RouteIndex: 5, RouteName: "default", Action: ActionHTTPRoute,
+				BackendGroupID: "bg-default", Path: "/helloworld.Greeter/SayHello", Authority: "example.com",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			result, err := Match(nil, routes, tc.req)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestMatchNoRoute(t *testing.T) {
+	routes := testRoutes()[:2]
+
+	result, err := Match([]string{"*.example.com"}, routes, Request{Authority: "www.example.com", Path: "/"})
+	require.NoError(t, err)
+	assert.Equal(t, Result{AuthorityMatched: true, RouteIndex: -1}, result)
+
+	result, err = Match([]string{"*.example.com"}, routes, Request{Authority: "example.com", Path: "/api/", Method: "POST"})
+	require.NoError(t, err)
+	assert.Equal(t, Result{AuthorityMatched: false, RouteIndex: -1}, result)
+}
+
+func TestMatchErrors(t *testing.T) {
+	_, err := Match(nil, testRoutes(), Request{Authority: "example.com", Path: "api"})
+	assert.Error(t, err)
+
+	routes := []*apploadbalancer.Route{
+		httpRoute("bad", regex("/(api"), nil, &apploadbalancer.HttpRouteAction{BackendGroupId: "bg"}),
+	}
+	_, err = Match(nil, routes, Request{Authority: "example.com", Path: "/api"})
+	assert.Error(t, err)
+
+	assert.Error(t, ValidateRegex("/(api"))
+	assert.NoError(t, ValidateRegex("/api/v[0-9]+/.*"))
+}
+
+func TestMatchAuthority(t *testing.T) {
+	authorities := []string{"example.com", "*.example.org", "*-bar.example.net"}
+
+	assert.True(t, MatchAuthority(nil, "anything"))
+	assert.True(t, MatchAuthority(authorities, "EXAMPLE.com"))
+	assert.True(t, MatchAuthority(authorities, "example.com:8443"))
+	assert.True(t, MatchAuthority(authorities, "www.example.org"))
+	assert.True(t, MatchAuthority(authorities, "foo-bar.example.net"))
+	assert.False(t, MatchAuthority(authorities, "example.org"))
+	assert.False(t, MatchAuthority(authorities, "-bar.example.net"))
+	assert.False(t, MatchAuthority(authorities, "example.net"))
+}
+
+func TestFindShadowed(t *testing.T) {
+	action := &apploadbalancer.HttpRouteAction{BackendGroupId: "bg"}
+	routes := []*apploadbalancer.Route{
+		httpRoute("api", prefix("/api/"), nil, action),
+		httpRoute("api-users", exact("/api/users"), nil, action),
+		httpRoute("post", prefix("/"), []string{"POST"}, action),
+		httpRoute("post-upload", prefix("/upload"), []string{"POST"}, action),
+		httpRoute("upload", prefix("/upload"), nil, action),
+		httpRoute("items", regex("/items/.*"), nil, action),
+		httpRoute("items-exact", exact("/items/1"), nil, action),
+		httpRoute("all", nil, nil, action),
+		httpRoute("after-all", exact("/x"), []string{"GET"}, action),
+	}
+
+	assert.Equal(t, []Shadowed{
+		{RouteIndex: 1, RouteName: "api-users", ShadowedBy: 0},
+		{RouteIndex: 3, RouteName: "post-upload", ShadowedBy: 2},
+		{RouteIndex: 8, RouteName: "after-all", ShadowedBy: 7},
+	}, FindShadowed(routes))
+}
@@ -0,0 +1,86 @@
+package albroute
+
+import (
+	"strings"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
+)
+
+// Shadowed is a route which never matches because an earlier route matches all its requests.
+type Shadowed struct {
+	RouteIndex int
+	RouteName  string
+	// ShadowedBy is the index of the earlier route.
+	ShadowedBy int
+}
+
+// FindShadowed returns the routes which are shadowed by earlier routes of the same type, e.g. an
+// exact route after a prefix route with the prefix of its path. Routes with regular expressions
+// never shadow other routes, since their languages cannot be compared.
+func FindShadowed(routes []*apploadbalancer.Route) []Shadowed {
+	var shadowed []Shadowed
+	for i, route := range routes {
+		for j := 0; j < i; j++ {
+			if shadows(routes[j], route) {
+				shadowed = append(shadowed, Shadowed{
+					RouteIndex: i,
+					RouteName:  route.GetName(),
+					ShadowedBy: j,
+				})
+				break
+			}
+		}
+	}
+	return shadowed
+}
+
+// shadows reports whether the earlier route matches all requests of the later route.
+func shadows(earlier, later *apploadbalancer.Route) bool {
+	switch {
+	case earlier.GetHttp() != nil && later.GetHttp() != nil:
+		earlierMatch, laterMatch := earlier.GetHttp().GetMatch(), later.GetHttp().GetMatch()
+		return coversMethods(earlierMatch.GetHttpMethod(), laterMatch.GetHttpMethod()) &&
+			coversString(earlierMatch.GetPath(), laterMatch.GetPath())
+	case earlier.GetGrpc() != nil && later.GetGrpc() != nil:
+		return coversString(earlier.GetGrpc().GetMatch().GetFqmn(), later.GetGrpc().GetMatch().GetFqmn())
+	default:
+		return false
+	}
+}
+
+func coversMethods(earlier, later []string) bool {
+	if len(earlier) == 0 {
+		return true
+	}
+	if len(later) == 0 {
+		return false
+	}
+	for _, method := range later {
+		if !containsFold(earlier, method) {
+			return false
+		}
+	}
+	return true
+}
+
+func coversString(earlier, later *apploadbalancer.StringMatch) bool {
+	switch earlier.GetMatch().(type) {
+	case nil:
+		return true
+	case *apploadbalancer.StringMatch_PrefixMatch:
+		prefix := earlier.GetPrefixMatch()
+		switch later.GetMatch().(type) {
+		case *apploadbalancer.StringMatch_PrefixMatch:
+			return strings.HasPrefix(later.GetPrefixMatch(), prefix)
+		case *apploadbalancer.StringMatch_ExactMatch:
+			return strings.HasPrefix(later.GetExactMatch(), prefix)
+		case nil:
+			return prefix == "" || prefix == "/"
+		}
+	case *apploadbalancer.StringMatch_ExactMatch:
+		if _, ok := later.GetMatch().(*apploadbalancer.StringMatch_ExactMatch); ok {
+			return earlier.GetExactMatch() == later.GetExactMatch()
+		}
+	}
+	return false
+}
@@ -1304,57 +1304,47 @@ type Bucket struct {
 }
 
 func (c *Client) GetBucket(ctx context.Context, bucket, endpoint, acl string) (*Bucket, error) {
-	resp, err := RetryLongTermOperations[*s3.HeadBucketOutput](ctx, func() (*s3.HeadBucketOutput, error) {
-		return c.s3.HeadBucketWithContext(ctx, &s3.HeadBucketInput{
-			Bucket: aws.String(bucket),
-		})
-	})
-	if err != nil {
-		var awsError awserr.RequestFailure
-		if errors.As(err, &awsError) && awsError.StatusCode() == 404 {
-			return nil, ErrBucketNotFound
-		}
-		return nil, fmt.Errorf("error reading Storage Bucket (%s): %w", bucket, err)
+	if err := c.HeadBucket(ctx, bucket); err != nil {
+		return nil, err
 	}
-	log.Printf("[DEBUG] Storage head bucket output: %#v", resp)
 
 	domainName, err := c.getBucketDomainName(bucket, endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("error getting bucket domain name: %w", err)
 	}
-	policy, err := c.getBucketPolicy(ctx, bucket)
+	policy, err := c.GetBucketPolicy(ctx, bucket)
 	if err != nil {
 		return nil, fmt.Errorf("error getting bucket policy: %w", err)
 	}
-	corsRules, err := c.getCORSRules(ctx, bucket)
+	corsRules, err := c.GetBucketCORSRules(ctx, bucket)
 	if err != nil {
 		return nil, fmt.Errorf("error getting bucket CORS rules: %w", err)
 	}
-	website, err := c.getBucketWebsite(ctx, bucket)
+	website, err := c.GetBucketWebsite(ctx, bucket)
 	if err != nil {
 		return nil, fmt.Errorf("error getting bucket website: %w", err)
 	}
-	grants, err := c.getBucketGrants(ctx, bucket, acl)
+	grants, err := c.GetBucketGrants(ctx, bucket, acl)
 	if err != nil {
 		return nil, fmt.Errorf("error getting bucket grants: %w", err)
 	}
-	versioning, err := c.getBucketVersioning(ctx, bucket)
+	versioning, err := c.GetBucketVersioning(ctx, bucket)
 	if err != nil {
 		return nil, fmt.Errorf("error getting bucket versioning: %w", err)
 	}
-	objectLock, err := c.getBucketObjectLock(ctx, bucket)
+	objectLock, err := c.GetBucketObjectLock(ctx, bucket)
 	if err != nil {
 		return nil, fmt.Errorf("error getting bucket object lock: %w", err)
 	}
-	logging, err := c.getBucketLogging(ctx, bucket)
+	logging, err := c.GetBucketLogging(ctx, bucket)
 	if err != nil {
 		return nil, fmt.Errorf("error getting bucket logging: %w", err)
 	}
-	lifecycle, err := c.getBucketLifecycle(ctx, bucket)
+	lifecycle, err := c.GetBucketLifecycle(ctx, bucket)
 	if err != nil {
 		return nil, fmt.Errorf("error getting bucket lifecycle: %w", err)
 	}
-	encryption, err := c.getBucketServerSideEncryption(ctx, bucket)
+	encryption, err := c.GetBucketServerSideEncryption(ctx, bucket)
 	if err != nil {
 		return nil, fmt.Errorf("error getting bucket server side encryption: %w", err)
 	}
@@ -1378,6 +1368,24 @@ func (c *Client) GetBucket(ctx context.Context, bucket, endpoint, acl string) (*
 	}, nil
 }
 
+// HeadBucket checks that the bucket exists. It returns ErrBucketNotFound if it does not.
+func (c *Client) HeadBucket(ctx context.Context, bucket string) error {
+	resp, err := RetryLongTermOperations[*s3.HeadBucketOutput](ctx, func() (*s3.HeadBucketOutput, error) {
+		return c.s3.HeadBucketWithContext(ctx, &s3.HeadBucketInput{
+			Bucket: aws.String(bucket),
+		})
+	})
+	if err != nil {
+		var awsError awserr.RequestFailure
+		if errors.As(err, &awsError) && awsError.StatusCode() == 404 {
+			return ErrBucketNotFound
+		}
+		return fmt.Errorf("error reading Storage Bucket (%s): %w", bucket, err)
+	}
+	log.Printf("[DEBUG] Storage head bucket output: %#v", resp)
+	return nil
+}
+
 func (c *Client) getBucketDomainName(bucket string, endpointURL string) (string, error) {
 	// Without a scheme the url will not be parsed as we expect
 	// See https://github.com/golang/go/issues/19779
@@ -1391,7 +1399,7 @@ func (c *Client) getBucketDomainName(bucket string, endpointURL string) (string,
 	return fmt.Sprintf("%s.%s", bucket, parse.Hostname()), nil
 }
 
-func (c *Client) getBucketPolicy(ctx context.Context, bucket string) (string, error) {
+func (c *Client) GetBucketPolicy(ctx context.Context, bucket string) (string, error) {
 	pol, err := RetryLongTermOperations[*s3.GetBucketPolicyOutput](
 		ctx,
 		func() (*s3.GetBucketPolicyOutput, error) {
@@ -1421,7 +1429,7 @@ func (c *Client) getBucketPolicy(ctx context.Context, bucket string) (string, er
 	return "", nil
 }
 
-func (c *Client) getCORSRules(ctx context.Context, bucket string) ([]map[string]interface{}, error) {
+func (c *Client) GetBucketCORSRules(ctx context.Context, bucket string) ([]map[string]interface{}, error) {
 	resp, err := RetryLongTermOperations[*s3.GetBucketCorsOutput](ctx, func() (*s3.GetBucketCorsOutput, error) {
 		return c.s3.GetBucketCorsWithContext(ctx, &s3.GetBucketCorsInput{
 			Bucket: aws.String(bucket),
@@ -1457,7 +1465,7 @@ func (c *Client) getCORSRules(ctx context.Context, bucket string) ([]map[string]
 
 const websiteDomainURL = "website.yandexcloud.net"
 
-func (c *Client) getBucketWebsite(ctx context.Context, bucket string) (*WebsiteInfo, error) {
+func (c *Client) GetBucketWebsite(ctx context.Context, bucket string) (*WebsiteInfo, error) {
 	rawData, err := c.getBucketWebsiteRawData(ctx, bucket)
 	if err != nil {
 		return nil, err
@@ -1535,7 +1543,7 @@ func (c *Client) getBucketWebsiteRawData(ctx context.Context, bucket string) ([]
 	return websites, nil
 }
 
-func (c *Client) getBucketGrants(ctx context.Context, bucket, acl string) ([]interface{}, error) {
+func (c *Client) GetBucketGrants(ctx context.Context, bucket, acl string) ([]interface{}, error) {
 	if acl != "" {
 		return nil, nil
 	}
@@ -1562,7 +1570,7 @@ func (c *Client) getBucketGrants(ctx context.Context, bucket, acl string) ([]int
 	return grants, nil
 }
 
-func (c *Client) getBucketVersioning(ctx context.Context, bucket string) ([]map[string]interface{}, error) {
+func (c *Client) GetBucketVersioning(ctx context.Context, bucket string) ([]map[string]interface{}, error) {
 	versioning, err := RetryLongTermOperations[*s3.GetBucketVersioningOutput](
 		ctx,
 		func() (*s3.GetBucketVersioningOutput, error) {
@@ -1586,7 +1594,7 @@ func (c *Client) getBucketVersioning(ctx context.Context, bucket string) ([]map[
 	return append(vcl, vc), nil
 }
 
-func (c *Client) getBucketObjectLock(ctx context.Context, bucket string) ([]map[string]interface{}, error) {
+func (c *Client) GetBucketObjectLock(ctx context.Context, bucket string) ([]map[string]interface{}, error) {
 	objectLockConfig, err := RetryLongTermOperations[*s3.GetObjectLockConfigurationOutput](
 		ctx,
 		func() (*s3.GetObjectLockConfigurationOutput, error) {
@@ -1641,7 +1649,7 @@ func (c *Client) getBucketObjectLock(ctx context.Context, bucket string) ([]map[
 	return append(olcl, olc), nil
 }
 
-func (c *Client) getBucketLogging(ctx context.Context, bucket string) ([]map[string]interface{}, error) {
+func (c *Client) GetBucketLogging(ctx context.Context, bucket string) ([]map[string]interface{}, error) {
 	logging, err := RetryLongTermOperations[*s3.GetBucketLoggingOutput](
 		ctx,
 		func() (*s3.GetBucketLoggingOutput, error) {
@@ -1669,7 +1677,7 @@ func (c *Client) getBucketLogging(ctx context.Context, bucket string) ([]map[str
 	return append(lcl, lc), nil
 }
 
-func (c *Client) getBucketLifecycle(ctx context.Context, bucket string) ([]map[string]interface{}, error) {
+func (c *Client) GetBucketLifecycle(ctx context.Context, bucket string) ([]map[string]interface{}, error) {
 	lifecycle, err := RetryLongTermOperations[*s3.GetBucketLifecycleConfigurationOutput](
 		ctx,
 		func() (*s3.GetBucketLifecycleConfigurationOutput, error) {
@@ -1825,7 +1833,7 @@ func (c *Client) getBucketLifecycle(ctx context.Context, bucket string) ([]map[s
 	return lifecycleRules, nil
 }
 
-func (c *Client) getBucketServerSideEncryption(ctx context.Context, bucket string) ([]map[string]interface{}, error) {
+func (c *Client) GetBucketServerSideEncryption(ctx context.Context, bucket string) ([]map[string]interface{}, error) {
 	encryption, err := RetryLongTermOperations[*s3.GetBucketEncryptionOutput](
 		ctx,
 		func() (*s3.GetBucketEncryptionOutput, error) {
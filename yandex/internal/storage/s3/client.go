@@ -10,18 +10,20 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/iamtoken"
 )
 
 const (
 	defaultS3Region = "ru-central1"
-	iamTokenHeader  = "X-YaCloud-SubjectToken"
 )
 
 type Client struct {
 	s3 *s3.S3
 }
 
-func NewClient(ctx context.Context, accessKey, secretKey, iamToken, url string) (*Client, error) {
+// NewClient returns client authenticated either with static access keys or, if they are not set, with IAM token.
+// The IAM token is requested for every request, see iamtoken.Source.
+func NewClient(ctx context.Context, accessKey, secretKey string, iamToken func(ctx context.Context) (string, error), url string) (*Client, error) {
 	if url == "" {
 		return nil, fmt.Errorf("storage endpoint url is not specified")
 	}
@@ -37,10 +39,10 @@ func NewClient(ctx context.Context, accessKey, secretKey, iamToken, url string)
 	switch {
 	case accessKey != "" && secretKey != "":
 		config.Credentials = credentials.NewStaticCredentials(accessKey, secretKey, "")
-	case iamToken != "":
+	case iamToken != nil:
 		config.Credentials = credentials.AnonymousCredentials
 		config.HTTPClient = &http.Client{
-			Transport: newTransport(iamToken),
+			Transport: iamtoken.NewTransport(iamToken),
 		}
 	default:
 		return nil, fmt.Errorf("nor token, nor access and secret keys are specified")
@@ -61,20 +63,3 @@ func NewClient(ctx context.Context, accessKey, secretKey, iamToken, url string)
 func (c *Client) S3() *s3.S3 {
 	return c.s3
 }
-
-type iamTransport struct {
-	Transport http.RoundTripper
-	IAMToken  string
-}
-
-func newTransport(iamToken string) http.RoundTripper {
-	return &iamTransport{
-		Transport: http.DefaultTransport,
-		IAMToken:  iamToken,
-	}
-}
-
-func (t *iamTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	req.Header.Set(iamTokenHeader, t.IAMToken)
-	return t.Transport.RoundTrip(req)
-}
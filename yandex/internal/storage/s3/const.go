@@ -8,6 +8,9 @@ const (
 	StorageClassIce      = "ICE"
 )
 
+// ObjectStorageClassStandard is the storage class of objects which is not returned by the storage explicitly.
+const ObjectStorageClassStandard = s3.StorageClassStandard
+
 const (
 	TypeCanonicalUser = s3.TypeCanonicalUser
 	TypeGroup         = s3.TypeGroup
@@ -31,4 +34,5 @@ var (
 	ObjectLockEnabledValues         = s3.ObjectLockEnabled_Values()
 	ObjectLockRetentionModeValues   = s3.ObjectLockRetentionMode_Values()
 	ObjectLockLegalHoldStatusValues = s3.ObjectLockLegalHoldStatus_Values()
+	ObjectStorageClassValues        = []string{ObjectStorageClassStandard, StorageClassCold, StorageClassIce}
 )
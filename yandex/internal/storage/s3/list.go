@@ -0,0 +1,82 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// DefaultListMaxKeys is the number of keys returned by ListObjects when the limit is not set.
+const DefaultListMaxKeys = 1000
+
+type ListObjectsInput struct {
+	Bucket     string
+	Prefix     string
+	Delimiter  string
+	StartAfter string
+	// MaxKeys limits the total number of keys and common prefixes, the listing is paginated until
+	// the limit is reached.
+	MaxKeys int
+}
+
+type ObjectSummary struct {
+	Key          string
+	Size         int64
+	ETag         string
+	LastModified time.Time
+	StorageClass string
+}
+
+type ObjectList struct {
+	Objects        []ObjectSummary
+	CommonPrefixes []string
+}
+
+// ListObjects lists objects of the bucket in lexicographical order of their keys.
+// When Delimiter is set, keys sharing the prefix up to the delimiter are rolled up into CommonPrefixes.
+func (c *Client) ListObjects(ctx context.Context, input ListObjectsInput) (*ObjectList, error) {
+	maxKeys := input.MaxKeys
+	if maxKeys <= 0 {
+		maxKeys = DefaultListMaxKeys
+	}
+
+	request := &s3.ListObjectsV2Input{
+		Bucket:     aws.String(input.Bucket),
+		Prefix:     stringOrNil(input.Prefix),
+		Delimiter:  stringOrNil(input.Delimiter),
+		StartAfter: stringOrNil(input.StartAfter),
+	}
+	list := &ObjectList{}
+	for remaining := maxKeys; remaining > 0; {
+		request.MaxKeys = aws.Int64(int64(min(remaining, DefaultListMaxKeys)))
+		resp, err := c.s3.ListObjectsV2WithContext(ctx, request)
+		if err != nil {
+			return nil, fmt.Errorf("error listing objects in bucket %q: %w", input.Bucket, err)
+		}
+
+		for _, object := range resp.Contents {
+			list.Objects = append(list.Objects, ObjectSummary{
+				Key:          aws.StringValue(object.Key),
+				Size:         aws.Int64Value(object.Size),
+				ETag:         strings.Trim(aws.StringValue(object.ETag), `"`),
+				LastModified: aws.TimeValue(object.LastModified),
+				StorageClass: aws.StringValue(object.StorageClass),
+			})
+		}
+		for _, prefix := range resp.CommonPrefixes {
+			list.CommonPrefixes = append(list.CommonPrefixes, aws.StringValue(prefix.Prefix))
+		}
+		remaining -= len(resp.Contents) + len(resp.CommonPrefixes)
+
+		if !aws.BoolValue(resp.IsTruncated) || resp.NextContinuationToken == nil {
+			break
+		}
+		request.ContinuationToken = resp.NextContinuationToken
+	}
+
+	return list, nil
+}
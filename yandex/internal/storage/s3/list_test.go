@@ -0,0 +1,115 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// list serves ListObjectsV2 requests, continuation tokens are the last returned keys.
+func (f *fakeStorage) list(w http.ResponseWriter, bucket string, query url.Values) {
+	prefix, delimiter := query.Get("prefix"), query.Get("delimiter")
+	after := query.Get("start-after")
+	if token := query.Get("continuation-token"); token != "" {
+		after = token
+	}
+	maxKeys, err := strconv.Atoi(query.Get("max-keys"))
+	if err != nil {
+		maxKeys = DefaultListMaxKeys
+	}
+
+	keys := make([]string, 0, len(f.objects))
+	for key := range f.objects {
+		if key, ok := strings.CutPrefix(key, bucket+"/"); ok && strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var contents, prefixes strings.Builder
+	var count int
+	var last string
+	truncated := false
+	for _, key := range keys {
+		entry := key
+		if i := strings.Index(key[len(prefix):], delimiter); delimiter != "" && i >= 0 {
+			entry = key[:len(prefix)+i+len(delimiter)]
+		}
+		if entry <= after || entry == last {
+			continue
+		}
+		if count == maxKeys {
+			truncated = true
+			break
+		}
+		if entry != key {
+			fmt.Fprintf(&prefixes, `<CommonPrefixes><Prefix>%s</Prefix></CommonPrefixes>`, entry)
+		} else {
+			fmt.Fprintf(&contents, `<Contents><Key>%s</Key><Size>%d</Size><ETag>%s</ETag><StorageClass>STANDARD</StorageClass></Contents>`,
+				key, len(f.objects[bucket+"/"+key]), etag(f.objects[bucket+"/"+key]))
+		}
+		last = entry
+		count++
+	}
+
+	fmt.Fprintf(w, `<ListBucketResult><Name>%s</Name><IsTruncated>%t</IsTruncated>`, bucket, truncated)
+	if truncated {
+		fmt.Fprintf(w, `<NextContinuationToken>%s</NextContinuationToken>`, last)
+	}
+	fmt.Fprintf(w, `%s%s</ListBucketResult>`, contents.String(), prefixes.String())
+}
+
+func TestListObjects(t *testing.T) {
+	storage, client := newFakeStorage(t)
+	for i := 0; i < 2500; i++ {
+		storage.objects[fmt.Sprintf("bucket/logs/%04d", i)] = []byte("log")
+	}
+	storage.objects["bucket/a/1"] = []byte("a")
+	storage.objects["bucket/a/2"] = []byte("a")
+	storage.objects["bucket/b"] = []byte("b")
+	storage.objects["other/c"] = []byte("c")
+
+	t.Run("paginated", func(t *testing.T) {
+		list, err := client.ListObjects(context.Background(), ListObjectsInput{
+			Bucket:  "bucket",
+			Prefix:  "logs/",
+			MaxKeys: 2200,
+		})
+		require.NoError(t, err)
+		require.Len(t, list.Objects, 2200)
+		assert.Equal(t, "logs/0000", list.Objects[0].Key)
+		assert.Equal(t, "logs/2199", list.Objects[2199].Key)
+		assert.Equal(t, int64(3), list.Objects[0].Size)
+		assert.Equal(t, strings.Trim(etag([]byte("log")), `"`), list.Objects[0].ETag)
+	})
+
+	t.Run("delimiter", func(t *testing.T) {
+		list, err := client.ListObjects(context.Background(), ListObjectsInput{
+			Bucket:    "bucket",
+			Delimiter: "/",
+		})
+		require.NoError(t, err)
+		require.Len(t, list.Objects, 1)
+		assert.Equal(t, "b", list.Objects[0].Key)
+		assert.Equal(t, []string{"a/", "logs/"}, list.CommonPrefixes)
+	})
+
+	t.Run("start after", func(t *testing.T) {
+		list, err := client.ListObjects(context.Background(), ListObjectsInput{
+			Bucket:     "bucket",
+			StartAfter: "logs/2497",
+		})
+		require.NoError(t, err)
+		require.Len(t, list.Objects, 2)
+		assert.Equal(t, "logs/2498", list.Objects[0].Key)
+		assert.Empty(t, list.CommonPrefixes)
+	})
+}
@@ -8,7 +8,10 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"net/http"
+	"net/url"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -31,47 +34,39 @@ type Source struct {
 	Value string
 }
 
-func (s *Source) Parse() (io.ReadSeeker, error) {
-	var (
-		data []byte
-		err  error
-	)
-
+// Open returns the content of the source and its size. Files are streamed instead of being read into memory,
+// so the caller must close the returned reader.
+func (s *Source) Open() (io.ReadCloser, int64, error) {
 	switch s.Type {
 	case SourceTypeFile:
 		path, err := homedir.Expand(s.Value)
 		if err != nil {
-			return nil, fmt.Errorf("error expanding homedir in source (%s): %w", s.Value, err)
+			return nil, 0, fmt.Errorf("error expanding homedir in source (%s): %w", s.Value, err)
 		}
 		file, err := os.Open(path)
 		if err != nil {
-			return nil, fmt.Errorf("error opening storage bucket object source (%s): %w", path, err)
+			return nil, 0, fmt.Errorf("error opening storage bucket object source (%s): %w", path, err)
 		}
-		defer func() {
-			err := file.Close()
-			if err != nil {
-				log.Printf("[WARN] Error closing storage bucket object source (%s): %s", path, err)
-			}
-		}()
-		data, err = io.ReadAll(file)
+		info, err := file.Stat()
 		if err != nil {
-			return nil, fmt.Errorf("error reading from file (%s): %w", path, err)
+			file.Close()
+			return nil, 0, fmt.Errorf("error reading storage bucket object source (%s): %w", path, err)
 		}
+		return file, info.Size(), nil
 
 	case SourceTypeContent:
-		data = []byte(s.Value)
+		return io.NopCloser(strings.NewReader(s.Value)), int64(len(s.Value)), nil
 
 	case SourceTypeContentBase64:
-		data, err = base64.StdEncoding.DecodeString(s.Value)
+		data, err := base64.StdEncoding.DecodeString(s.Value)
 		if err != nil {
-			return nil, fmt.Errorf("error decoding content_base64: %w", err)
+			return nil, 0, fmt.Errorf("error decoding content_base64: %w", err)
 		}
+		return io.NopCloser(bytes.NewReader(data)), int64(len(data)), nil
 
 	default:
-		return nil, fmt.Errorf("unsupported source type: %s", s.Type)
+		return nil, 0, fmt.Errorf("unsupported source type: %s", s.Type)
 	}
-
-	return bytes.NewReader(data), nil
 }
 
 type ObjectRetention struct {
@@ -79,6 +74,20 @@ type ObjectRetention struct {
 	RetainUntilDate time.Time
 }
 
+// ObjectMetadata is the system and user-defined metadata of the object. It can be changed only
+// by copying the object to itself, see Client.UpdateObjectMetadata.
+type ObjectMetadata struct {
+	CacheControl       string
+	ContentDisposition string
+	ContentEncoding    string
+	ContentLanguage    string
+	Expires            *time.Time
+	// Metadata is the user-defined metadata stored in `x-amz-meta-*` headers. Keys are lower case.
+	Metadata     map[string]string
+	StorageClass string
+	KMSKeyID     string
+}
+
 type CreationData struct {
 	Source                    *Source
 	Bucket                    string
@@ -88,21 +97,27 @@ type CreationData struct {
 	ObjectLockLegalHoldStatus string
 	ObjectRetention           *ObjectRetention
 	Tags                      []Tag
+	Upload                    UploadOptions
+	ObjectMetadata
 }
 
 // CreateObject creates a new object in the bucket with the given key and source.
 // It returns true if the object was created, false if it was not created (but no error occurred),
 func (c *Client) CreateObject(ctx context.Context, data CreationData) (bool, error) {
-	body, err := data.Source.Parse()
+	body, size, err := data.Source.Open()
 	if err != nil {
 		return false, fmt.Errorf("error parsing source: %w", err)
 	}
+	defer func() {
+		if err := body.Close(); err != nil {
+			log.Printf("[WARN] Error closing storage bucket object source: %s", err)
+		}
+	}()
 
 	putObjectInput := &s3.PutObjectInput{
 		Bucket: aws.String(data.Bucket),
 		Key:    aws.String(data.Key),
 		ACL:    aws.String(data.ACL),
-		Body:   body,
 	}
 
 	if data.ContentType != "" {
@@ -115,10 +130,10 @@ func (c *Client) CreateObject(ctx context.Context, data CreationData) (bool, err
 		putObjectInput.SetObjectLockMode(data.ObjectRetention.Mode)
 		putObjectInput.SetObjectLockRetainUntilDate(data.ObjectRetention.RetainUntilDate)
 	}
+	setPutObjectMetadata(putObjectInput, data.ObjectMetadata)
 
-	log.Printf("[DEBUG] Sending putObjectInput %s", putObjectInput.String())
-	if _, err := c.s3.PutObjectWithContext(ctx, putObjectInput); err != nil {
-		return false, fmt.Errorf("error putting object in bucket %q: %w", data.Bucket, err)
+	if _, err := c.upload(ctx, putObjectInput, body, size, data.Upload); err != nil {
+		return false, err
 	}
 
 	// Use separate request to set tags since it allows to caught
@@ -149,6 +164,12 @@ type Object struct {
 	ObjectLockLegalHoldStatus *string
 	ObjectRetention           *ObjectRetention
 	Tags                      []Tag
+	ObjectMetadata
+	// ETag is the entity tag of the object without quotes, see Checksum.Matches.
+	ETag          string
+	VersionID     string
+	ContentLength int64
+	LastModified  time.Time
 }
 
 func (c *Client) GetObject(ctx context.Context, bucket, key string) (*Object, error) {
@@ -172,7 +193,12 @@ func (c *Client) GetObject(ctx context.Context, bucket, key string) (*Object, er
 		Key:                       key,
 		ContentType:               resp.ContentType,
 		ObjectLockLegalHoldStatus: resp.ObjectLockLegalHoldStatus,
+		ETag:                      strings.Trim(aws.StringValue(resp.ETag), `"`),
+		VersionID:                 aws.StringValue(resp.VersionId),
+		ContentLength:             aws.Int64Value(resp.ContentLength),
+		LastModified:              aws.TimeValue(resp.LastModified),
 	}
+	object.ObjectMetadata = newObjectMetadata(resp)
 	if resp.ObjectLockMode != nil {
 		object.ObjectRetention = &ObjectRetention{
 			Mode:            aws.StringValue(resp.ObjectLockMode),
@@ -198,6 +224,251 @@ func (c *Client) GetObject(ctx context.Context, bucket, key string) (*Object, er
 	return object, nil
 }
 
+// ReadObjectBody returns the content of the object. Objects larger than maxSize are not read.
+func (c *Client) ReadObjectBody(ctx context.Context, bucket, key string, maxSize int64) ([]byte, error) {
+	resp, err := c.s3.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		var awsError awserr.RequestFailure
+		if errors.As(err, &awsError) && awsError.StatusCode() == 404 {
+			return nil, ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("error reading object (%s) content: %w", key, err)
+	}
+	defer resp.Body.Close()
+
+	if size := aws.Int64Value(resp.ContentLength); size > maxSize {
+		return nil, fmt.Errorf("object (%s) size %d exceeds the limit of %d bytes", key, size, maxSize)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("error reading object (%s) content: %w", key, err)
+	}
+	if int64(len(body)) > maxSize {
+		return nil, fmt.Errorf("object (%s) size exceeds the limit of %d bytes", key, maxSize)
+	}
+	return body, nil
+}
+
+// maxCopySize is the size limit of a single copy request. Tests lower it to copy small objects in parts.
+var maxCopySize = MaxPartSize
+
+// UpdateObjectMetadata replaces the metadata of the object by copying the object to itself.
+// Since the copy replaces all object attributes, the data must describe the whole object except its content.
+// Tags of the object are kept. Objects larger than MaxPartSize are copied in parts of the data.Upload part size,
+// so the copy has the same ETag as the upload of the object, see Source.Checksum.
+func (c *Client) UpdateObjectMetadata(ctx context.Context, data CreationData) error {
+	input := &s3.CopyObjectInput{
+		Bucket:            aws.String(data.Bucket),
+		Key:               aws.String(data.Key),
+		CopySource:        aws.String(copySource(data.Bucket, data.Key)),
+		ACL:               aws.String(data.ACL),
+		MetadataDirective: aws.String(s3.MetadataDirectiveReplace),
+	}
+	if data.ContentType != "" {
+		input.ContentType = aws.String(data.ContentType)
+	}
+	if data.ObjectLockLegalHoldStatus != "" {
+		input.SetObjectLockLegalHoldStatus(data.ObjectLockLegalHoldStatus)
+	}
+	if data.ObjectRetention != nil {
+		input.SetObjectLockMode(data.ObjectRetention.Mode)
+		input.SetObjectLockRetainUntilDate(data.ObjectRetention.RetainUntilDate)
+	}
+
+	metadata := data.ObjectMetadata
+	input.CacheControl = stringOrNil(metadata.CacheControl)
+	input.ContentDisposition = stringOrNil(metadata.ContentDisposition)
+	input.ContentEncoding = stringOrNil(metadata.ContentEncoding)
+	input.ContentLanguage = stringOrNil(metadata.ContentLanguage)
+	input.Expires = metadata.Expires
+	input.Metadata = aws.StringMap(metadata.Metadata)
+	input.StorageClass = stringOrNil(metadata.StorageClass)
+	if metadata.KMSKeyID != "" {
+		input.ServerSideEncryption = aws.String(ServerSideEncryptionAwsKms)
+		input.SSEKMSKeyId = aws.String(metadata.KMSKeyID)
+	}
+
+	head, err := c.s3.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: input.Bucket,
+		Key:    input.Key,
+	})
+	if err != nil {
+		return fmt.Errorf("error reading object (%s): %w", data.Key, err)
+	}
+	if size := aws.Int64Value(head.ContentLength); size > maxCopySize {
+		log.Printf("[DEBUG] Updating storage object metadata with multipart copy %s", input.String())
+		if err := c.copyObjectInParts(ctx, input, head, data.Upload.partSize(size)); err != nil {
+			return fmt.Errorf("error updating object metadata (%s): %w", data.Key, err)
+		}
+		return nil
+	}
+
+	log.Printf("[DEBUG] Updating storage object metadata with copyObjectInput %s", input.String())
+	if _, err := c.s3.CopyObjectWithContext(ctx, input); err != nil {
+		return fmt.Errorf("error updating object metadata (%s): %w", data.Key, err)
+	}
+	return nil
+}
+
+// copyObjectInParts copies the object described by head with a multipart upload, since a single copy
+// is limited to MaxPartSize bytes. Parts are copied only while the object keeps the same ETag.
+// Multipart uploads do not copy tags, so they are read beforehand and set on the new object.
+func (c *Client) copyObjectInParts(ctx context.Context, input *s3.CopyObjectInput, head *s3.HeadObjectOutput, partSize int64) error {
+	tags, err := c.s3.GetObjectTaggingWithContext(ctx, &s3.GetObjectTaggingInput{
+		Bucket: input.Bucket,
+		Key:    input.Key,
+	})
+	if err != nil {
+		return fmt.Errorf("error getting object tags: %w", err)
+	}
+
+	createInput := newCopyMultipartUploadInput(input)
+	if len(tags.TagSet) > 0 {
+		tagging := url.Values{}
+		for _, tag := range tags.TagSet {
+			tagging.Set(aws.StringValue(tag.Key), aws.StringValue(tag.Value))
+		}
+		createInput.Tagging = aws.String(tagging.Encode())
+	}
+	upload, err := c.s3.CreateMultipartUploadWithContext(ctx, createInput)
+	if err != nil {
+		return fmt.Errorf("error creating multipart copy: %w", err)
+	}
+
+	u := &multipartUpload{
+		client:   c,
+		bucket:   input.Bucket,
+		key:      input.Key,
+		uploadID: upload.UploadId,
+	}
+	if err := u.copyParts(ctx, input.CopySource, head, partSize); err != nil {
+		u.abort(ctx)
+		return err
+	}
+
+	_, err = c.s3.CompleteMultipartUploadWithContext(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          u.bucket,
+		Key:             u.key,
+		UploadId:        u.uploadID,
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: u.parts},
+	})
+	if err != nil {
+		u.abort(ctx)
+		return fmt.Errorf("error completing multipart copy: %w", err)
+	}
+	return nil
+}
+
+func (u *multipartUpload) copyParts(ctx context.Context, source *string, head *s3.HeadObjectOutput, partSize int64) error {
+	size := aws.Int64Value(head.ContentLength)
+	for number, offset := int64(1), int64(0); offset < size; number, offset = number+1, offset+partSize {
+		last := min(offset+partSize, size) - 1
+		resp, err := u.client.s3.UploadPartCopyWithContext(ctx, &s3.UploadPartCopyInput{
+			Bucket:            u.bucket,
+			Key:               u.key,
+			UploadId:          u.uploadID,
+			PartNumber:        aws.Int64(number),
+			CopySource:        source,
+			CopySourceIfMatch: head.ETag,
+			CopySourceRange:   aws.String(fmt.Sprintf("bytes=%d-%d", offset, last)),
+		})
+		if err != nil {
+			return fmt.Errorf("error copying part %d: %w", number, err)
+		}
+		u.parts = append(u.parts, &s3.CompletedPart{
+			ETag:       resp.CopyPartResult.ETag,
+			PartNumber: aws.Int64(number),
+		})
+	}
+	return nil
+}
+
+func newCopyMultipartUploadInput(input *s3.CopyObjectInput) *s3.CreateMultipartUploadInput {
+	return &s3.CreateMultipartUploadInput{
+		Bucket:                    input.Bucket,
+		Key:                       input.Key,
+		ACL:                       input.ACL,
+		ContentType:               input.ContentType,
+		ObjectLockLegalHoldStatus: input.ObjectLockLegalHoldStatus,
+		ObjectLockMode:            input.ObjectLockMode,
+		ObjectLockRetainUntilDate: input.ObjectLockRetainUntilDate,
+		CacheControl:              input.CacheControl,
+		ContentDisposition:        input.ContentDisposition,
+		ContentEncoding:           input.ContentEncoding,
+		ContentLanguage:           input.ContentLanguage,
+		Expires:                   input.Expires,
+		Metadata:                  input.Metadata,
+		StorageClass:              input.StorageClass,
+		ServerSideEncryption:      input.ServerSideEncryption,
+		SSEKMSKeyId:               input.SSEKMSKeyId,
+	}
+}
+
+func setPutObjectMetadata(input *s3.PutObjectInput, metadata ObjectMetadata) {
+	input.CacheControl = stringOrNil(metadata.CacheControl)
+	input.ContentDisposition = stringOrNil(metadata.ContentDisposition)
+	input.ContentEncoding = stringOrNil(metadata.ContentEncoding)
+	input.ContentLanguage = stringOrNil(metadata.ContentLanguage)
+	input.Expires = metadata.Expires
+	if len(metadata.Metadata) > 0 {
+		input.Metadata = aws.StringMap(metadata.Metadata)
+	}
+	input.StorageClass = stringOrNil(metadata.StorageClass)
+	if metadata.KMSKeyID != "" {
+		input.ServerSideEncryption = aws.String(ServerSideEncryptionAwsKms)
+		input.SSEKMSKeyId = aws.String(metadata.KMSKeyID)
+	}
+}
+
+func newObjectMetadata(resp *s3.HeadObjectOutput) ObjectMetadata {
+	metadata := ObjectMetadata{
+		CacheControl:       aws.StringValue(resp.CacheControl),
+		ContentDisposition: aws.StringValue(resp.ContentDisposition),
+		ContentEncoding:    aws.StringValue(resp.ContentEncoding),
+		ContentLanguage:    aws.StringValue(resp.ContentLanguage),
+		StorageClass:       aws.StringValue(resp.StorageClass),
+		KMSKeyID:           aws.StringValue(resp.SSEKMSKeyId),
+	}
+	// Storage class of standard objects is not returned.
+	if metadata.StorageClass == "" {
+		metadata.StorageClass = ObjectStorageClassStandard
+	}
+	if resp.Expires != nil {
+		if expires, err := http.ParseTime(aws.StringValue(resp.Expires)); err == nil {
+			metadata.Expires = &expires
+		} else {
+			log.Printf("[WARN] Unable to parse Expires header of storage object: %s", err)
+		}
+	}
+	// Header names are canonicalized by the SDK, e.g. `X-Amz-Meta-Build-Id` is returned as `Build-Id`.
+	if len(resp.Metadata) > 0 {
+		metadata.Metadata = make(map[string]string, len(resp.Metadata))
+		for k, v := range resp.Metadata {
+			metadata.Metadata[strings.ToLower(k)] = aws.StringValue(v)
+		}
+	}
+	return metadata
+}
+
+// copySource returns the escaped source of the object copy.
+func copySource(bucket, key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return bucket + "/" + strings.Join(segments, "/")
+}
+
+func stringOrNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return aws.String(s)
+}
+
 func (c *Client) UpdateObjectACL(ctx context.Context, bucket, key, acl string) error {
 	_, err := c.s3.PutObjectAclWithContext(ctx, &s3.PutObjectAclInput{
 		Bucket: aws.String(bucket),
@@ -300,3 +571,34 @@ func (c *Client) DeleteObject(ctx context.Context, bucket, key string) error {
 
 	return nil
 }
+
+// deleteObjectsBatchSize is the maximum number of keys in a single DeleteObjects request.
+const deleteObjectsBatchSize = 1000
+
+// DeleteObjects deletes the current versions of the objects with the given keys.
+func (c *Client) DeleteObjects(ctx context.Context, bucket string, keys []string) error {
+	for start := 0; start < len(keys); start += deleteObjectsBatchSize {
+		batch := keys[start:min(start+deleteObjectsBatchSize, len(keys))]
+		objects := make([]*s3.ObjectIdentifier, 0, len(batch))
+		for _, key := range batch {
+			objects = append(objects, &s3.ObjectIdentifier{Key: aws.String(key)})
+		}
+
+		resp, err := c.s3.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("error deleting storage objects in bucket %q: %w", bucket, err)
+		}
+		if len(resp.Errors) > 0 {
+			e := resp.Errors[0]
+			return fmt.Errorf("error deleting storage object %q in bucket %q: %s: %s",
+				aws.StringValue(e.Key), bucket, aws.StringValue(e.Code), aws.StringValue(e.Message))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,374 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+const (
+	// MinPartSize is the minimal size of all parts of multipart upload except the last one.
+	MinPartSize int64 = 5 * 1024 * 1024
+	// MaxPartSize is the maximal size of a part of multipart upload and of an object uploaded with a single request.
+	MaxPartSize int64 = 5 * 1024 * 1024 * 1024
+	// MaxParts is the maximal number of parts of multipart upload.
+	MaxParts = 10000
+
+	DefaultPartSize          int64 = 16 * 1024 * 1024
+	DefaultUploadConcurrency       = 4
+)
+
+// UploadOptions configure streaming upload of objects. Objects which are not larger than the part size
+// are uploaded with a single request, larger ones are uploaded in parts. Up to Concurrency parts are
+// uploaded in parallel, so upload takes about PartSize * Concurrency bytes of memory.
+type UploadOptions struct {
+	PartSize    int64
+	Concurrency int
+}
+
+func (o UploadOptions) partSize(size int64) int64 {
+	partSize := o.PartSize
+	if partSize <= 0 {
+		partSize = DefaultPartSize
+	}
+	// Grow parts of known size objects to fit into the limit of parts.
+	if minSize := (size + MaxParts - 1) / MaxParts; partSize < minSize {
+		partSize = minSize
+	}
+	return partSize
+}
+
+func (o UploadOptions) concurrency() int {
+	if o.Concurrency <= 0 {
+		return DefaultUploadConcurrency
+	}
+	return o.Concurrency
+}
+
+// Checksum is calculated while the object is uploaded.
+type Checksum struct {
+	// MD5 is the hex encoded MD5 of the object content.
+	MD5 string
+	// ETag is the expected ETag of the object: MD5 of the content for objects uploaded with a single request,
+	// and MD5 of part MD5s with the number of parts for multipart uploaded ones, e.g. `<md5>-3`.
+	ETag string
+}
+
+// Matches reports whether the ETag returned by the storage corresponds to the checksum. Besides the ETag of
+// the upload, MD5 of the content is accepted, since copies of multipart uploaded objects have such ETags.
+func (c *Checksum) Matches(etag string) bool {
+	etag = strings.Trim(etag, `"`)
+	return etag == c.ETag || etag == c.MD5
+}
+
+// Checksum calculates the checksum of the source content uploaded with opts without uploading it.
+func (s *Source) Checksum(opts UploadOptions) (*Checksum, error) {
+	body, size, err := s.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	partSize := opts.partSize(size)
+	objectHash := md5.New()
+	partsHash := md5.New()
+	var parts int
+	single := false
+	for {
+		partHash := md5.New()
+		n, err := io.CopyN(io.MultiWriter(objectHash, partHash), body, partSize)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("error reading object source: %w", err)
+		}
+		// Content smaller than a part is uploaded with a single request, see Client.upload.
+		if parts == 0 && n < partSize {
+			single = true
+			break
+		}
+		if n == 0 {
+			break
+		}
+		parts++
+		partsHash.Write(partHash.Sum(nil))
+		if n < partSize {
+			break
+		}
+	}
+
+	checksum := &Checksum{MD5: hex.EncodeToString(objectHash.Sum(nil))}
+	if single {
+		checksum.ETag = checksum.MD5
+	} else {
+		checksum.ETag = fmt.Sprintf("%s-%d", hex.EncodeToString(partsHash.Sum(nil)), parts)
+	}
+	return checksum, nil
+}
+
+// upload streams body to the object described by input. It verifies every part with Content-MD5
+// and aborts the multipart upload if any part fails, so no incomplete upload is left in the bucket.
+func (c *Client) upload(ctx context.Context, input *s3.PutObjectInput, body io.Reader, size int64, opts UploadOptions) (*Checksum, error) {
+	partSize := opts.partSize(size)
+	objectHash := md5.New()
+	body = io.TeeReader(body, objectHash)
+
+	first, err := readPart(body, partSize, size)
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(first)) < partSize {
+		return c.putObject(ctx, input, first, objectHash)
+	}
+
+	upload, err := c.s3.CreateMultipartUploadWithContext(ctx, newCreateMultipartUploadInput(input))
+	if err != nil {
+		return nil, fmt.Errorf("error creating multipart upload of object %q: %w", aws.StringValue(input.Key), err)
+	}
+	log.Printf("[DEBUG] Started multipart upload %s of object %q with %d bytes parts", aws.StringValue(upload.UploadId), aws.StringValue(input.Key), partSize)
+
+	u := &multipartUpload{
+		client:   c,
+		bucket:   input.Bucket,
+		key:      input.Key,
+		uploadID: upload.UploadId,
+	}
+	checksum, err := u.run(ctx, body, first, partSize, opts.concurrency(), objectHash)
+	if err != nil {
+		u.abort(ctx)
+		return nil, err
+	}
+	return checksum, nil
+}
+
+func (c *Client) putObject(ctx context.Context, input *s3.PutObjectInput, data []byte, objectHash hash.Hash) (*Checksum, error) {
+	sum := objectHash.Sum(nil)
+	input.Body = bytes.NewReader(data)
+	input.ContentMD5 = aws.String(base64.StdEncoding.EncodeToString(sum))
+
+	log.Printf("[DEBUG] Sending putObjectInput %s", input.String())
+	resp, err := c.s3.PutObjectWithContext(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("error putting object in bucket %q: %w", aws.StringValue(input.Bucket), err)
+	}
+
+	checksum := &Checksum{
+		MD5:  hex.EncodeToString(sum),
+		ETag: hex.EncodeToString(sum),
+	}
+	return checksum, checkETag(resp.ETag, resp.ServerSideEncryption, checksum.ETag)
+}
+
+type multipartUpload struct {
+	client   *Client
+	bucket   *string
+	key      *string
+	uploadID *string
+
+	mu    sync.Mutex
+	parts []*s3.CompletedPart
+	md5s  map[int64][]byte
+	err   error
+}
+
+func (u *multipartUpload) run(ctx context.Context, body io.Reader, first []byte, partSize int64, concurrency int, objectHash hash.Hash) (*Checksum, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	u.md5s = make(map[int64][]byte)
+	slots := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	data := first
+	for number := int64(1); ; number++ {
+		if number > MaxParts {
+			u.fail(fmt.Errorf("object %q has more than %d parts of %d bytes", aws.StringValue(u.key), MaxParts, partSize))
+			break
+		}
+
+		// Next part is read only when a slot is free, which bounds the memory used for parts.
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil || u.failed() {
+			break
+		}
+
+		wg.Add(1)
+		go func(number int64, data []byte) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			if err := u.uploadPart(ctx, number, data); err != nil {
+				u.fail(err)
+				cancel()
+			}
+		}(number, data)
+
+		if int64(len(data)) < partSize {
+			break
+		}
+		var err error
+		if data, err = readPart(body, partSize, partSize); err != nil {
+			u.fail(err)
+			break
+		}
+		if len(data) == 0 {
+			break
+		}
+	}
+	wg.Wait()
+
+	if u.err != nil {
+		return nil, u.err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return u.complete(ctx, objectHash)
+}
+
+func (u *multipartUpload) uploadPart(ctx context.Context, number int64, data []byte) error {
+	sum := md5.Sum(data)
+	resp, err := u.client.s3.UploadPartWithContext(ctx, &s3.UploadPartInput{
+		Bucket:     u.bucket,
+		Key:        u.key,
+		UploadId:   u.uploadID,
+		PartNumber: aws.Int64(number),
+		Body:       bytes.NewReader(data),
+		ContentMD5: aws.String(base64.StdEncoding.EncodeToString(sum[:])),
+	})
+	if err != nil {
+		return fmt.Errorf("error uploading part %d of object %q: %w", number, aws.StringValue(u.key), err)
+	}
+	if err := checkETag(resp.ETag, resp.ServerSideEncryption, hex.EncodeToString(sum[:])); err != nil {
+		return fmt.Errorf("part %d of object %q is corrupted: %w", number, aws.StringValue(u.key), err)
+	}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.parts = append(u.parts, &s3.CompletedPart{
+		ETag:       resp.ETag,
+		PartNumber: aws.Int64(number),
+	})
+	u.md5s[number] = sum[:]
+	return nil
+}
+
+func (u *multipartUpload) complete(ctx context.Context, objectHash hash.Hash) (*Checksum, error) {
+	sort.Slice(u.parts, func(i, j int) bool {
+		return aws.Int64Value(u.parts[i].PartNumber) < aws.Int64Value(u.parts[j].PartNumber)
+	})
+
+	partsHash := md5.New()
+	for _, part := range u.parts {
+		partsHash.Write(u.md5s[aws.Int64Value(part.PartNumber)])
+	}
+	checksum := &Checksum{
+		MD5:  hex.EncodeToString(objectHash.Sum(nil)),
+		ETag: fmt.Sprintf("%s-%d", hex.EncodeToString(partsHash.Sum(nil)), len(u.parts)),
+	}
+
+	resp, err := u.client.s3.CompleteMultipartUploadWithContext(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          u.bucket,
+		Key:             u.key,
+		UploadId:        u.uploadID,
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: u.parts},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error completing multipart upload of object %q: %w", aws.StringValue(u.key), err)
+	}
+	return checksum, checkETag(resp.ETag, resp.ServerSideEncryption, checksum.ETag)
+}
+
+// abort removes uploaded parts. It uses a separate context, since the upload may fail because of the cancelled one.
+func (u *multipartUpload) abort(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), retryTimeout)
+	defer cancel()
+
+	log.Printf("[DEBUG] Aborting multipart upload %s of object %q", aws.StringValue(u.uploadID), aws.StringValue(u.key))
+	_, err := u.client.s3.AbortMultipartUploadWithContext(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   u.bucket,
+		Key:      u.key,
+		UploadId: u.uploadID,
+	})
+	if err != nil {
+		log.Printf("[WARN] Failed to abort multipart upload %s of object %q: %s", aws.StringValue(u.uploadID), aws.StringValue(u.key), err)
+	}
+}
+
+func (u *multipartUpload) fail(err error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if u.err == nil {
+		u.err = err
+	}
+}
+
+func (u *multipartUpload) failed() bool {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return u.err != nil
+}
+
+// readPart reads up to size bytes. It returns less bytes only at the end of the body.
+// The buffer is allocated for sizeHint bytes if it is known to be less than size.
+func readPart(body io.Reader, size, sizeHint int64) ([]byte, error) {
+	capacity := size
+	if sizeHint >= 0 && sizeHint < size {
+		capacity = sizeHint
+	}
+	buf := bytes.NewBuffer(make([]byte, 0, capacity+bytes.MinRead))
+	if _, err := buf.ReadFrom(io.LimitReader(body, size)); err != nil {
+		return nil, fmt.Errorf("error reading object source: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// checkETag compares the ETag returned by the storage with the expected one. ETags of objects encrypted
+// with KMS keys are not MD5 of the content, so they are not compared.
+func checkETag(etag, serverSideEncryption *string, expected string) error {
+	actual := strings.Trim(aws.StringValue(etag), `"`)
+	if actual == "" || aws.StringValue(serverSideEncryption) == ServerSideEncryptionAwsKms || !isMD5ETag(actual) || actual == expected {
+		return nil
+	}
+	return fmt.Errorf("checksum mismatch: storage returned ETag %s, expected %s", actual, expected)
+}
+
+func isMD5ETag(etag string) bool {
+	hexPart, _, _ := strings.Cut(etag, "-")
+	_, err := hex.DecodeString(hexPart)
+	return err == nil && len(hexPart) == 2*md5.Size
+}
+
+func newCreateMultipartUploadInput(input *s3.PutObjectInput) *s3.CreateMultipartUploadInput {
+	return &s3.CreateMultipartUploadInput{
+		Bucket:                    input.Bucket,
+		Key:                       input.Key,
+		ACL:                       input.ACL,
+		ContentType:               input.ContentType,
+		ObjectLockLegalHoldStatus: input.ObjectLockLegalHoldStatus,
+		ObjectLockMode:            input.ObjectLockMode,
+		ObjectLockRetainUntilDate: input.ObjectLockRetainUntilDate,
+		CacheControl:              input.CacheControl,
+		ContentDisposition:        input.ContentDisposition,
+		ContentEncoding:           input.ContentEncoding,
+		ContentLanguage:           input.ContentLanguage,
+		Expires:                   input.Expires,
+		Metadata:                  input.Metadata,
+		StorageClass:              input.StorageClass,
+		ServerSideEncryption:      input.ServerSideEncryption,
+		SSEKMSKeyId:               input.SSEKMSKeyId,
+	}
+}
@@ -0,0 +1,373 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeStorage is a local stand-in of the S3 API which supports single and multipart uploads
+// and multipart copy.
+type fakeStorage struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	// etags keeps the ETags of objects uploaded in parts, ETags of other objects are MD5 of their content.
+	etags   map[string]string
+	tags    map[string]string
+	uploads map[string]map[int][]byte
+	// uploadTags keeps the tagging of multipart uploads until they are completed.
+	uploadTags map[string]string
+	aborted    []string
+	uploadID   int
+	// failPart makes upload of the part with this number fail.
+	failPart int
+}
+
+func newFakeStorage(t *testing.T) (*fakeStorage, *Client) {
+	storage := &fakeStorage{
+		objects:    make(map[string][]byte),
+		etags:      make(map[string]string),
+		tags:       make(map[string]string),
+		uploads:    make(map[string]map[int][]byte),
+		uploadTags: make(map[string]string),
+	}
+	server := httptest.NewServer(storage)
+	t.Cleanup(server.Close)
+
+	config := &aws.Config{
+		Endpoint:         aws.String(server.URL),
+		Region:           aws.String(defaultS3Region),
+		S3ForcePathStyle: aws.Bool(true),
+		Credentials:      credentials.NewStaticCredentials("key", "secret", ""),
+		MaxRetries:       aws.Int(0),
+	}
+	ssn, err := session.NewSession(config)
+	require.NoError(t, err)
+	return storage, &Client{s3: s3.New(ssn, config)}
+}
+
+func (f *fakeStorage) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	key := strings.TrimPrefix(r.URL.Path, "/")
+	query := r.URL.Query()
+	body, _ := io.ReadAll(r.Body)
+
+	if md5Header := r.Header.Get("Content-MD5"); md5Header != "" {
+		sum := md5.Sum(body)
+		if md5Header != base64.StdEncoding.EncodeToString(sum[:]) {
+			writeError(w, http.StatusBadRequest, "BadDigest")
+			return
+		}
+	}
+
+	switch {
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		f.uploadID++
+		id := strconv.Itoa(f.uploadID)
+		f.uploads[id] = make(map[int][]byte)
+		f.uploadTags[id] = r.Header.Get("X-Amz-Tagging")
+		fmt.Fprintf(w, `<InitiateMultipartUploadResult><Key>%s</Key><UploadId>%s</UploadId></InitiateMultipartUploadResult>`, key, id)
+
+	case r.Method == http.MethodPut && query.Has("uploadId") && r.Header.Get("X-Amz-Copy-Source") != "":
+		source, _ := url.PathUnescape(r.Header.Get("X-Amz-Copy-Source"))
+		object, ok := f.objects[source]
+		if !ok {
+			writeError(w, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+		if ifMatch := r.Header.Get("X-Amz-Copy-Source-If-Match"); ifMatch != "" && ifMatch != f.objectETag(source) {
+			writeError(w, http.StatusPreconditionFailed, "PreconditionFailed")
+			return
+		}
+		var first, last int
+		fmt.Sscanf(r.Header.Get("X-Amz-Copy-Source-Range"), "bytes=%d-%d", &first, &last)
+		number, _ := strconv.Atoi(query.Get("partNumber"))
+		part := object[first : last+1]
+		f.uploads[query.Get("uploadId")][number] = part
+		fmt.Fprintf(w, `<CopyPartResult><ETag>%s</ETag></CopyPartResult>`, etag(part))
+
+	case r.Method == http.MethodPut && query.Has("uploadId"):
+		number, _ := strconv.Atoi(query.Get("partNumber"))
+		if number == f.failPart {
+			writeError(w, http.StatusForbidden, "AccessDenied")
+			return
+		}
+		f.uploads[query.Get("uploadId")][number] = body
+		w.Header().Set("ETag", etag(body))
+
+	case r.Method == http.MethodPost && query.Has("uploadId"):
+		var complete struct {
+			Parts []struct {
+				PartNumber int
+				ETag       string
+			} `xml:"Part"`
+		}
+		if err := xml.Unmarshal(body, &complete); err != nil {
+			writeError(w, http.StatusBadRequest, "MalformedXML")
+			return
+		}
+		parts := f.uploads[query.Get("uploadId")]
+		var object []byte
+		partsHash := md5.New()
+		for _, part := range complete.Parts {
+			sum := md5.Sum(parts[part.PartNumber])
+			partsHash.Write(sum[:])
+			object = append(object, parts[part.PartNumber]...)
+		}
+		f.objects[key] = object
+		f.etags[key] = fmt.Sprintf(`"%s-%d"`, hex.EncodeToString(partsHash.Sum(nil)), len(complete.Parts))
+		f.tags[key] = f.uploadTags[query.Get("uploadId")]
+		delete(f.uploads, query.Get("uploadId"))
+		fmt.Fprintf(w, `<CompleteMultipartUploadResult><Key>%s</Key><ETag>%s</ETag></CompleteMultipartUploadResult>`,
+			key, f.etags[key])
+
+	case r.Method == http.MethodDelete && query.Has("uploadId"):
+		f.aborted = append(f.aborted, query.Get("uploadId"))
+		delete(f.uploads, query.Get("uploadId"))
+		w.WriteHeader(http.StatusNoContent)
+
+	case r.Method == http.MethodGet && query.Has("tagging"):
+		tags, _ := url.ParseQuery(f.tags[key])
+		fmt.Fprint(w, `<Tagging><TagSet>`)
+		for k := range tags {
+			fmt.Fprintf(w, `<Tag><Key>%s</Key><Value>%s</Value></Tag>`, k, tags.Get(k))
+		}
+		fmt.Fprint(w, `</TagSet></Tagging>`)
+
+	case r.Method == http.MethodGet && query.Get("list-type") == "2":
+		f.list(w, key, query)
+
+	case r.Method == http.MethodHead:
+		if _, ok := f.objects[key]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("ETag", f.objectETag(key))
+		w.Header().Set("Content-Length", strconv.Itoa(len(f.objects[key])))
+
+	case r.Method == http.MethodPut:
+		f.objects[key] = body
+		delete(f.etags, key)
+		w.Header().Set("ETag", etag(body))
+
+	default:
+		writeError(w, http.StatusNotImplemented, "NotImplemented")
+	}
+}
+
+func (f *fakeStorage) objectETag(key string) string {
+	if etag, ok := f.etags[key]; ok {
+		return etag
+	}
+	return etag(f.objects[key])
+}
+
+func etag(data []byte) string {
+	sum := md5.Sum(data)
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
+
+func writeError(w http.ResponseWriter, status int, code string) {
+	w.WriteHeader(status)
+	fmt.Fprintf(w, `<Error><Code>%s</Code><Message>%s</Message></Error>`, code, code)
+}
+
+func randomContent(size int) []byte {
+	data := make([]byte, size)
+	rand.New(rand.NewSource(int64(size))).Read(data)
+	return data
+}
+
+func TestUploadSingleRequest(t *testing.T) {
+	storage, client := newFakeStorage(t)
+	content := randomContent(1000)
+
+	checksum, err := client.upload(context.Background(), &s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("small"),
+	}, bytes.NewReader(content), int64(len(content)), UploadOptions{PartSize: 1024})
+	require.NoError(t, err)
+
+	sum := md5.Sum(content)
+	assert.Equal(t, hex.EncodeToString(sum[:]), checksum.MD5)
+	assert.Equal(t, checksum.MD5, checksum.ETag)
+	assert.Equal(t, content, storage.objects["bucket/small"])
+}
+
+func TestUploadMultipart(t *testing.T) {
+	storage, client := newFakeStorage(t)
+	content := randomContent(10*1024 + 100)
+
+	// The reader hides the size of the content like a pipe does.
+	checksum, err := client.upload(context.Background(), &s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("large"),
+	}, io.MultiReader(bytes.NewReader(content)), -1, UploadOptions{PartSize: 1024, Concurrency: 3})
+	require.NoError(t, err)
+
+	sum := md5.Sum(content)
+	assert.Equal(t, hex.EncodeToString(sum[:]), checksum.MD5)
+	assert.True(t, strings.HasSuffix(checksum.ETag, "-11"), checksum.ETag)
+	assert.Equal(t, content, storage.objects["bucket/large"])
+	assert.Empty(t, storage.uploads)
+}
+
+func TestUploadAbortsFailedMultipartUpload(t *testing.T) {
+	storage, client := newFakeStorage(t)
+	storage.failPart = 3
+	content := randomContent(8 * 1024)
+
+	_, err := client.upload(context.Background(), &s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("failed"),
+	}, bytes.NewReader(content), int64(len(content)), UploadOptions{PartSize: 1024, Concurrency: 2})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "part 3")
+
+	assert.Equal(t, []string{"1"}, storage.aborted)
+	assert.Empty(t, storage.uploads)
+	assert.NotContains(t, storage.objects, "bucket/failed")
+}
+
+func TestCopyObjectInParts(t *testing.T) {
+	storage, client := newFakeStorage(t)
+	content := randomContent(5*1024 + 100)
+	storage.objects["bucket/dir/large"] = content
+	storage.tags["bucket/dir/large"] = "env=test"
+
+	err := client.copyObjectInParts(context.Background(), &s3.CopyObjectInput{
+		Bucket:      aws.String("bucket"),
+		Key:         aws.String("dir/large"),
+		CopySource:  aws.String(copySource("bucket", "dir/large")),
+		ContentType: aws.String("text/plain"),
+	}, &s3.HeadObjectOutput{
+		ContentLength: aws.Int64(int64(len(content))),
+		ETag:          aws.String(etag(content)),
+	}, 1024)
+	require.NoError(t, err)
+
+	assert.Equal(t, content, storage.objects["bucket/dir/large"])
+	assert.Equal(t, "env=test", storage.tags["bucket/dir/large"])
+	assert.Empty(t, storage.uploads)
+}
+
+func TestCopyObjectInPartsAbortsChangedObject(t *testing.T) {
+	storage, client := newFakeStorage(t)
+	content := randomContent(3 * 1024)
+	storage.objects["bucket/changed"] = content
+
+	err := client.copyObjectInParts(context.Background(), &s3.CopyObjectInput{
+		Bucket:     aws.String("bucket"),
+		Key:        aws.String("changed"),
+		CopySource: aws.String(copySource("bucket", "changed")),
+	}, &s3.HeadObjectOutput{
+		ContentLength: aws.Int64(int64(len(content))),
+		ETag:          aws.String(etag(randomContent(10))),
+	}, 1024)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "part 1")
+
+	assert.Equal(t, []string{"1"}, storage.aborted)
+	assert.Empty(t, storage.uploads)
+	assert.Equal(t, content, storage.objects["bucket/changed"])
+}
+
+func TestUpdateObjectMetadataKeepsChecksum(t *testing.T) {
+	storage, client := newFakeStorage(t)
+	// The object is copied in parts as if it were larger than a single copy allows.
+	maxCopySize = 2048
+	t.Cleanup(func() { maxCopySize = MaxPartSize })
+
+	source := &Source{
+		Type:  SourceTypeContentBase64,
+		Value: base64.StdEncoding.EncodeToString(randomContent(5*1024 + 100)),
+	}
+	data := CreationData{
+		Source: source,
+		Bucket: "bucket",
+		Key:    "large",
+		Upload: UploadOptions{PartSize: 1024},
+	}
+	_, err := client.CreateObject(context.Background(), data)
+	require.NoError(t, err)
+
+	data.ContentType = "text/plain"
+	require.NoError(t, client.UpdateObjectMetadata(context.Background(), data))
+
+	// The plan compares the ETag of the copy with the checksum of the source, see resourceYandexStorageObjectCustomizeDiff.
+	head, err := client.s3.HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("large"),
+	})
+	require.NoError(t, err)
+	checksum, err := source.Checksum(data.Upload)
+	require.NoError(t, err)
+	assert.True(t, strings.HasSuffix(aws.StringValue(head.ETag), `-6"`), aws.StringValue(head.ETag))
+	assert.True(t, checksum.Matches(aws.StringValue(head.ETag)), aws.StringValue(head.ETag))
+	assert.Empty(t, storage.uploads)
+}
+
+func TestSourceChecksumMatchesUpload(t *testing.T) {
+	_, client := newFakeStorage(t)
+	opts := UploadOptions{PartSize: 1024}
+
+	for _, size := range []int{0, 1000, 1024, 2048, 3000} {
+		t.Run(strconv.Itoa(size), func(t *testing.T) {
+			source := &Source{
+				Type:  SourceTypeContentBase64,
+				Value: base64.StdEncoding.EncodeToString(randomContent(size)),
+			}
+			expected, err := source.Checksum(opts)
+			require.NoError(t, err)
+
+			body, size, err := source.Open()
+			require.NoError(t, err)
+			defer body.Close()
+			uploaded, err := client.upload(context.Background(), &s3.PutObjectInput{
+				Bucket: aws.String("bucket"),
+				Key:    aws.String("object"),
+			}, body, size, opts)
+			require.NoError(t, err)
+
+			assert.Equal(t, uploaded, expected)
+			assert.True(t, expected.Matches(`"`+uploaded.ETag+`"`))
+			assert.True(t, expected.Matches(uploaded.MD5))
+		})
+	}
+}
+
+func TestUploadPartSize(t *testing.T) {
+	assert.Equal(t, int64(DefaultPartSize), UploadOptions{}.partSize(-1))
+	assert.Equal(t, int64(MinPartSize), UploadOptions{PartSize: MinPartSize}.partSize(MinPartSize*MaxParts))
+	assert.Equal(t, int64(MinPartSize+1), UploadOptions{PartSize: MinPartSize}.partSize(MinPartSize*MaxParts+1))
+}
+
+func TestCheckETag(t *testing.T) {
+	assert.NoError(t, checkETag(aws.String(`"0cc175b9c0f1b6a831c399e269772661"`), nil, "0cc175b9c0f1b6a831c399e269772661"))
+	assert.Error(t, checkETag(aws.String(`"0cc175b9c0f1b6a831c399e269772661"`), nil, "92eb5ffee6ae2fec3ad71c777531578f"))
+	assert.NoError(t, checkETag(aws.String(`"0cc175b9c0f1b6a831c399e269772661"`), aws.String(ServerSideEncryptionAwsKms), "92eb5ffee6ae2fec3ad71c777531578f"))
+	assert.NoError(t, checkETag(aws.String(`"not-md5"`), nil, "92eb5ffee6ae2fec3ad71c777531578f"))
+	assert.NoError(t, checkETag(nil, nil, "92eb5ffee6ae2fec3ad71c777531578f"))
+}
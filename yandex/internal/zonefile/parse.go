@@ -0,0 +1,274 @@
+// Package zonefile parses and renders DNS zone files in the RFC 1035 master file format.
+package zonefile
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// MaxTTL is the largest TTL value allowed by RFC 2181.
+const MaxTTL = 1<<31 - 1
+
+// RecordSet is a set of records with the same name and type.
+type RecordSet struct {
+	// Name is the fully qualified name of the records with the trailing dot.
+	Name string
+	Type string
+	TTL  int64
+	Data []string
+}
+
+var (
+	typePattern = regexp.MustCompile(`^[A-Z][A-Z0-9-]*$`)
+	ttlPattern  = regexp.MustCompile(`^(?:[0-9]+[smhdwSMHDW])+$`)
+
+	classes = map[string]bool{"IN": true, "CH": true, "HS": true, "CS": true}
+
+	ttlUnits = map[byte]int64{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+
+	// domainNameFields lists the positions of domain names in the data of record types.
+	// Relative names at these positions are qualified with the origin.
+	domainNameFields = map[string][]int{
+		"CNAME": {0},
+		"DNAME": {0},
+		"ANAME": {0},
+		"NS":    {0},
+		"PTR":   {0},
+		"MX":    {1},
+		"SRV":   {3},
+		"SOA":   {0, 1},
+	}
+)
+
+// Parse parses the content of a zone file into record sets in the order of their first occurrence.
+// Relative names are qualified with origin until a $ORIGIN directive changes it, and records without
+// TTL get the value of the $TTL directive or, before it, the TTL of the previous record or defaultTTL.
+// With negative defaultTTL such records are rejected.
+// The character strings of TXT and SPF records are concatenated into a single value, while the ones of
+// other records stay quoted.
+func Parse(content, origin string, defaultTTL int64) ([]RecordSet, error) {
+	entries, err := scan(content)
+	if err != nil {
+		return nil, err
+	}
+
+	p := parser{
+		origin: fqdn(origin),
+		ttl:    defaultTTL,
+		index:  make(map[string]int),
+	}
+	for _, e := range entries {
+		if err := p.parseEntry(e); err != nil {
+			return nil, fmt.Errorf("line %d: %w", e.line, err)
+		}
+	}
+	return p.recordSets, nil
+}
+
+type parser struct {
+	origin string
+	// ttl is the TTL of records without explicit one.
+	ttl          int64
+	ttlDirective bool
+	owner        string
+	recordSets   []RecordSet
+	// index maps the name and type of record sets to their position in recordSets.
+	index map[string]int
+}
+
+func (p *parser) parseEntry(e entry) error {
+	first := e.tokens[0]
+	if !e.continued && !first.quoted && strings.HasPrefix(first.value, "$") {
+		return p.parseDirective(e.tokens)
+	}
+
+	tokens := e.tokens
+	if e.continued {
+		if p.owner == "" {
+			return fmt.Errorf("record without owner name")
+		}
+	} else {
+		owner, err := p.qualify(first.value)
+		if err != nil {
+			return err
+		}
+		p.owner = owner
+		tokens = tokens[1:]
+	}
+
+	ttl := int64(-1)
+	class := ""
+	for len(tokens) > 0 && !tokens[0].quoted {
+		if v, err := parseTTL(tokens[0].value); err == nil && ttl < 0 {
+			ttl = v
+		} else if upper := strings.ToUpper(tokens[0].value); classes[upper] && class == "" {
+			class = upper
+		} else {
+			break
+		}
+		tokens = tokens[1:]
+	}
+	if class != "" && class != "IN" {
+		return fmt.Errorf("unsupported class %s", class)
+	}
+	switch {
+	case ttl >= 0 && !p.ttlDirective:
+		// Without $TTL records get the last explicitly stated TTL (RFC 1035 section 5.1).
+		p.ttl = ttl
+	case ttl < 0 && p.ttl < 0:
+		return fmt.Errorf("no TTL for %s and no $TTL directive", p.owner)
+	case ttl < 0:
+		ttl = p.ttl
+	}
+
+	if len(tokens) == 0 {
+		return fmt.Errorf("no type for %s", p.owner)
+	}
+	recordType := strings.ToUpper(tokens[0].value)
+	if tokens[0].quoted || !typePattern.MatchString(recordType) {
+		return fmt.Errorf("invalid type %q for %s", tokens[0].value, p.owner)
+	}
+	data, err := p.parseData(recordType, tokens[1:])
+	if err != nil {
+		return fmt.Errorf("%s %s: %w", p.owner, recordType, err)
+	}
+
+	p.add(p.owner, recordType, ttl, data)
+	return nil
+}
+
+func (p *parser) parseDirective(tokens []token) error {
+	directive := strings.ToUpper(tokens[0].value)
+	switch directive {
+	case "$ORIGIN":
+		if len(tokens) != 2 {
+			return fmt.Errorf("$ORIGIN requires a single domain name")
+		}
+		origin, err := p.qualify(tokens[1].value)
+		if err != nil {
+			return err
+		}
+		p.origin = origin
+	case "$TTL":
+		if len(tokens) != 2 {
+			return fmt.Errorf("$TTL requires a single value")
+		}
+		ttl, err := parseTTL(tokens[1].value)
+		if err != nil {
+			return err
+		}
+		p.ttl = ttl
+		p.ttlDirective = true
+	default:
+		return fmt.Errorf("unsupported directive %s", directive)
+	}
+	return nil
+}
+
+func (p *parser) parseData(recordType string, tokens []token) (string, error) {
+	if len(tokens) == 0 {
+		return "", fmt.Errorf("no data")
+	}
+
+	if recordType == "TXT" || recordType == "SPF" {
+		var sb strings.Builder
+		for _, t := range tokens {
+			sb.WriteString(t.value)
+		}
+		return sb.String(), nil
+	}
+
+	// Quoted character strings of other types, e.g. the value of CAA records, keep their quotes.
+	fields := make([]string, len(tokens))
+	for i, t := range tokens {
+		fields[i] = t.value
+		if t.quoted {
+			fields[i] = quote(t.value)
+		}
+	}
+	for _, i := range domainNameFields[recordType] {
+		if i >= len(fields) {
+			return "", fmt.Errorf("expected at least %d fields, got %d", i+1, len(fields))
+		}
+		name, err := p.qualify(fields[i])
+		if err != nil {
+			return "", err
+		}
+		fields[i] = name
+	}
+	return strings.Join(fields, " "), nil
+}
+
+func (p *parser) add(name, recordType string, ttl int64, data string) {
+	key := name + " " + recordType
+	if i, ok := p.index[key]; ok {
+		// All records of a set have the TTL of the first one, as RFC 2181 section 5.2 requires.
+		p.recordSets[i].Data = append(p.recordSets[i].Data, data)
+		return
+	}
+	p.index[key] = len(p.recordSets)
+	p.recordSets = append(p.recordSets, RecordSet{
+		Name: name,
+		Type: recordType,
+		TTL:  ttl,
+		Data: []string{data},
+	})
+}
+
+// qualify makes name fully qualified. "@" stands for the origin.
+func (p *parser) qualify(name string) (string, error) {
+	name = strings.ToLower(name)
+	switch {
+	case name == "@":
+		if p.origin == "" {
+			return "", fmt.Errorf("@ used without origin")
+		}
+		return p.origin, nil
+	case strings.HasSuffix(name, "."):
+		return name, nil
+	case p.origin == "":
+		return "", fmt.Errorf("relative name %q without origin", name)
+	case p.origin == ".":
+		return name + ".", nil
+	default:
+		return name + "." + p.origin, nil
+	}
+}
+
+// parseTTL parses TTL in seconds or in BIND format like 1h30m.
+func parseTTL(value string) (int64, error) {
+	var ttl int64
+	if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+		ttl = n
+	} else if ttlPattern.MatchString(value) {
+		var n int64
+		for i := 0; i < len(value); i++ {
+			c := value[i]
+			if c >= '0' && c <= '9' {
+				n = n*10 + int64(c-'0')
+				if n > MaxTTL {
+					return 0, fmt.Errorf("TTL %s is too large", value)
+				}
+				continue
+			}
+			ttl += n * ttlUnits[c|0x20]
+			n = 0
+		}
+	} else {
+		return 0, fmt.Errorf("invalid TTL %q", value)
+	}
+	if ttl < 0 || ttl > MaxTTL {
+		return 0, fmt.Errorf("TTL %s is out of range", value)
+	}
+	return ttl, nil
+}
+
+func fqdn(name string) string {
+	name = strings.ToLower(name)
+	if name == "" || strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
@@ -0,0 +1,121 @@
+package zonefile
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// maxCharacterString is the maximum length of a character string in TXT records.
+const maxCharacterString = 255
+
+// Render renders record sets as a zone file with the $ORIGIN directive. Names within the origin
+// are written relative to it. Record sets are sorted by name with SOA and NS records first, and
+// TXT and SPF values are quoted and split into character strings.
+func Render(origin string, recordSets []RecordSet) string {
+	origin = fqdn(origin)
+
+	sorted := make([]RecordSet, len(recordSets))
+	copy(sorted, recordSets)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if a.Name != b.Name {
+			// The origin goes first.
+			if a.Name == origin || b.Name == origin {
+				return a.Name == origin
+			}
+			return reverseLabels(a.Name) < reverseLabels(b.Name)
+		}
+		if typeOrder(a.Type) != typeOrder(b.Type) {
+			return typeOrder(a.Type) < typeOrder(b.Type)
+		}
+		return a.Type < b.Type
+	})
+
+	var sb strings.Builder
+	if origin != "" {
+		fmt.Fprintf(&sb, "$ORIGIN %s\n", origin)
+	}
+	for _, rs := range sorted {
+		name := relativeName(fqdn(rs.Name), origin)
+		for _, data := range rs.Data {
+			if rs.Type == "TXT" || rs.Type == "SPF" {
+				data = quoteCharacterStrings(data)
+			}
+			fmt.Fprintf(&sb, "%s\t%d\tIN\t%s\t%s\n", name, rs.TTL, rs.Type, data)
+		}
+	}
+	return sb.String()
+}
+
+func typeOrder(recordType string) int {
+	switch recordType {
+	case "SOA":
+		return 0
+	case "NS":
+		return 1
+	default:
+		return 2
+	}
+}
+
+// reverseLabels makes names within the same domain sort next to each other.
+func reverseLabels(name string) string {
+	labels := strings.Split(strings.TrimSuffix(name, "."), ".")
+	for i, j := 0, len(labels)-1; i < j; i, j = i+1, j-1 {
+		labels[i], labels[j] = labels[j], labels[i]
+	}
+	return strings.Join(labels, ".")
+}
+
+func relativeName(name, origin string) string {
+	switch {
+	case origin == "":
+		return name
+	case name == origin:
+		return "@"
+	case origin != "." && strings.HasSuffix(name, "."+origin):
+		return strings.TrimSuffix(name, "."+origin)
+	default:
+		return name
+	}
+}
+
+// quoteCharacterStrings quotes data of a TXT record. Values which are already quoted are kept as is.
+func quoteCharacterStrings(data string) string {
+	if strings.HasPrefix(data, `"`) && strings.HasSuffix(data, `"`) && len(data) > 1 {
+		return data
+	}
+
+	var parts []string
+	for {
+		chunk := data
+		if len(chunk) > maxCharacterString {
+			chunk = chunk[:maxCharacterString]
+		}
+		parts = append(parts, quote(chunk))
+		data = data[len(chunk):]
+		if data == "" {
+			break
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+func quote(s string) string {
+	var sb strings.Builder
+	sb.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '"' || c == '\\':
+			sb.WriteByte('\\')
+			sb.WriteByte(c)
+		case c < ' ' || c > '~':
+			fmt.Fprintf(&sb, "\\%03d", c)
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
@@ -0,0 +1,140 @@
+package zonefile
+
+import (
+	"fmt"
+	"strings"
+)
+
+// entry is a directive or a record of a zone file. It can span several lines in parentheses.
+type entry struct {
+	line int
+	// continued is true for entries starting with a blank, which belong to the previous owner name.
+	continued bool
+	tokens    []token
+}
+
+type token struct {
+	value  string
+	quoted bool
+}
+
+// scan splits the content of a zone file into entries, dropping comments and blank lines.
+func scan(content string) ([]entry, error) {
+	var (
+		entries []entry
+		current entry
+		depth   int
+		line    = 1
+		// parenLine is the line of the outermost open parenthesis.
+		parenLine int
+	)
+
+	flush := func() {
+		if len(current.tokens) > 0 {
+			entries = append(entries, current)
+		}
+		current = entry{}
+	}
+
+	atLineStart := true
+	for i := 0; i < len(content); {
+		c := content[i]
+
+		if atLineStart && depth == 0 {
+			current = entry{line: line, continued: c == ' ' || c == '\t'}
+		}
+		atLineStart = false
+
+		switch {
+		case c == '\n':
+			line++
+			if depth == 0 {
+				flush()
+			}
+			atLineStart = true
+			i++
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+		case c == ';':
+			for i < len(content) && content[i] != '\n' {
+				i++
+			}
+		case c == '(':
+			if depth == 0 {
+				parenLine = line
+			}
+			depth++
+			i++
+		case c == ')':
+			if depth == 0 {
+				return nil, fmt.Errorf("line %d: unbalanced parenthesis", line)
+			}
+			depth--
+			i++
+		case c == '"':
+			value, n, lines, err := scanQuoted(content[i+1:])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+			current.tokens = append(current.tokens, token{value: value, quoted: true})
+			line += lines
+			i += n + 1
+		default:
+			start := i
+			for i < len(content) && !strings.ContainsRune(" \t\r\n;()\"", rune(content[i])) {
+				if content[i] == '\\' && i+1 < len(content) {
+					i++
+				}
+				i++
+			}
+			current.tokens = append(current.tokens, token{value: content[start:i]})
+		}
+	}
+	if depth > 0 {
+		return nil, fmt.Errorf("line %d: unclosed parenthesis", parenLine)
+	}
+	flush()
+
+	return entries, nil
+}
+
+// scanQuoted decodes a character string up to the closing quote. It returns the decoded value,
+// the number of bytes consumed including the closing quote and the number of line breaks in it.
+func scanQuoted(s string) (string, int, int, error) {
+	var sb strings.Builder
+	lines := 0
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '"':
+			return sb.String(), i + 1, lines, nil
+		case '\\':
+			if i+1 >= len(s) {
+				return "", 0, 0, fmt.Errorf("unterminated escape sequence")
+			}
+			if i+3 < len(s) && isDigit(s[i+1]) && isDigit(s[i+2]) && isDigit(s[i+3]) {
+				code := int(s[i+1]-'0')*100 + int(s[i+2]-'0')*10 + int(s[i+3]-'0')
+				if code > 255 {
+					return "", 0, 0, fmt.Errorf("invalid escape sequence \\%s", s[i+1:i+4])
+				}
+				sb.WriteByte(byte(code))
+				i += 3
+				continue
+			}
+			i++
+			if s[i] == '\n' {
+				lines++
+			}
+			sb.WriteByte(s[i])
+		case '\n':
+			lines++
+			sb.WriteByte(c)
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return "", 0, 0, fmt.Errorf("unterminated quoted string")
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
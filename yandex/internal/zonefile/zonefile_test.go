@@ -0,0 +1,129 @@
+package zonefile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const exampleZone = `$ORIGIN example.com.
+$TTL 1h
+@       IN  SOA ns1 hostmaster (
+                2024010101 ; serial
+                1d 2h 4w 1h )
+        IN  NS  ns1
+        IN  NS  ns2.example.net.
+        IN  MX  10 mail
+ns1         A   192.0.2.1
+www  600    A   192.0.2.10
+            A   192.0.2.11
+mail    IN  300 A 192.0.2.20
+txt         TXT "v=spf1 include:_spf.example.net " "~all"
+            TXT "say \"hi\"; not a comment" ; a comment
+dkim._domainkey TXT ( "v=DKIM1; k=rsa; "
+                      "p=MIGf" )
+@           CAA 0 issue "letsencrypt.org"
+$ORIGIN sub
+api         CNAME www.example.com.
+_sip._tcp   SRV 10 5 5060 sip
+`
+
+func TestParse(t *testing.T) {
+	recordSets, err := Parse(exampleZone, "", -1)
+	require.NoError(t, err)
+
+	assert.Equal(t, []RecordSet{
+		{Name: "example.com.", Type: "SOA", TTL: 3600, Data: []string{"ns1.example.com. hostmaster.example.com. 2024010101 1d 2h 4w 1h"}},
+		{Name: "example.com.", Type: "NS", TTL: 3600, Data: []string{"ns1.example.com.", "ns2.example.net."}},
+		{Name: "example.com.", Type: "MX", TTL: 3600, Data: []string{"10 mail.example.com."}},
+		{Name: "ns1.example.com.", Type: "A", TTL: 3600, Data: []string{"192.0.2.1"}},
+		{Name: "www.example.com.", Type: "A", TTL: 600, Data: []string{"192.0.2.10", "192.0.2.11"}},
+		{Name: "mail.example.com.", Type: "A", TTL: 300, Data: []string{"192.0.2.20"}},
+		{Name: "txt.example.com.", Type: "TXT", TTL: 3600, Data: []string{"v=spf1 include:_spf.example.net ~all", `say "hi"; not a comment`}},
+		{Name: "dkim._domainkey.example.com.", Type: "TXT", TTL: 3600, Data: []string{"v=DKIM1; k=rsa; p=MIGf"}},
+		{Name: "example.com.", Type: "CAA", TTL: 3600, Data: []string{`0 issue "letsencrypt.org"`}},
+		{Name: "api.sub.example.com.", Type: "CNAME", TTL: 3600, Data: []string{"www.example.com."}},
+		{Name: "_sip._tcp.sub.example.com.", Type: "SRV", TTL: 3600, Data: []string{"10 5 5060 sip.sub.example.com."}},
+	}, recordSets)
+}
+
+func TestParseTTL(t *testing.T) {
+	recordSets, err := Parse(`
+a 300 A 192.0.2.1
+b A 192.0.2.2
+$TTL 60
+c 120 A 192.0.2.3
+d A 192.0.2.4
+`, "example.com", 1000)
+	require.NoError(t, err)
+
+	ttls := make(map[string]int64)
+	for _, rs := range recordSets {
+		ttls[rs.Name] = rs.TTL
+	}
+	assert.Equal(t, map[string]int64{
+		"a.example.com.": 300,
+		"b.example.com.": 300,
+		"c.example.com.": 120,
+		"d.example.com.": 60,
+	}, ttls)
+
+	ttl, err := parseTTL("1h30m")
+	require.NoError(t, err)
+	assert.Equal(t, int64(5400), ttl)
+	_, err = parseTTL("1x")
+	assert.Error(t, err)
+}
+
+func TestParseErrors(t *testing.T) {
+	for name, content := range map[string]string{
+		"no origin":         "www 300 A 192.0.2.1",
+		"no ttl":            "$ORIGIN example.com.\nwww A 192.0.2.1",
+		"no owner":          "$TTL 300\n  A 192.0.2.1",
+		"unclosed quote":    "$ORIGIN example.com.\n$TTL 300\ntxt TXT \"abc",
+		"unclosed paren":    "$ORIGIN example.com.\n$TTL 300\n@ SOA ns1 hostmaster ( 1 2 3 4 5",
+		"include":           "$INCLUDE other.zone",
+		"unsupported class": "$ORIGIN example.com.\n$TTL 300\nwww CH A 192.0.2.1",
+		"no data":           "$ORIGIN example.com.\n$TTL 300\nwww A",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse(content, "", -1)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	content := Render("example.com", []RecordSet{
+		{Name: "www.example.com.", Type: "A", TTL: 600, Data: []string{"192.0.2.10", "192.0.2.11"}},
+		{Name: "example.com.", Type: "NS", TTL: 3600, Data: []string{"ns1.example.com."}},
+		{Name: "txt.example.com.", Type: "TXT", TTL: 300, Data: []string{`say "hi"`, `"already quoted"`}},
+		{Name: "other.example.net.", Type: "A", TTL: 300, Data: []string{"192.0.2.1"}},
+		{Name: "example.com.", Type: "SOA", TTL: 3600, Data: []string{"ns1.example.com. hostmaster.example.com. 1 1d 2h 4w 1h"}},
+	})
+
+	assert.Equal(t, `$ORIGIN example.com.
+@	3600	IN	SOA	ns1.example.com. hostmaster.example.com. 1 1d 2h 4w 1h
+@	3600	IN	NS	ns1.example.com.
+txt	300	IN	TXT	"say \"hi\""
+txt	300	IN	TXT	"already quoted"
+www	600	IN	A	192.0.2.10
+www	600	IN	A	192.0.2.11
+other.example.net.	300	IN	A	192.0.2.1
+`, content)
+}
+
+func TestRenderParseRoundTrip(t *testing.T) {
+	long := make([]byte, 600)
+	for i := range long {
+		long[i] = 'a' + byte(i%26)
+	}
+	recordSets, err := Parse(exampleZone, "", -1)
+	require.NoError(t, err)
+	recordSets = append(recordSets, RecordSet{Name: "long.example.com.", Type: "TXT", TTL: 60, Data: []string{string(long)}})
+
+	parsed, err := Parse(Render("example.com.", recordSets), "", -1)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, recordSets, parsed)
+}
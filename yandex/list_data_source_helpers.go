@@ -0,0 +1,110 @@
+package yandex
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+)
+
+// listDataSourceSchema returns schema of the data source which lists objects of one kind
+// in a folder. Objects are stored in the computed attribute itemsKey.
+func listDataSourceSchema(itemsKey string, itemsDescription string, item *schema.Resource) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"folder_id": {
+			Type:        schema.TypeString,
+			Description: "The folder to list objects in. If it is not provided, the default provider `folder_id` is used.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"filter": {
+			Type: schema.TypeString,
+			Description: "A filter expression that is passed to the List API as is. " +
+				"The expression must specify the field name, an operator and the value, e.g. `name=\"my-name\"`. " +
+				"For more information, see the List method of the corresponding service in [the API reference](https://yandex.cloud/docs/api-design-guide/).",
+			Optional: true,
+		},
+		"labels": {
+			Type:        schema.TypeMap,
+			Description: "Label selector. Only objects having all of the specified labels with the specified values are returned.",
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		itemsKey: {
+			Type:        schema.TypeList,
+			Description: itemsDescription,
+			Computed:    true,
+			Elem:        item,
+		},
+	}
+}
+
+// listItemCommonSchema returns schema of attributes common for all objects returned by list data sources.
+func listItemCommonSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Description: common.ResourceDescriptions["id"],
+			Computed:    true,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: common.ResourceDescriptions["name"],
+			Computed:    true,
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Description: common.ResourceDescriptions["description"],
+			Computed:    true,
+		},
+		"folder_id": {
+			Type:        schema.TypeString,
+			Description: common.ResourceDescriptions["folder_id"],
+			Computed:    true,
+		},
+		"labels": {
+			Type:        schema.TypeMap,
+			Description: common.ResourceDescriptions["labels"],
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Description: common.ResourceDescriptions["created_at"],
+			Computed:    true,
+		},
+	}
+}
+
+// listDataSourceLabelSelector returns label selector configured in the data source.
+func listDataSourceLabelSelector(d *schema.ResourceData) map[string]string {
+	selector := make(map[string]string)
+	for k, v := range d.Get("labels").(map[string]interface{}) {
+		selector[k] = v.(string)
+	}
+	return selector
+}
+
+// matchLabels reports whether labels contain all key-value pairs of selector.
+func matchLabels(selector map[string]string, labels map[string]string) bool {
+	for k, v := range selector {
+		if value, ok := labels[k]; !ok || value != v {
+			return false
+		}
+	}
+	return true
+}
+
+// listDataSourceID returns ID of the list data source, which depends only on its arguments.
+func listDataSourceID(folderID string, filter string, selector map[string]string) string {
+	labels := make([]string, 0, len(selector))
+	for k, v := range selector {
+		labels = append(labels, k+"="+v)
+	}
+	sort.Strings(labels)
+
+	return strconv.Itoa(schema.HashString(fmt.Sprintf("%s:%s:%s", folderID, filter, strings.Join(labels, ","))))
+}
@@ -0,0 +1,30 @@
+package yandex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchLabels(t *testing.T) {
+	labels := map[string]string{
+		"env":  "prod",
+		"team": "core",
+	}
+
+	assert.True(t, matchLabels(nil, labels))
+	assert.True(t, matchLabels(map[string]string{"env": "prod"}, labels))
+	assert.True(t, matchLabels(map[string]string{"env": "prod", "team": "core"}, labels))
+	assert.False(t, matchLabels(map[string]string{"env": "test"}, labels))
+	assert.False(t, matchLabels(map[string]string{"owner": "core"}, labels))
+	assert.False(t, matchLabels(map[string]string{"env": "prod"}, nil))
+}
+
+func TestListDataSourceID(t *testing.T) {
+	id := listDataSourceID("folder", "name=\"foo\"", map[string]string{"a": "1", "b": "2"})
+
+	assert.Equal(t, id, listDataSourceID("folder", "name=\"foo\"", map[string]string{"b": "2", "a": "1"}))
+	assert.NotEqual(t, id, listDataSourceID("folder", "name=\"bar\"", map[string]string{"a": "1", "b": "2"}))
+	assert.NotEqual(t, id, listDataSourceID("other", "name=\"foo\"", map[string]string{"a": "1", "b": "2"}))
+	assert.NotEqual(t, id, listDataSourceID("folder", "name=\"foo\"", map[string]string{"a": "1"}))
+}
@@ -6,11 +6,13 @@ import (
 	"fmt"
 	"os"
 	"strconv"
-	"strings"
 
 	"github.com/yandex-cloud/terraform-provider-yandex/common/mutexkv"
 
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/workloadidentity"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/ycprofile"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -132,12 +134,45 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 				Optional:    true,
 				Description: common.Descriptions["profile"],
 			},
+			"impersonate_service_account_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: common.Descriptions["impersonate_service_account_id"],
+			},
+			// MaxItems is not set to keep the block identical to the one of the framework provider schema,
+			// the number of blocks is checked in providerConfigure.
+			"workload_identity": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Description:   common.Descriptions["workload_identity"],
+				ConflictsWith: []string{"token", "service_account_key_file"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_account_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: common.Descriptions["workload_identity_service_account_id"],
+						},
+						"jwt_file": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: common.Descriptions["workload_identity_jwt_file"],
+						},
+						"jwt_env": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: common.Descriptions["workload_identity_jwt_env"],
+						},
+					},
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
 			"yandex_alb_backend_group":                                dataSourceYandexALBBackendGroup(),
 			"yandex_alb_http_router":                                  dataSourceYandexALBHTTPRouter(),
 			"yandex_alb_load_balancer":                                dataSourceYandexALBLoadBalancer(),
+			"yandex_alb_route_match":                                  dataSourceYandexALBRouteMatch(),
 			"yandex_alb_target_group":                                 dataSourceYandexALBTargetGroup(),
 			"yandex_alb_virtual_host":                                 dataSourceYandexALBVirtualHost(),
 			"yandex_api_gateway":                                      dataSourceYandexApiGateway(),
@@ -158,12 +193,14 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_compute_gpu_cluster":                              dataSourceYandexComputeGpuCluster(),
 			"yandex_compute_image":                                    dataSourceYandexComputeImage(),
 			"yandex_compute_instance":                                 dataSourceYandexComputeInstance(),
+			"yandex_compute_instances":                                dataSourceYandexComputeInstances(),
 			"yandex_compute_instance_group":                           dataSourceYandexComputeInstanceGroup(),
 			"yandex_compute_placement_group":                          dataSourceYandexComputePlacementGroup(),
 			"yandex_compute_snapshot":                                 dataSourceYandexComputeSnapshot(),
 			"yandex_compute_snapshot_schedule":                        dataSourceYandexComputeSnapshotSchedule(),
 			"yandex_dataproc_cluster":                                 dataSourceYandexDataprocCluster(),
 			"yandex_dns_zone":                                         dataSourceYandexDnsZone(),
+			"yandex_dns_zone_file":                                    dataSourceYandexDnsZoneFile(),
 			"yandex_serverless_eventrouter_bus":                       dataSourceYandexServerlessEventrouterBus(),
 			"yandex_serverless_eventrouter_connector":                 dataSourceYandexServerlessEventrouterConnector(),
 			"yandex_serverless_eventrouter_rule":                      dataSourceYandexServerlessEventrouterRule(),
@@ -173,6 +210,7 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_iam_policy":                                       dataSourceYandexIAMPolicy(),
 			"yandex_iam_role":                                         dataSourceYandexIAMRole(),
 			"yandex_iam_service_account":                              dataSourceYandexIAMServiceAccount(),
+			"yandex_iam_service_accounts":                             dataSourceYandexIAMServiceAccounts(),
 			"yandex_iam_service_agent":                                dataSourceYandexIamServiceAgent(),
 			"yandex_iam_user":                                         dataSourceYandexIAMUser(),
 			"yandex_iam_workload_identity_federated_credential":       dataSourceYandexIAMWorkloadIdentityFederatedCredential(),
@@ -203,6 +241,7 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_mdb_mysql_database":                               dataSourceYandexMDBMySQLDatabase(),
 			"yandex_mdb_mysql_user":                                   dataSourceYandexMDBMySQLUser(),
 			"yandex_mdb_postgresql_cluster":                           dataSourceYandexMDBPostgreSQLCluster(),
+			"yandex_mdb_postgresql_clusters":                          dataSourceYandexMDBPostgreSQLClusters(),
 			"yandex_mdb_postgresql_database":                          dataSourceYandexMDBPostgreSQLDatabase(),
 			"yandex_mdb_postgresql_user":                              dataSourceYandexMDBPostgreSQLUser(),
 			"yandex_mdb_redis_cluster":                                dataSourceYandexMDBRedisCluster(),
@@ -217,12 +256,15 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_resourcemanager_cloud":                            dataSourceYandexResourceManagerCloud(),
 			"yandex_resourcemanager_folder":                           dataSourceYandexResourceManagerFolder(),
 			"yandex_serverless_container":                             dataSourceYandexServerlessContainer(),
+			"yandex_storage_object":                                   dataSourceYandexStorageObject(),
+			"yandex_storage_objects":                                  dataSourceYandexStorageObjects(),
 			"yandex_vpc_address":                                      dataSourceYandexVPCAddress(),
 			"yandex_vpc_gateway":                                      dataSourceYandexVPCGateway(),
 			"yandex_vpc_network":                                      dataSourceYandexVPCNetwork(),
 			"yandex_vpc_route_table":                                  dataSourceYandexVPCRouteTable(),
 			"yandex_vpc_security_group":                               dataSourceYandexVPCSecurityGroup(),
 			"yandex_vpc_subnet":                                       dataSourceYandexVPCSubnet(),
+			"yandex_vpc_subnets":                                      dataSourceYandexVPCSubnets(),
 			"yandex_vpc_private_endpoint":                             dataSourceYandexVPCPrivateEndpoint(),
 			"yandex_ydb_database_dedicated":                           dataSourceYandexYDBDatabaseDedicated(),
 			"yandex_ydb_database_serverless":                          dataSourceYandexYDBDatabaseServerless(),
@@ -234,142 +276,153 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"yandex_alb_backend_group":                                 resourceYandexALBBackendGroup(),
-			"yandex_alb_http_router":                                   resourceYandexALBHTTPRouter(),
-			"yandex_alb_load_balancer":                                 resourceYandexALBLoadBalancer(),
-			"yandex_alb_target_group":                                  resourceYandexALBTargetGroup(),
-			"yandex_alb_virtual_host":                                  addPassthroughImport(withALBVirtualHostID(resourceYandexALBVirtualHost())),
-			"yandex_api_gateway":                                       resourceYandexApiGateway(),
-			"yandex_audit_trails_trail":                                resourceYandexAuditTrailsTrail(),
-			"yandex_backup_policy":                                     resourceYandexBackupPolicy(),
-			"yandex_backup_policy_bindings":                            resourceYandexBackupPolicyBindings(),
-			"yandex_container_registry":                                resourceYandexContainerRegistry(),
-			"yandex_container_registry_iam_binding":                    resourceYandexContainerRegistryIAMBinding(),
-			"yandex_container_registry_ip_permission":                  resourceYandexContainerRegistryIPPermission(),
-			"yandex_container_repository":                              resourceYandexContainerRepository(),
-			"yandex_container_repository_iam_binding":                  resourceYandexContainerRepositoryIAMBinding(),
-			"yandex_container_repository_lifecycle_policy":             resourceYandexContainerRepositoryLifecyclePolicy(),
-			"yandex_cdn_origin_group":                                  resourceYandexCDNOriginGroup(),
-			"yandex_cdn_resource":                                      resourceYandexCDNResource(),
-			"yandex_cm_certificate":                                    resourceYandexCMCertificate(),
-			"yandex_cm_certificate_iam_binding":                        resourceYandexCMCertificateIAMBinding(),
-			"yandex_cm_certificate_iam_member":                         resourceYandexCMCertificateIAMMember(),
-			"yandex_compute_disk":                                      resourceYandexComputeDisk(),
-			"yandex_compute_disk_placement_group":                      resourceYandexComputeDiskPlacementGroup(),
-			"yandex_compute_filesystem":                                resourceYandexComputeFilesystem(),
-			"yandex_compute_gpu_cluster":                               resourceYandexComputeGpuCluster(),
-			"yandex_compute_image":                                     resourceYandexComputeImage(),
-			"yandex_compute_instance":                                  resourceYandexComputeInstance(),
-			"yandex_compute_instance_group":                            resourceYandexComputeInstanceGroup(),
-			"yandex_compute_placement_group":                           resourceYandexComputePlacementGroup(),
-			"yandex_compute_snapshot":                                  resourceYandexComputeSnapshot(),
-			"yandex_compute_snapshot_schedule":                         resourceYandexComputeSnapshotSchedule(),
-			"yandex_dataproc_cluster":                                  resourceYandexDataprocCluster(),
-			"yandex_datatransfer_endpoint":                             resourceYandexDatatransferEndpoint(),
-			"yandex_datatransfer_transfer":                             resourceYandexDatatransferTransfer(),
-			"yandex_dns_zone_iam_binding":                              resourceYandexDnsZoneIAMBinding(),
-			"yandex_dns_recordset":                                     resourceYandexDnsRecordSet(),
-			"yandex_dns_zone":                                          resourceYandexDnsZone(),
-			"yandex_serverless_eventrouter_bus":                        resourceYandexServerlessEventrouterBus(),
-			"yandex_serverless_eventrouter_connector":                  resourceYandexServerlessEventrouterConnector(),
-			"yandex_serverless_eventrouter_rule":                       resourceYandexServerlessEventrouterRule(),
-			"yandex_function":                                          resourceYandexFunction(),
-			"yandex_function_iam_binding":                              resourceYandexFunctionIAMBinding(),
-			"yandex_function_scaling_policy":                           resourceYandexFunctionScalingPolicy(),
-			"yandex_function_trigger":                                  resourceYandexFunctionTrigger(),
-			"yandex_iam_service_account":                               resourceYandexIAMServiceAccount(),
-			"yandex_iam_service_account_api_key":                       resourceYandexIAMServiceAccountAPIKey(),
-			"yandex_iam_service_account_iam_binding":                   resourceYandexIAMServiceAccountIAMBinding(),
-			"yandex_iam_service_account_iam_member":                    resourceYandexIAMServiceAccountIAMMember(),
-			"yandex_iam_service_account_iam_policy":                    resourceYandexIAMServiceAccountIAMPolicy(),
-			"yandex_iam_service_account_key":                           resourceYandexIAMServiceAccountKey(),
-			"yandex_iam_service_account_static_access_key":             resourceYandexIAMServiceAccountStaticAccessKey(),
-			"yandex_iam_workload_identity_federated_credential":        resourceYandexIAMWorkloadIdentityFederatedCredential(),
-			"yandex_iam_workload_identity_oidc_federation":             resourceYandexIAMWorkloadIdentityOidcFederation(),
-			"yandex_iam_workload_identity_oidc_federation_iam_binding": resourceYandexIAMWorkloadIdentityOidcFederationIAMBinding(),
-			"yandex_iot_core_broker":                                   resourceYandexIoTCoreBroker(),
-			"yandex_iot_core_device":                                   resourceYandexIoTCoreDevice(),
-			"yandex_iot_core_registry":                                 resourceYandexIoTCoreRegistry(),
-			"yandex_kms_secret_ciphertext":                             resourceYandexKMSSecretCiphertext(),
-			"yandex_kms_symmetric_key":                                 resourceYandexKMSSymmetricKey(),
-			"yandex_kms_symmetric_key_iam_binding":                     resourceYandexKMSSymmetricKeyIAMBinding(),
-			"yandex_kms_symmetric_key_iam_member":                      resourceYandexKMSSymmetricKeyIAMMember(),
-			"yandex_kms_asymmetric_encryption_key":                     resourceYandexKMSAsymmetricEncryptionKey(),
-			"yandex_kms_asymmetric_encryption_key_iam_binding":         resourceYandexKMSAsymmetricEncryptionKeyIAMBinding(),
-			"yandex_kms_asymmetric_encryption_key_iam_member":          resourceYandexKMSAsymmetricEncryptionKeyIAMMember(),
-			"yandex_kms_asymmetric_signature_key":                      resourceYandexKMSAsymmetricSignatureKey(),
-			"yandex_kms_asymmetric_signature_key_iam_binding":          resourceYandexKMSAsymmetricSignatureKeyIAMBinding(),
-			"yandex_kms_asymmetric_signature_key_iam_member":           resourceYandexKMSAsymmetricSignatureKeyIAMMember(),
-			"yandex_kubernetes_cluster":                                resourceYandexKubernetesCluster(),
-			"yandex_kubernetes_node_group":                             resourceYandexKubernetesNodeGroup(),
-			"yandex_lb_network_load_balancer":                          resourceYandexLBNetworkLoadBalancer(),
-			"yandex_lb_target_group":                                   resourceYandexLBTargetGroup(),
-			"yandex_loadtesting_agent":                                 resourceYandexLoadtestingAgent(),
-			"yandex_lockbox_secret":                                    resourceYandexLockboxSecret(),
-			"yandex_lockbox_secret_version":                            resourceYandexLockboxSecretVersion(),
-			"yandex_lockbox_secret_version_hashed":                     resourceYandexLockboxSecretVersionHashed(),
-			"yandex_lockbox_secret_iam_binding":                        resourceYandexLockboxSecretIAMBinding(),
-			"yandex_lockbox_secret_iam_member":                         resourceYandexLockboxSecretIAMMember(),
-			"yandex_logging_group":                                     resourceYandexLoggingGroup(),
-			"yandex_mdb_clickhouse_cluster":                            resourceYandexMDBClickHouseCluster(),
-			"yandex_mdb_elasticsearch_cluster":                         resourceYandexMDBElasticsearchCluster(),
-			"yandex_mdb_greenplum_cluster":                             resourceYandexMDBGreenplumCluster(),
-			"yandex_mdb_kafka_cluster":                                 resourceYandexMDBKafkaCluster(),
-			"yandex_mdb_kafka_topic":                                   resourceYandexMDBKafkaTopic(),
-			"yandex_mdb_kafka_connector":                               resourceYandexMDBKafkaConnector(),
-			"yandex_mdb_kafka_user":                                    resourceYandexMDBKafkaUser(),
-			"yandex_mdb_mongodb_cluster":                               resourceYandexMDBMongodbCluster(),
-			"yandex_mdb_mysql_cluster":                                 resourceYandexMDBMySQLCluster(),
-			"yandex_mdb_mysql_database":                                resourceYandexMDBMySQLDatabase(),
-			"yandex_mdb_mysql_user":                                    resourceYandexMDBMySQLUser(),
-			"yandex_mdb_postgresql_cluster":                            resourceYandexMDBPostgreSQLCluster(),
-			"yandex_mdb_postgresql_database":                           resourceYandexMDBPostgreSQLDatabase(),
-			"yandex_mdb_postgresql_user":                               resourceYandexMDBPostgreSQLUser(),
-			"yandex_mdb_redis_cluster":                                 resourceYandexMDBRedisCluster(),
-			"yandex_mdb_sqlserver_cluster":                             resourceYandexMDBSQLServerCluster(),
-			"yandex_message_queue":                                     resourceYandexMessageQueue(),
-			"yandex_monitoring_dashboard":                              resourceYandexMonitoringDashboard(),
-			"yandex_organizationmanager_organization_iam_binding":      resourceYandexOrganizationManagerOrganizationIAMBinding(),
-			"yandex_organizationmanager_organization_iam_member":       resourceYandexOrganizationManagerOrganizationIAMMember(),
-			"yandex_organizationmanager_saml_federation":               resourceYandexOrganizationManagerSamlFederation(),
-			"yandex_organizationmanager_saml_federation_user_account":  resourceYandexOrganizationManagerSamlFederationUserAccount(),
-			"yandex_organizationmanager_group":                         resourceYandexOrganizationManagerGroup(),
-			"yandex_organizationmanager_group_iam_member":              resourceYandexOrganizationManagerGroupIAMMember(),
-			"yandex_organizationmanager_group_mapping":                 resourceYandexOrganizationManagerGroupMapping(),
-			"yandex_organizationmanager_group_mapping_item":            resourceYandexOrganizationManagerGroupMappingItem(),
-			"yandex_organizationmanager_group_membership":              resourceYandexOrganizationManagerGroupMembership(),
-			"yandex_organizationmanager_os_login_settings":             resourceYandexOrganizationManagerOsLoginSettings(),
-			"yandex_organizationmanager_user_ssh_key":                  resourceYandexOrganizationManagerUserSshKey(),
-			"yandex_resourcemanager_cloud":                             resourceYandexResourceManagerCloud(),
-			"yandex_resourcemanager_cloud_iam_binding":                 resourceYandexResourceManagerCloudIAMBinding(),
-			"yandex_resourcemanager_cloud_iam_member":                  resourceYandexResourceManagerCloudIAMMember(),
-			"yandex_resourcemanager_folder":                            resourceYandexResourceManagerFolder(),
-			"yandex_resourcemanager_folder_iam_binding":                resourceYandexResourceManagerFolderIAMBinding(),
-			"yandex_resourcemanager_folder_iam_member":                 resourceYandexResourceManagerFolderIAMMember(),
-			"yandex_resourcemanager_folder_iam_policy":                 resourceYandexResourceManagerFolderIAMPolicy(),
-			"yandex_serverless_container":                              resourceYandexServerlessContainer(),
-			"yandex_serverless_container_iam_binding":                  resourceYandexServerlessContainerIAMBinding(),
-			"yandex_storage_bucket":                                    resourceYandexStorageBucket(),
-			"yandex_storage_object":                                    resourceYandexStorageObject(),
-			"yandex_vpc_address":                                       resourceYandexVPCAddress(),
-			"yandex_vpc_default_security_group":                        resourceYandexVPCDefaultSecurityGroup(),
-			"yandex_vpc_gateway":                                       resourceYandexVPCGateway(),
-			"yandex_vpc_network":                                       resourceYandexVPCNetwork(),
-			"yandex_vpc_route_table":                                   resourceYandexVPCRouteTable(),
-			"yandex_vpc_security_group":                                resourceYandexVPCSecurityGroup(),
-			"yandex_vpc_subnet":                                        resourceYandexVPCSubnet(),
-			"yandex_vpc_private_endpoint":                              resourceYandexVPCPrivateEndpoint(),
-			"yandex_ydb_database_iam_binding":                          resourceYandexYDBDatabaseIAMBinding(),
-			"yandex_ydb_database_dedicated":                            resourceYandexYDBDatabaseDedicated(),
-			"yandex_ydb_database_serverless":                           resourceYandexYDBDatabaseServerless(),
-			"yandex_ydb_topic":                                         resourceYandexYDBTopic(),
-			"yandex_ydb_table":                                         resourceYandexYDBTable(),
-			"yandex_ydb_table_changefeed":                              resourceYandexYDBTableChangefeed(),
-			"yandex_ydb_table_index":                                   resourceYandexYDBTableIndex(),
-			"yandex_sws_security_profile":                              resourceYandexSmartwebsecuritySecurityProfile(),
-			"yandex_sws_advanced_rate_limiter_profile":                 resourceYandexSmartwebsecurityAdvancedRateLimiterAdvancedRateLimiterProfile(),
-			"yandex_sws_waf_profile":                                   resourceYandexSmartwebsecurityWafWafProfile(),
-			"yandex_smartcaptcha_captcha":                              resourceYandexSmartcaptchaCaptcha(),
+			"yandex_alb_backend_group":                                   resourceYandexALBBackendGroup(),
+			"yandex_alb_http_router":                                     resourceYandexALBHTTPRouter(),
+			"yandex_alb_load_balancer":                                   resourceYandexALBLoadBalancer(),
+			"yandex_alb_target_group":                                    resourceYandexALBTargetGroup(),
+			"yandex_alb_virtual_host":                                    withResourceIdentity(addPassthroughImport(withALBVirtualHostID(resourceYandexALBVirtualHost())), resourceid.VirtualHost),
+			"yandex_alb_virtual_host_route":                              withResourceIdentity(resourceYandexALBVirtualHostRoute(), resourceid.VirtualHostRoute),
+			"yandex_api_gateway":                                         resourceYandexApiGateway(),
+			"yandex_audit_trails_trail":                                  resourceYandexAuditTrailsTrail(),
+			"yandex_backup_policy":                                       resourceYandexBackupPolicy(),
+			"yandex_backup_policy_bindings":                              resourceYandexBackupPolicyBindings(),
+			"yandex_container_registry":                                  resourceYandexContainerRegistry(),
+			"yandex_container_registry_iam_binding":                      resourceYandexContainerRegistryIAMBinding(),
+			"yandex_container_registry_ip_permission":                    resourceYandexContainerRegistryIPPermission(),
+			"yandex_container_repository":                                resourceYandexContainerRepository(),
+			"yandex_container_repository_iam_binding":                    resourceYandexContainerRepositoryIAMBinding(),
+			"yandex_container_repository_lifecycle_policy":               resourceYandexContainerRepositoryLifecyclePolicy(),
+			"yandex_cdn_origin_group":                                    resourceYandexCDNOriginGroup(),
+			"yandex_cdn_resource":                                        resourceYandexCDNResource(),
+			"yandex_cm_certificate":                                      resourceYandexCMCertificate(),
+			"yandex_cm_certificate_iam_binding":                          resourceYandexCMCertificateIAMBinding(),
+			"yandex_cm_certificate_iam_member":                           resourceYandexCMCertificateIAMMember(),
+			"yandex_compute_disk":                                        withResourceIdentity(resourceYandexComputeDisk(), resourceid.ID),
+			"yandex_compute_disk_placement_group":                        resourceYandexComputeDiskPlacementGroup(),
+			"yandex_compute_filesystem":                                  resourceYandexComputeFilesystem(),
+			"yandex_compute_gpu_cluster":                                 resourceYandexComputeGpuCluster(),
+			"yandex_compute_image":                                       resourceYandexComputeImage(),
+			"yandex_compute_instance":                                    withResourceIdentity(resourceYandexComputeInstance(), resourceid.ID),
+			"yandex_compute_instance_group":                              resourceYandexComputeInstanceGroup(),
+			"yandex_compute_placement_group":                             resourceYandexComputePlacementGroup(),
+			"yandex_compute_snapshot":                                    resourceYandexComputeSnapshot(),
+			"yandex_compute_snapshot_schedule":                           resourceYandexComputeSnapshotSchedule(),
+			"yandex_dataproc_cluster":                                    resourceYandexDataprocCluster(),
+			"yandex_datatransfer_endpoint":                               resourceYandexDatatransferEndpoint(),
+			"yandex_datatransfer_transfer":                               resourceYandexDatatransferTransfer(),
+			"yandex_dns_zone_iam_binding":                                resourceYandexDnsZoneIAMBinding(),
+			"yandex_dns_recordset":                                       resourceYandexDnsRecordSet(),
+			"yandex_dns_zone":                                            resourceYandexDnsZone(),
+			"yandex_dns_zone_records":                                    resourceYandexDnsZoneRecords(),
+			"yandex_serverless_eventrouter_bus":                          resourceYandexServerlessEventrouterBus(),
+			"yandex_serverless_eventrouter_connector":                    resourceYandexServerlessEventrouterConnector(),
+			"yandex_serverless_eventrouter_rule":                         resourceYandexServerlessEventrouterRule(),
+			"yandex_function":                                            resourceYandexFunction(),
+			"yandex_function_iam_binding":                                resourceYandexFunctionIAMBinding(),
+			"yandex_function_scaling_policy":                             resourceYandexFunctionScalingPolicy(),
+			"yandex_function_trigger":                                    resourceYandexFunctionTrigger(),
+			"yandex_iam_service_account":                                 resourceYandexIAMServiceAccount(),
+			"yandex_iam_service_account_api_key":                         resourceYandexIAMServiceAccountAPIKey(),
+			"yandex_iam_service_account_iam_binding":                     resourceYandexIAMServiceAccountIAMBinding(),
+			"yandex_iam_service_account_iam_member":                      resourceYandexIAMServiceAccountIAMMember(),
+			"yandex_iam_service_account_iam_policy":                      resourceYandexIAMServiceAccountIAMPolicy(),
+			"yandex_iam_service_account_key":                             resourceYandexIAMServiceAccountKey(),
+			"yandex_iam_service_account_static_access_key":               resourceYandexIAMServiceAccountStaticAccessKey(),
+			"yandex_iam_workload_identity_federated_credential":          resourceYandexIAMWorkloadIdentityFederatedCredential(),
+			"yandex_iam_workload_identity_oidc_federation":               resourceYandexIAMWorkloadIdentityOidcFederation(),
+			"yandex_iam_workload_identity_oidc_federation_iam_binding":   resourceYandexIAMWorkloadIdentityOidcFederationIAMBinding(),
+			"yandex_iot_core_broker":                                     resourceYandexIoTCoreBroker(),
+			"yandex_iot_core_device":                                     resourceYandexIoTCoreDevice(),
+			"yandex_iot_core_registry":                                   resourceYandexIoTCoreRegistry(),
+			"yandex_kms_secret_ciphertext":                               resourceYandexKMSSecretCiphertext(),
+			"yandex_kms_symmetric_key":                                   resourceYandexKMSSymmetricKey(),
+			"yandex_kms_symmetric_key_iam_binding":                       resourceYandexKMSSymmetricKeyIAMBinding(),
+			"yandex_kms_symmetric_key_iam_member":                        resourceYandexKMSSymmetricKeyIAMMember(),
+			"yandex_kms_asymmetric_encryption_key":                       resourceYandexKMSAsymmetricEncryptionKey(),
+			"yandex_kms_asymmetric_encryption_key_iam_binding":           resourceYandexKMSAsymmetricEncryptionKeyIAMBinding(),
+			"yandex_kms_asymmetric_encryption_key_iam_member":            resourceYandexKMSAsymmetricEncryptionKeyIAMMember(),
+			"yandex_kms_asymmetric_signature_key":                        resourceYandexKMSAsymmetricSignatureKey(),
+			"yandex_kms_asymmetric_signature_key_iam_binding":            resourceYandexKMSAsymmetricSignatureKeyIAMBinding(),
+			"yandex_kms_asymmetric_signature_key_iam_member":             resourceYandexKMSAsymmetricSignatureKeyIAMMember(),
+			"yandex_kubernetes_cluster":                                  withResourceIdentity(resourceYandexKubernetesCluster(), resourceid.ID),
+			"yandex_kubernetes_node_group":                               resourceYandexKubernetesNodeGroup(),
+			"yandex_lb_network_load_balancer":                            resourceYandexLBNetworkLoadBalancer(),
+			"yandex_lb_target_group":                                     resourceYandexLBTargetGroup(),
+			"yandex_loadtesting_agent":                                   resourceYandexLoadtestingAgent(),
+			"yandex_lockbox_secret":                                      resourceYandexLockboxSecret(),
+			"yandex_lockbox_secret_version":                              resourceYandexLockboxSecretVersion(),
+			"yandex_lockbox_secret_version_hashed":                       resourceYandexLockboxSecretVersionHashed(),
+			"yandex_lockbox_secret_iam_binding":                          resourceYandexLockboxSecretIAMBinding(),
+			"yandex_lockbox_secret_iam_member":                           resourceYandexLockboxSecretIAMMember(),
+			"yandex_logging_group":                                       resourceYandexLoggingGroup(),
+			"yandex_mdb_clickhouse_cluster":                              withResourceIdentity(resourceYandexMDBClickHouseCluster(), resourceid.ID),
+			"yandex_mdb_elasticsearch_cluster":                           resourceYandexMDBElasticsearchCluster(),
+			"yandex_mdb_greenplum_cluster":                               resourceYandexMDBGreenplumCluster(),
+			"yandex_mdb_kafka_cluster":                                   withResourceIdentity(resourceYandexMDBKafkaCluster(), resourceid.ID),
+			"yandex_mdb_kafka_topic":                                     withResourceIdentity(resourceYandexMDBKafkaTopic(), resourceid.ClusterResource),
+			"yandex_mdb_kafka_connector":                                 resourceYandexMDBKafkaConnector(),
+			"yandex_mdb_kafka_user":                                      resourceYandexMDBKafkaUser(),
+			"yandex_mdb_mongodb_cluster":                                 withResourceIdentity(resourceYandexMDBMongodbCluster(), resourceid.ID),
+			"yandex_mdb_mysql_cluster":                                   withResourceIdentity(resourceYandexMDBMySQLCluster(), resourceid.ID),
+			"yandex_mdb_mysql_database":                                  resourceYandexMDBMySQLDatabase(),
+			"yandex_mdb_mysql_user":                                      resourceYandexMDBMySQLUser(),
+			"yandex_mdb_postgresql_cluster":                              withResourceIdentity(resourceYandexMDBPostgreSQLCluster(), resourceid.ID),
+			"yandex_mdb_postgresql_database":                             resourceYandexMDBPostgreSQLDatabase(),
+			"yandex_mdb_postgresql_user":                                 resourceYandexMDBPostgreSQLUser(),
+			"yandex_mdb_redis_cluster":                                   withResourceIdentity(resourceYandexMDBRedisCluster(), resourceid.ID),
+			"yandex_mdb_sqlserver_cluster":                               resourceYandexMDBSQLServerCluster(),
+			"yandex_message_queue":                                       resourceYandexMessageQueue(),
+			"yandex_monitoring_dashboard":                                resourceYandexMonitoringDashboard(),
+			"yandex_organizationmanager_organization_iam_binding":        resourceYandexOrganizationManagerOrganizationIAMBinding(),
+			"yandex_organizationmanager_organization_iam_member":         resourceYandexOrganizationManagerOrganizationIAMMember(),
+			"yandex_organizationmanager_saml_federation":                 resourceYandexOrganizationManagerSamlFederation(),
+			"yandex_organizationmanager_saml_federation_user_account":    resourceYandexOrganizationManagerSamlFederationUserAccount(),
+			"yandex_organizationmanager_group":                           resourceYandexOrganizationManagerGroup(),
+			"yandex_organizationmanager_group_iam_member":                resourceYandexOrganizationManagerGroupIAMMember(),
+			"yandex_organizationmanager_group_mapping":                   resourceYandexOrganizationManagerGroupMapping(),
+			"yandex_organizationmanager_group_mapping_item":              resourceYandexOrganizationManagerGroupMappingItem(),
+			"yandex_organizationmanager_group_membership":                resourceYandexOrganizationManagerGroupMembership(),
+			"yandex_organizationmanager_os_login_settings":               resourceYandexOrganizationManagerOsLoginSettings(),
+			"yandex_organizationmanager_user_ssh_key":                    resourceYandexOrganizationManagerUserSshKey(),
+			"yandex_resourcemanager_cloud":                               resourceYandexResourceManagerCloud(),
+			"yandex_resourcemanager_cloud_iam_binding":                   resourceYandexResourceManagerCloudIAMBinding(),
+			"yandex_resourcemanager_cloud_iam_member":                    resourceYandexResourceManagerCloudIAMMember(),
+			"yandex_resourcemanager_folder":                              resourceYandexResourceManagerFolder(),
+			"yandex_resourcemanager_folder_iam_binding":                  resourceYandexResourceManagerFolderIAMBinding(),
+			"yandex_resourcemanager_folder_iam_member":                   resourceYandexResourceManagerFolderIAMMember(),
+			"yandex_resourcemanager_folder_iam_policy":                   resourceYandexResourceManagerFolderIAMPolicy(),
+			"yandex_serverless_container":                                resourceYandexServerlessContainer(),
+			"yandex_serverless_container_iam_binding":                    resourceYandexServerlessContainerIAMBinding(),
+			"yandex_storage_bucket":                                      withResourceIdentity(resourceYandexStorageBucket(), resourceid.ID),
+			"yandex_storage_bucket_cors_configuration":                   resourceYandexStorageBucketCORSConfiguration(),
+			"yandex_storage_bucket_directory":                            resourceYandexStorageBucketDirectory(),
+			"yandex_storage_bucket_grant":                                resourceYandexStorageBucketGrant(),
+			"yandex_storage_bucket_lifecycle_configuration":              resourceYandexStorageBucketLifecycleConfiguration(),
+			"yandex_storage_bucket_logging":                              resourceYandexStorageBucketLogging(),
+			"yandex_storage_bucket_object_lock_configuration":            resourceYandexStorageBucketObjectLockConfiguration(),
+			"yandex_storage_bucket_policy":                               resourceYandexStorageBucketPolicy(),
+			"yandex_storage_bucket_server_side_encryption_configuration": resourceYandexStorageBucketServerSideEncryptionConfiguration(),
+			"yandex_storage_bucket_versioning":                           resourceYandexStorageBucketVersioning(),
+			"yandex_storage_bucket_website_configuration":                resourceYandexStorageBucketWebsiteConfiguration(),
+			"yandex_storage_object":                                      resourceYandexStorageObject(),
+			"yandex_vpc_address":                                         resourceYandexVPCAddress(),
+			"yandex_vpc_default_security_group":                          resourceYandexVPCDefaultSecurityGroup(),
+			"yandex_vpc_gateway":                                         resourceYandexVPCGateway(),
+			"yandex_vpc_network":                                         withResourceIdentity(resourceYandexVPCNetwork(), resourceid.ID),
+			"yandex_vpc_route_table":                                     resourceYandexVPCRouteTable(),
+			"yandex_vpc_subnet":                                          withResourceIdentity(resourceYandexVPCSubnet(), resourceid.ID),
+			"yandex_vpc_private_endpoint":                                resourceYandexVPCPrivateEndpoint(),
+			"yandex_ydb_database_iam_binding":                            resourceYandexYDBDatabaseIAMBinding(),
+			"yandex_ydb_database_dedicated":                              resourceYandexYDBDatabaseDedicated(),
+			"yandex_ydb_database_serverless":                             resourceYandexYDBDatabaseServerless(),
+			"yandex_ydb_topic":                                           resourceYandexYDBTopic(),
+			"yandex_ydb_table":                                           resourceYandexYDBTable(),
+			"yandex_ydb_table_changefeed":                                resourceYandexYDBTableChangefeed(),
+			"yandex_ydb_table_index":                                     resourceYandexYDBTableIndex(),
+			"yandex_sws_security_profile":                                resourceYandexSmartwebsecuritySecurityProfile(),
+			"yandex_sws_advanced_rate_limiter_profile":                   resourceYandexSmartwebsecurityAdvancedRateLimiterAdvancedRateLimiterProfile(),
+			"yandex_sws_waf_profile":                                     resourceYandexSmartwebsecurityWafWafProfile(),
+			"yandex_smartcaptcha_captcha":                                resourceYandexSmartcaptchaCaptcha(),
 		},
 	}
 
@@ -398,20 +451,80 @@ func withALBVirtualHostID(r *schema.Resource) *schema.Resource {
 
 func wrapParseVirtualHostID(f crudFunc) crudFunc {
 	return func(d *schema.ResourceData, meta interface{}) error {
-		attrs := strings.Split(d.Id(), "/")
-		if len(attrs) < 2 {
-			return fmt.Errorf("error reading virtual_host, wrong id: %q", d.Id())
+		httpRouterID, name, err := resourceid.DeconstructVirtualHostID(d.Id())
+		if err != nil {
+			return fmt.Errorf("error reading virtual_host, wrong id: %w", err)
 		}
-		if err := d.Set("http_router_id", attrs[0]); err != nil {
+		if err := d.Set("http_router_id", httpRouterID); err != nil {
 			return err
 		}
-		if err := d.Set("name", attrs[1]); err != nil {
+		if err := d.Set("name", name); err != nil {
 			return err
 		}
 		return f(d, meta)
 	}
 }
 
+type crudContextFunc = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics
+
+// withResourceIdentity adds resource identity built from the resource ID of the given format,
+// so the resource can be imported with an `identity` block of the `import` block.
+func withResourceIdentity(r *schema.Resource, format resourceid.Format) *schema.Resource {
+	r.Identity = format.SDKIdentity()
+
+	var next schema.StateContextFunc
+	if r.Importer != nil && r.Importer.StateContext != nil {
+		next = r.Importer.StateContext
+	} else if r.Importer != nil && r.Importer.State != nil {
+		state := r.Importer.State
+		next = func(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			return state(d, meta)
+		}
+	}
+	r.Importer = &schema.ResourceImporter{
+		StateContext: format.SDKImportState(next),
+	}
+
+	if r.Create != nil {
+		r.Create = wrapSetIdentity(r.Create, format)
+	}
+	if r.Read != nil {
+		r.Read = wrapSetIdentity(r.Read, format)
+	}
+	if r.Update != nil {
+		r.Update = wrapSetIdentity(r.Update, format)
+	}
+	if r.CreateContext != nil {
+		r.CreateContext = wrapContextSetIdentity(r.CreateContext, format)
+	}
+	if r.ReadContext != nil {
+		r.ReadContext = wrapContextSetIdentity(r.ReadContext, format)
+	}
+	if r.UpdateContext != nil {
+		r.UpdateContext = wrapContextSetIdentity(r.UpdateContext, format)
+	}
+	return r
+}
+
+func wrapSetIdentity(f crudFunc, format resourceid.Format) crudFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		if err := f(d, meta); err != nil {
+			return err
+		}
+		return format.SDKSetIdentity(d)
+	}
+}
+
+func wrapContextSetIdentity(f crudContextFunc, format resourceid.Format) crudContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		diags := f(ctx, d, meta)
+		if diags.HasError() {
+			return diags
+		}
+		return append(diags, diag.FromErr(format.SDKSetIdentity(d))...)
+	}
+}
+
 func setToDefaultIfNeeded(field string, osEnvName string, defaultVal string) string {
 	if len(field) != 0 {
 		return field
@@ -436,13 +549,19 @@ func setToDefaultBoolIfNeeded(osEnvName string, defaultVal bool) bool {
 // testConfig is used to avoid using StopContext duo to tests are run in parallel and context is cancelled randomly in tests
 // there is same following issue https://github.com/hashicorp/terraform-plugin-sdk/issues/966
 func providerConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Provider, emptyFolder bool, testConfig bool) (interface{}, diag.Diagnostics) {
+	// Settings of the yc CLI profile are used when they are set neither in the provider block nor in the environment.
+	cliProfile, err := ycprofile.Resolve(d.Get("shared_credentials_file").(string), d.Get("profile").(string))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
 	config := Config{
-		Endpoint:                       setToDefaultIfNeeded(d.Get("endpoint").(string), "YC_ENDPOINT", common.DefaultEndpoint),
-		FolderID:                       setToDefaultIfNeeded(d.Get("folder_id").(string), "YC_FOLDER_ID", ""),
-		CloudID:                        setToDefaultIfNeeded(d.Get("cloud_id").(string), "YC_CLOUD_ID", ""),
-		OrganizationID:                 setToDefaultIfNeeded(d.Get("organization_id").(string), "YC_ORGANIZATION_ID", ""),
+		Endpoint:                       setToDefaultIfNeeded(d.Get("endpoint").(string), "YC_ENDPOINT", ycprofile.Or(cliProfile.Endpoint, common.DefaultEndpoint)),
+		FolderID:                       setToDefaultIfNeeded(d.Get("folder_id").(string), "YC_FOLDER_ID", cliProfile.FolderID),
+		CloudID:                        setToDefaultIfNeeded(d.Get("cloud_id").(string), "YC_CLOUD_ID", cliProfile.CloudID),
+		OrganizationID:                 setToDefaultIfNeeded(d.Get("organization_id").(string), "YC_ORGANIZATION_ID", cliProfile.OrganizationID),
 		Region:                         setToDefaultIfNeeded(d.Get("region_id").(string), "YC_REGION", common.DefaultRegion),
-		Zone:                           setToDefaultIfNeeded(d.Get("zone").(string), "YC_ZONE", ""),
+		Zone:                           setToDefaultIfNeeded(d.Get("zone").(string), "YC_ZONE", cliProfile.Zone),
 		Token:                          setToDefaultIfNeeded(d.Get("token").(string), "YC_TOKEN", ""),
 		ServiceAccountKeyFileOrContent: setToDefaultIfNeeded(d.Get("service_account_key_file").(string), "YC_SERVICE_ACCOUNT_KEY_FILE", ""),
 		StorageEndpoint:                setToDefaultIfNeeded(d.Get("storage_endpoint").(string), "YC_STORAGE_ENDPOINT_URL", common.DefaultStorageEndpoint),
@@ -451,6 +570,7 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 		YMQEndpoint:                    setToDefaultIfNeeded(d.Get("ymq_endpoint").(string), "YC_MESSAGE_QUEUE_ENDPOINT", common.DefaultYMQEndpoint),
 		YMQAccessKey:                   setToDefaultIfNeeded(d.Get("ymq_access_key").(string), "YC_MESSAGE_QUEUE_ACCESS_KEY", ""),
 		YMQSecretKey:                   setToDefaultIfNeeded(d.Get("ymq_secret_key").(string), "YC_MESSAGE_QUEUE_SECRET_KEY", ""),
+		ImpersonateServiceAccountID:    setToDefaultIfNeeded(d.Get("impersonate_service_account_id").(string), "YC_IMPERSONATE_SERVICE_ACCOUNT_ID", ""),
 
 		Plaintext:             setToDefaultBoolIfNeeded("YC_PLAINTEXT", d.Get("plaintext").(bool)),
 		Insecure:              setToDefaultBoolIfNeeded("YC_INSECURE", d.Get("insecure").(bool)),
@@ -464,6 +584,21 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 		config.Profile = "default"
 	}
 
+	if v := d.Get("workload_identity").([]interface{}); len(v) > 1 {
+		return nil, diag.Errorf("only one workload_identity block can be specified")
+	} else if len(v) == 1 && v[0] != nil {
+		wi := v[0].(map[string]interface{})
+		config.WorkloadIdentity = &workloadidentity.Config{
+			ServiceAccountID: wi["service_account_id"].(string),
+			JWTFile:          wi["jwt_file"].(string),
+			JWTEnv:           wi["jwt_env"].(string),
+		}
+	}
+
+	if config.Token == "" && config.ServiceAccountKeyFileOrContent == "" && config.WorkloadIdentity == nil {
+		config.cliProfile = cliProfile
+	}
+
 	if config.MaxRetries == 0 {
 		config.MaxRetries = common.DefaultMaxRetries
 	}
@@ -0,0 +1,37 @@
+package yandex_test
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+)
+
+func init() {
+	yandex.SetAccMuxProviderFactories(map[string]func() (tfprotov6.ProviderServer, error){
+		"yandex": func() (tfprotov6.ProviderServer, error) {
+			ctx := context.Background()
+			// The SDKv2 side is the configured testAccProvider, so the testAccCheck***Destroy functions
+			// can use its meta.
+			sdkProvider, err := tf5to6server.UpgradeServer(ctx, yandex.AccProvider().GRPCProvider)
+			if err != nil {
+				return nil, err
+			}
+			muxServer, err := tf6muxserver.NewMuxServer(ctx,
+				providerserver.NewProtocol6(yandex_framework.NewFrameworkProvider()),
+				func() tfprotov6.ProviderServer {
+					return sdkProvider
+				},
+			)
+			if err != nil {
+				return nil, err
+			}
+			return muxServer.ProviderServer(), nil
+		},
+	})
+}
@@ -0,0 +1,22 @@
+package yandex
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// testAccMuxProviderFactories serve testAccProvider muxed with the plugin framework provider, like
+// the released provider does. Use them in the acceptance tests which create resources implemented
+// with the plugin framework, e.g. yandex_vpc_security_group. The framework provider imports this
+// package, so the factories are set by the external test package, see provider_mux_ext_test.go.
+var testAccMuxProviderFactories map[string]func() (tfprotov6.ProviderServer, error)
+
+// AccProvider returns the SDKv2 provider of the acceptance tests to the external test package.
+func AccProvider() *schema.Provider {
+	return testAccProvider
+}
+
+// SetAccMuxProviderFactories sets testAccMuxProviderFactories from the external test package.
+func SetAccMuxProviderFactories(factories map[string]func() (tfprotov6.ProviderServer, error)) {
+	testAccMuxProviderFactories = factories
+}
@@ -232,6 +232,60 @@ func TestProviderSharedCredentialsFileAndProfile(t *testing.T) {
 	assert.Equal(t, "prod-profile", conf.Profile)
 }
 
+func TestProviderImpersonateServiceAccountID(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      map[string]interface{}
+		env      string
+		expected string
+	}{
+		{
+			name:     "not set",
+			raw:      map[string]interface{}{},
+			expected: "",
+		},
+		{
+			name:     "attribute",
+			raw:      map[string]interface{}{"impersonate_service_account_id": "sa-from-config"},
+			env:      "sa-from-env",
+			expected: "sa-from-config",
+		},
+		{
+			name:     "env var",
+			raw:      map[string]interface{}{},
+			env:      "sa-from-env",
+			expected: "sa-from-env",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("YC_IMPERSONATE_SERVICE_ACCOUNT_ID", tt.env)
+			testProvider := NewSDKProvider()
+
+			raw := map[string]interface{}{
+				"token": "any_string_like_a_oauth",
+			}
+			for k, v := range tt.raw {
+				raw[k] = v
+			}
+
+			diags := testProvider.Configure(context.Background(), terraform2.NewResourceConfigRaw(raw))
+			if diags != nil && diags.HasError() {
+				for _, d := range diags {
+					if d.Severity == diag.Error {
+						t.Fatalf("error configuring provider: %s", d.Summary)
+					}
+				}
+			}
+
+			conf := testProvider.Meta().(*Config)
+			assert.Equal(t, tt.expected, conf.ImpersonateServiceAccountID)
+			assert.NotNil(t, conf.sdk)
+		})
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	for _, varName := range testAccEnvVars {
 		if val := os.Getenv(varName); val == "" {
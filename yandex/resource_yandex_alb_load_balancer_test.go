@@ -84,9 +84,9 @@ func TestAccALBLoadBalancer_basic(t *testing.T) {
 	folderID := getExampleFolderID()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckALBLoadBalancerDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckALBLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccALBLoadBalancerBasic(balancerName, balancerDescription),
@@ -116,9 +116,9 @@ func TestAccALBLoadBalancer_streamListener(t *testing.T) {
 	listenerPath := ""
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckALBLoadBalancerDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckALBLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testALBLoadBalancerConfig_basic(albResource),
@@ -158,9 +158,9 @@ func TestAccALBLoadBalancer_httpListenerWithHTTP2Options(t *testing.T) {
 	listenerPath := ""
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckALBLoadBalancerDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckALBLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testALBLoadBalancerConfig_basic(albResource),
@@ -197,9 +197,9 @@ func TestAccALBLoadBalancer_httpListenerWithAllowHTTP10(t *testing.T) {
 	listenerPath := ""
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckALBLoadBalancerDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckALBLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testALBLoadBalancerConfig_basic(albResource),
@@ -236,9 +236,9 @@ func TestAccALBLoadBalancer_httpListenerWithRewriteRequestID(t *testing.T) {
 	listenerPath := ""
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckALBLoadBalancerDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckALBLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testALBLoadBalancerConfig_basic(albResource),
@@ -274,9 +274,9 @@ func TestAccALBLoadBalancer_httpListenerWithRedirects(t *testing.T) {
 	listenerPath := ""
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckALBLoadBalancerDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckALBLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testALBLoadBalancerConfig_basic(albResource),
@@ -312,9 +312,9 @@ func TestAccALBLoadBalancer_tlsListenerWithStreamHandler(t *testing.T) {
 	listenerPath := ""
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckALBLoadBalancerDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckALBLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testALBLoadBalancerConfig_basic(albResource),
@@ -360,9 +360,9 @@ func TestAccALBLoadBalancer_tlsListenerWithHTTP2Options(t *testing.T) {
 	listenerPath := ""
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckALBLoadBalancerDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckALBLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testALBLoadBalancerConfig_basic(albResource),
@@ -405,9 +405,9 @@ func TestAccALBLoadBalancer_tlsListenerWithAllowHTTP10(t *testing.T) {
 	listenerPath := ""
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckALBLoadBalancerDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckALBLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testALBLoadBalancerConfig_basic(albResource),
@@ -444,9 +444,9 @@ func TestAccALBLoadBalancer_tlsListenerWithRewriteRequestID(t *testing.T) {
 	listenerPath := ""
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckALBLoadBalancerDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckALBLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testALBLoadBalancerConfig_basic(albResource),
@@ -475,9 +475,9 @@ func TestAccALBLoadBalancer_update(t *testing.T) {
 	var alb apploadbalancer.LoadBalancer
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckALBLoadBalancerDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckALBLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccALBLoadBalancerBasic(
@@ -517,9 +517,9 @@ func TestAccALBLoadBalancer_logOptions(t *testing.T) {
 	var rulesPath string
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckALBLoadBalancerDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckALBLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testALBLoadBalancerConfig_basic(albResource),
@@ -2,21 +2,24 @@ package yandex
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
 )
 
 const yandexALBVirtualHostDefaultTimeout = 5 * time.Minute
 
 func resourceYandexALBVirtualHost() *schema.Resource {
 	return &schema.Resource{
-		Description: "Creates a virtual host that belongs to specified HTTP router and adds the specified routes to it. For more information, see [the official documentation](https://yandex.cloud/docs/application-load-balancer/concepts/http-router).\n",
+		Description: "Creates a virtual host that belongs to specified HTTP router and adds the specified routes to it. For more information, see [the official documentation](https://yandex.cloud/docs/application-load-balancer/concepts/http-router).\n\n~> Invalid RE2 regular expressions and `host_rewrite` combined with `auto_host_rewrite` in routes are reported as errors at plan time.\n",
 		Create:      resourceYandexALBVirtualHostCreate,
 		Read:        resourceYandexALBVirtualHostRead,
 		Update:      resourceYandexALBVirtualHostUpdate,
@@ -25,6 +28,8 @@ func resourceYandexALBVirtualHost() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceYandexALBVirtualHostCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(yandexALBVirtualHostDefaultTimeout),
 			Update: schema.DefaultTimeout(yandexALBVirtualHostDefaultTimeout),
@@ -293,6 +298,18 @@ func resourceYandexALBVirtualHost() *schema.Resource {
 				},
 			},
 			"route_options": routeOptions(),
+			"ignore_external_routes": {
+				Type:        schema.TypeBool,
+				Description: "If `true`, the routes of the virtual host which are not declared in `route` blocks, e.g. the ones managed by `yandex_alb_virtual_host_route` resources, are neither read into `route` nor removed on update. Such routes are identified by name, so the routes should have unique names. On import all routes are read into `route`. Default is `false`.",
+				Optional:    true,
+				Default:     false,
+			},
+			"validate_shadowed_routes": {
+				Type:        schema.TypeBool,
+				Description: "If `true`, the routes which never match any request, because an earlier route matches all their requests, are reported as errors at plan time. Routes with `regex` matches are not checked. Default is `false`.",
+				Optional:    true,
+				Default:     false,
+			},
 		},
 	}
 }
@@ -410,6 +427,21 @@ func rateLimit() *schema.Schema {
 	}
 }
 
+func resourceYandexALBVirtualHostCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	routes := diff.Get("route").([]interface{})
+
+	errs := validateALBRegexes("route_options", diff.Get("route_options"))
+	for i, route := range routes {
+		if route, ok := route.(map[string]interface{}); ok {
+			errs = append(errs, validateALBRoute(fmt.Sprintf("route.%d.", i), route)...)
+		}
+	}
+	if diff.Get("validate_shadowed_routes").(bool) {
+		errs = append(errs, validateALBShadowedRoutes(routes, diff.NewValueKnown)...)
+	}
+	return errors.Join(errs...)
+}
+
 func resourceYandexALBVirtualHostCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -438,7 +470,7 @@ func resourceYandexALBVirtualHostCreate(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("could not get Application Virtual Host ID from create operation metadata")
 	}
 
-	d.SetId(md.HttpRouterId + "/" + md.VirtualHostName)
+	d.SetId(resourceid.VirtualHost.Construct(md.HttpRouterId, md.VirtualHostName))
 
 	err = op.Wait(ctx)
 	if err != nil {
@@ -526,7 +558,13 @@ func resourceYandexALBVirtualHostRead(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	routes, err := flattenALBRoutes(virtualHost.Routes)
+	apiRoutes := virtualHost.Routes
+	// There are no routes in the state on import to tell the declared routes from the external ones, so all of them are read.
+	if stateRoutes := d.Get("route").([]interface{}); d.Get("ignore_external_routes").(bool) && len(stateRoutes) > 0 {
+		apiRoutes = filterALBExternalRoutes(apiRoutes, albRouteNames(stateRoutes))
+	}
+
+	routes, err := flattenALBRoutes(apiRoutes)
 	if err != nil {
 		return err
 	}
@@ -540,6 +578,8 @@ func resourceYandexALBVirtualHostRead(d *schema.ResourceData, meta interface{})
 
 	d.Set("name", virtualHost.Name)
 	d.Set("authority", virtualHost.Authority)
+	d.Set("ignore_external_routes", d.Get("ignore_external_routes"))
+	d.Set("validate_shadowed_routes", d.Get("validate_shadowed_routes"))
 
 	if err := d.Set("modify_request_headers", requestHeaderModification); err != nil {
 		return err
@@ -578,6 +618,27 @@ func resourceYandexALBVirtualHostUpdate(d *schema.ResourceData, meta interface{}
 	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
 	defer cancel()
 
+	mutexKey := albVirtualHostMutexKey(req.HttpRouterId, req.VirtualHostName)
+	mutexKV.Lock(mutexKey)
+	defer mutexKV.Unlock(mutexKey)
+
+	if d.Get("ignore_external_routes").(bool) {
+		virtualHost, err := config.sdk.ApplicationLoadBalancer().VirtualHost().Get(ctx, &apploadbalancer.GetVirtualHostRequest{
+			HttpRouterId:    req.HttpRouterId,
+			VirtualHostName: req.VirtualHostName,
+		})
+		if err != nil {
+			return fmt.Errorf("Error while requesting API to get Application Virtual Host %q: %w", d.Id(), err)
+		}
+
+		oldRoutes, newRoutes := d.GetChange("route")
+		inlineNames := albRouteNames(oldRoutes)
+		for name := range albRouteNames(newRoutes) {
+			inlineNames[name] = true
+		}
+		req.Routes = mergeALBExternalRoutes(req.Routes, virtualHost.GetRoutes(), inlineNames)
+	}
+
 	op, err := config.sdk.WrapOperation(config.sdk.ApplicationLoadBalancer().VirtualHost().Update(ctx, req))
 	if err != nil {
 		return fmt.Errorf("Error while requesting API to update Application Virtual Host %q: %w", d.Id(), err)
@@ -639,6 +700,50 @@ func buildALBVirtualHostUpdateRequest(d *schema.ResourceData) (*apploadbalancer.
 	return req, nil
 }
 
+// albRouteNames returns the names of the routes declared in the `route` blocks.
+func albRouteNames(routes interface{}) map[string]bool {
+	names := make(map[string]bool)
+	for _, route := range routes.([]interface{}) {
+		route, ok := route.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if name, _ := route["name"].(string); name != "" {
+			names[name] = true
+		}
+	}
+	return names
+}
+
+// isALBExternalRoute reports whether the route is not declared in the `route` blocks of the virtual host.
+// Unnamed routes cannot be managed by other resources, so they are always considered declared.
+func isALBExternalRoute(route *apploadbalancer.Route, inlineNames map[string]bool) bool {
+	return route.GetName() != "" && !inlineNames[route.GetName()]
+}
+
+// filterALBExternalRoutes returns the routes declared in the `route` blocks of the virtual host.
+func filterALBExternalRoutes(routes []*apploadbalancer.Route, inlineNames map[string]bool) []*apploadbalancer.Route {
+	var result []*apploadbalancer.Route
+	for _, route := range routes {
+		if !isALBExternalRoute(route, inlineNames) {
+			result = append(result, route)
+		}
+	}
+	return result
+}
+
+// mergeALBExternalRoutes returns the routes declared in the `route` blocks with the external routes of the
+// virtual host kept at their current indexes, as far as the number of the routes allows.
+func mergeALBExternalRoutes(inline, current []*apploadbalancer.Route, inlineNames map[string]bool) []*apploadbalancer.Route {
+	result := slices.Clone(inline)
+	for i, route := range current {
+		if isALBExternalRoute(route, inlineNames) {
+			result = slices.Insert(result, min(i, len(result)), route)
+		}
+	}
+	return result
+}
+
 func resourceYandexALBVirtualHostDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
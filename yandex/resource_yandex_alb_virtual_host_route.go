@@ -0,0 +1,347 @@
+package yandex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
+)
+
+func resourceYandexALBVirtualHostRoute() *schema.Resource {
+	routeSchema := resourceYandexALBVirtualHost().Schema["route"].Elem.(*schema.Resource).Schema
+
+	httpRoute := *routeSchema["http_route"]
+	httpRoute.ExactlyOneOf = []string{"http_route", "grpc_route"}
+	grpcRoute := *routeSchema["grpc_route"]
+	grpcRoute.ExactlyOneOf = []string{"http_route", "grpc_route"}
+
+	return &schema.Resource{
+		Description: "Manages a single route of an existing [Application Load Balancer Virtual Host](https://yandex.cloud/docs/application-load-balancer/concepts/http-router). " +
+			"The route is inserted into the route list of the virtual host at the given `position` or next to the `before` or `after` route, so the routes of one virtual host can be managed by different configurations.\n\n" +
+			"~> Routes are matched *in-order*. The placement is applied when the route is created and when the placement arguments change.\n\n" +
+			"~> Set `ignore_external_routes` of the `yandex_alb_virtual_host` resource, otherwise its inline `route` blocks remove the routes managed by this resource.\n",
+		Create: resourceYandexALBVirtualHostRouteCreate,
+		Read:   resourceYandexALBVirtualHostRouteRead,
+		Update: resourceYandexALBVirtualHostRouteUpdate,
+		Delete: resourceYandexALBVirtualHostRouteDelete,
+
+		CustomizeDiff: resourceYandexALBVirtualHostRouteCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexALBVirtualHostDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexALBVirtualHostDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexALBVirtualHostDefaultTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"http_router_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the HTTP router to which the virtual host belongs.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"virtual_host_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the virtual host to which the route belongs.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Name of the route. It should be unique within the virtual host.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"position": {
+				Type:          schema.TypeInt,
+				Description:   "The index of the route in the route list of the virtual host, starting from 0. If it is greater than the number of the other routes, the route is appended to the end. If none of `position`, `before` and `after` is specified, the route is appended to the end.",
+				Optional:      true,
+				ValidateFunc:  validation.IntAtLeast(0),
+				ConflictsWith: []string{"before", "after"},
+			},
+			"before": {
+				Type:          schema.TypeString,
+				Description:   "The name of the route to insert the route before.",
+				Optional:      true,
+				ConflictsWith: []string{"position", "after"},
+			},
+			"after": {
+				Type:          schema.TypeString,
+				Description:   "The name of the route to insert the route after.",
+				Optional:      true,
+				ConflictsWith: []string{"position", "before"},
+			},
+			"http_route":    &httpRoute,
+			"grpc_route":    &grpcRoute,
+			"route_options": routeSchema["route_options"],
+			"index": {
+				Type:        schema.TypeInt,
+				Description: "The current index of the route in the route list of the virtual host.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceYandexALBVirtualHostRouteCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	return errors.Join(validateALBRoute("", map[string]interface{}{
+		"http_route":    diff.Get("http_route"),
+		"grpc_route":    diff.Get("grpc_route"),
+		"route_options": diff.Get("route_options"),
+	})...)
+}
+
+func resourceYandexALBVirtualHostRouteCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	httpRouterID := d.Get("http_router_id").(string)
+	virtualHostName := d.Get("virtual_host_name").(string)
+	name := d.Get("name").(string)
+
+	log.Printf("[DEBUG] Creating route %q of Application Virtual Host %q", name, virtualHostName)
+
+	route, err := expandALBRoute(d, "")
+	if err != nil {
+		return fmt.Errorf("Error expanding route while creating Application Virtual Host route: %w", err)
+	}
+	placement := albRoutePlacementFromResourceData(d)
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	err = updateALBVirtualHostRoutes(ctx, config, httpRouterID, virtualHostName, func(routes []*apploadbalancer.Route) ([]*apploadbalancer.Route, error) {
+		if findALBRoute(routes, name) >= 0 {
+			return nil, fmt.Errorf("route %q already exists in Application Virtual Host %q, import it to manage it", name, virtualHostName)
+		}
+		return insertALBRoute(routes, route, placement)
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(resourceid.VirtualHostRoute.Construct(httpRouterID, virtualHostName, name))
+
+	log.Printf("[DEBUG] Finished creating Application Virtual Host route %q", d.Id())
+	return resourceYandexALBVirtualHostRouteRead(d, meta)
+}
+
+func resourceYandexALBVirtualHostRouteRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Reading Application Virtual Host route %q", d.Id())
+	config := meta.(*Config)
+
+	parts, err := resourceid.VirtualHostRoute.Deconstruct(d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading virtual host route, wrong id: %w", err)
+	}
+	httpRouterID, virtualHostName, name := parts[0], parts[1], parts[2]
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	virtualHost, err := config.sdk.ApplicationLoadBalancer().VirtualHost().Get(ctx, &apploadbalancer.GetVirtualHostRequest{
+		HttpRouterId:    httpRouterID,
+		VirtualHostName: virtualHostName,
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Application Virtual Host %q", virtualHostName))
+	}
+
+	index := findALBRoute(virtualHost.GetRoutes(), name)
+	if index < 0 {
+		log.Printf("[WARN] Removing route %q of Application Virtual Host %q because it doesn't exist anymore", name, virtualHostName)
+		d.SetId("")
+		return nil
+	}
+
+	routes, err := flattenALBRoutes(virtualHost.GetRoutes()[index : index+1])
+	if err != nil {
+		return err
+	}
+	route := routes[0]
+
+	d.Set("http_router_id", httpRouterID)
+	d.Set("virtual_host_name", virtualHostName)
+	d.Set("name", name)
+	d.Set("index", index)
+
+	for _, key := range []string{"http_route", "grpc_route", "route_options"} {
+		if err := d.Set(key, route[key]); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Finished reading Application Virtual Host route %q", d.Id())
+	return nil
+}
+
+func resourceYandexALBVirtualHostRouteUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	log.Printf("[DEBUG] Updating Application Virtual Host route %q", d.Id())
+
+	name := d.Get("name").(string)
+	route, err := expandALBRoute(d, "")
+	if err != nil {
+		return fmt.Errorf("Error expanding route while updating Application Virtual Host route: %w", err)
+	}
+	placement := albRoutePlacementFromResourceData(d)
+	placementChanged := d.HasChanges("position", "before", "after")
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	err = updateALBVirtualHostRoutes(ctx, config, d.Get("http_router_id").(string), d.Get("virtual_host_name").(string), func(routes []*apploadbalancer.Route) ([]*apploadbalancer.Route, error) {
+		if index := findALBRoute(routes, name); index >= 0 && !placementChanged {
+			result := slices.Clone(routes)
+			result[index] = route
+			return result, nil
+		}
+		return insertALBRoute(removeALBRoute(routes, name), route, placement)
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished updating Application Virtual Host route %q", d.Id())
+	return resourceYandexALBVirtualHostRouteRead(d, meta)
+}
+
+func resourceYandexALBVirtualHostRouteDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	log.Printf("[DEBUG] Deleting Application Virtual Host route %q", d.Id())
+
+	name := d.Get("name").(string)
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	err := updateALBVirtualHostRoutes(ctx, config, d.Get("http_router_id").(string), d.Get("virtual_host_name").(string), func(routes []*apploadbalancer.Route) ([]*apploadbalancer.Route, error) {
+		return removeALBRoute(routes, name), nil
+	})
+	if err != nil && !isStatusWithCode(err, codes.NotFound) {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting Application Virtual Host route %q", d.Id())
+	return nil
+}
+
+// albVirtualHostMutexKey is the key of the lock held while the routes of the virtual host are modified.
+func albVirtualHostMutexKey(httpRouterID, virtualHostName string) string {
+	return fmt.Sprintf("alb-virtual-host-%s", resourceid.VirtualHost.Construct(httpRouterID, virtualHostName))
+}
+
+// updateALBVirtualHostRoutes reads the routes of the virtual host, modifies them and writes them back under
+// the lock of the virtual host, so the resources sharing the virtual host do not overwrite each other's routes.
+func updateALBVirtualHostRoutes(ctx context.Context, config *Config, httpRouterID, virtualHostName string, modify func([]*apploadbalancer.Route) ([]*apploadbalancer.Route, error)) error {
+	mutexKey := albVirtualHostMutexKey(httpRouterID, virtualHostName)
+	mutexKV.Lock(mutexKey)
+	defer mutexKV.Unlock(mutexKey)
+
+	virtualHost, err := config.sdk.ApplicationLoadBalancer().VirtualHost().Get(ctx, &apploadbalancer.GetVirtualHostRequest{
+		HttpRouterId:    httpRouterID,
+		VirtualHostName: virtualHostName,
+	})
+	if err != nil {
+		return fmt.Errorf("Error while requesting API to get Application Virtual Host %q: %w", virtualHostName, err)
+	}
+
+	routes, err := modify(virtualHost.GetRoutes())
+	if err != nil {
+		return err
+	}
+
+	op, err := config.sdk.WrapOperation(config.sdk.ApplicationLoadBalancer().VirtualHost().Update(ctx, &apploadbalancer.UpdateVirtualHostRequest{
+		HttpRouterId:    httpRouterID,
+		VirtualHostName: virtualHostName,
+		UpdateMask:      &field_mask.FieldMask{Paths: []string{"routes"}},
+		Routes:          routes,
+	}))
+	if err != nil {
+		return fmt.Errorf("Error while requesting API to update routes of Application Virtual Host %q: %w", virtualHostName, err)
+	}
+
+	if err := op.Wait(ctx); err != nil {
+		return fmt.Errorf("Error updating routes of Application Virtual Host %q: %w", virtualHostName, err)
+	}
+	return nil
+}
+
+// albRoutePlacement is the place of a route in the route list of a virtual host.
+// The route is appended to the end if none of the fields is set.
+type albRoutePlacement struct {
+	position *int
+	before   string
+	after    string
+}
+
+func albRoutePlacementFromResourceData(d *schema.ResourceData) albRoutePlacement {
+	placement := albRoutePlacement{
+		before: d.Get("before").(string),
+		after:  d.Get("after").(string),
+	}
+	// position 0 is a valid index, so the raw config tells whether it is set.
+	if raw := d.GetRawConfig(); !raw.IsNull() && !raw.GetAttr("position").IsNull() {
+		position := d.Get("position").(int)
+		placement.position = &position
+	}
+	return placement
+}
+
+// insertALBRoute returns a copy of the routes with the route inserted at the placement.
+func insertALBRoute(routes []*apploadbalancer.Route, route *apploadbalancer.Route, placement albRoutePlacement) ([]*apploadbalancer.Route, error) {
+	index := len(routes)
+	switch {
+	case placement.position != nil:
+		index = min(*placement.position, len(routes))
+	case placement.before != "":
+		index = findALBRoute(routes, placement.before)
+		if index < 0 {
+			return nil, fmt.Errorf("route %q to insert the route %q before is not found", placement.before, route.GetName())
+		}
+	case placement.after != "":
+		index = findALBRoute(routes, placement.after)
+		if index < 0 {
+			return nil, fmt.Errorf("route %q to insert the route %q after is not found", placement.after, route.GetName())
+		}
+		index++
+	}
+
+	return slices.Insert(slices.Clone(routes), index, route), nil
+}
+
+// removeALBRoute returns a copy of the routes without the route with the name.
+func removeALBRoute(routes []*apploadbalancer.Route, name string) []*apploadbalancer.Route {
+	result := make([]*apploadbalancer.Route, 0, len(routes))
+	for _, route := range routes {
+		if route.GetName() != name {
+			result = append(result, route)
+		}
+	}
+	return result
+}
+
+// findALBRoute returns the index of the route with the name or -1 if there is no such route.
+func findALBRoute(routes []*apploadbalancer.Route, name string) int {
+	for i, route := range routes {
+		if route.GetName() == name {
+			return i
+		}
+	}
+	return -1
+}
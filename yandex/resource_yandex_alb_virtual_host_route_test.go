@@ -0,0 +1,199 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
+)
+
+const albVHRouteResource = "yandex_alb_virtual_host_route.test-route"
+
+func TestAccALBVirtualHostRoute_basic(t *testing.T) {
+	t.Parallel()
+
+	var virtualHost apploadbalancer.VirtualHost
+	virtualHostName := acctest.RandomWithPrefix("tf-virtual-host")
+	httpRouterName := acctest.RandomWithPrefix("tf-http-router")
+	httpRouterDesc := acctest.RandomWithPrefix("tf-http-router-desc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckALBVirtualHostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccALBVirtualHostRouteConfig(httpRouterName, httpRouterDesc, virtualHostName, `before = "default"`, "/api/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckALBVirtualHostExists(albVHResource, &virtualHost),
+					testAccCheckALBVirtualHostRouteNames(&virtualHost, "static", "api", "default"),
+					resource.TestCheckResourceAttr(albVHResource, "route.#", "2"),
+					resource.TestCheckResourceAttr(albVHRouteResource, "index", "1"),
+					resource.TestCheckResourceAttr(albVHRouteResource, "http_route.0.http_match.0.path.0.prefix", "/api/"),
+				),
+			},
+			{
+				Config: testAccALBVirtualHostRouteConfig(httpRouterName, httpRouterDesc, virtualHostName, `position = 0`, "/api/v2/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckALBVirtualHostExists(albVHResource, &virtualHost),
+					testAccCheckALBVirtualHostRouteNames(&virtualHost, "api", "static", "default"),
+					resource.TestCheckResourceAttr(albVHResource, "route.#", "2"),
+					resource.TestCheckResourceAttr(albVHRouteResource, "index", "0"),
+					resource.TestCheckResourceAttr(albVHRouteResource, "http_route.0.http_match.0.path.0.prefix", "/api/v2/"),
+				),
+			},
+			{
+				ResourceName:            albVHRouteResource,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"position"},
+			},
+		},
+	})
+}
+
+func testAccCheckALBVirtualHostRouteNames(virtualHost *apploadbalancer.VirtualHost, names ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		var actual []string
+		for _, route := range virtualHost.GetRoutes() {
+			actual = append(actual, route.GetName())
+		}
+		if fmt.Sprint(actual) != fmt.Sprint(names) {
+			return fmt.Errorf("expected routes %v of Virtual Host, got %v", names, actual)
+		}
+		return nil
+	}
+}
+
+func testAccALBVirtualHostRouteConfig(httpRouterName, httpRouterDesc, virtualHostName, placement, prefix string) string {
+	return testAccALBGeneralHTTPRouterTemplate(httpRouterName, httpRouterDesc) + fmt.Sprintf(`
+resource "yandex_alb_virtual_host" "test-vh" {
+  http_router_id         = yandex_alb_http_router.test-router.id
+  name                   = "%s"
+  ignore_external_routes = true
+
+  route {
+    name = "static"
+    http_route {
+      http_match {
+        path {
+          prefix = "/static/"
+        }
+      }
+      direct_response_action {
+        status = 404
+      }
+    }
+  }
+
+  route {
+    name = "default"
+    http_route {
+      direct_response_action {
+        status = 200
+        body   = "ok"
+      }
+    }
+  }
+}
+
+resource "yandex_alb_virtual_host_route" "test-route" {
+  http_router_id    = yandex_alb_virtual_host.test-vh.http_router_id
+  virtual_host_name = yandex_alb_virtual_host.test-vh.name
+  name              = "api"
+  %s
+
+  http_route {
+    http_match {
+      path {
+        prefix = "%s"
+      }
+    }
+    direct_response_action {
+      status = 418
+    }
+  }
+}
+`, virtualHostName, placement, prefix)
+}
+
+func testALBRoutes(names ...string) []*apploadbalancer.Route {
+	var routes []*apploadbalancer.Route
+	for _, name := range names {
+		routes = append(routes, &apploadbalancer.Route{Name: name})
+	}
+	return routes
+}
+
+func testALBRouteNames(routes []*apploadbalancer.Route) []string {
+	var names []string
+	for _, route := range routes {
+		names = append(names, route.GetName())
+	}
+	return names
+}
+
+func TestUnitALBInsertRoute(t *testing.T) {
+	t.Parallel()
+
+	position := func(i int) *int { return &i }
+	route := &apploadbalancer.Route{Name: "new"}
+
+	for name, tc := range map[string]struct {
+		placement albRoutePlacement
+		expected  []string
+		expectErr bool
+	}{
+		"append":             {expected: []string{"a", "b", "c", "new"}},
+		"position":           {placement: albRoutePlacement{position: position(0)}, expected: []string{"new", "a", "b", "c"}},
+		"position too large": {placement: albRoutePlacement{position: position(10)}, expected: []string{"a", "b", "c", "new"}},
+		"before":             {placement: albRoutePlacement{before: "b"}, expected: []string{"a", "new", "b", "c"}},
+		"after":              {placement: albRoutePlacement{after: "c"}, expected: []string{"a", "b", "c", "new"}},
+		"missing anchor":     {placement: albRoutePlacement{after: "x"}, expectErr: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			routes := testALBRoutes("a", "b", "c")
+			result, err := insertALBRoute(routes, route, tc.placement)
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, testALBRouteNames(result))
+			assert.Equal(t, []string{"a", "b", "c"}, testALBRouteNames(routes))
+		})
+	}
+}
+
+func TestUnitALBRemoveRoute(t *testing.T) {
+	t.Parallel()
+
+	routes := testALBRoutes("a", "b", "c")
+	assert.Equal(t, []string{"a", "c"}, testALBRouteNames(removeALBRoute(routes, "b")))
+	assert.Equal(t, []string{"a", "b", "c"}, testALBRouteNames(removeALBRoute(routes, "x")))
+	assert.Equal(t, 2, findALBRoute(routes, "c"))
+	assert.Equal(t, -1, findALBRoute(routes, "x"))
+}
+
+func TestUnitALBExternalRoutes(t *testing.T) {
+	t.Parallel()
+
+	type M = map[string]interface{}
+	type S = []interface{}
+
+	inlineNames := albRouteNames(S{M{"name": "a"}, M{"name": "b"}, M{"name": ""}})
+	assert.Equal(t, map[string]bool{"a": true, "b": true}, inlineNames)
+
+	current := testALBRoutes("ext1", "a", "", "ext2", "b", "ext3")
+	assert.Equal(t, []string{"a", "", "b"}, testALBRouteNames(filterALBExternalRoutes(current, inlineNames)))
+
+	inline := testALBRoutes("b", "a")
+	assert.Equal(t, []string{"ext1", "b", "a", "ext2", "ext3"}, testALBRouteNames(mergeALBExternalRoutes(inline, current, inlineNames)))
+	assert.Equal(t, []string{"b", "a"}, testALBRouteNames(inline))
+}
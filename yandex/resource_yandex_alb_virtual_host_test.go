@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -948,7 +949,11 @@ func testAccCheckALBVirtualHostDestroy(s *terraform.State) error {
 		httpRouterID := rs.Primary.Attributes["http_router_id"]
 		virtualHostName := rs.Primary.Attributes["name"]
 		if httpRouterID == "" || virtualHostName == "" {
-			httpRouterID, virtualHostName = retrieveDataFromVirtualHostID(rs.Primary.ID)
+			var err error
+			httpRouterID, virtualHostName, err = resourceid.DeconstructVirtualHostID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
 		}
 
 		_, err := config.sdk.ApplicationLoadBalancer().VirtualHost().Get(context.Background(), &apploadbalancer.GetVirtualHostRequest{
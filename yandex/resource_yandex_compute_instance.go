@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/mitchellh/hashstructure"
@@ -48,6 +49,8 @@ func resourceYandexComputeInstance() *schema.Resource {
 
 		MigrateState: resourceComputeInstanceMigrateState,
 
+		CustomizeDiff: resourceYandexComputeInstanceValidateBootDisk,
+
 		Schema: map[string]*schema.Schema{
 			"resources": {
 				Type:        schema.TypeList,
@@ -121,22 +124,20 @@ func resourceYandexComputeInstance() *schema.Resource {
 						},
 
 						"disk_id": {
-							Type:          schema.TypeString,
-							Description:   "The ID of the existing disk (such as those managed by `yandex_compute_disk`) to attach as a boot disk.",
-							Optional:      true,
-							Computed:      true,
-							ForceNew:      true,
-							ConflictsWith: []string{"boot_disk.initialize_params"},
+							Type:        schema.TypeString,
+							Description: "The ID of the existing disk (such as those managed by `yandex_compute_disk`) to attach as a boot disk.",
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
 						},
 
 						"initialize_params": {
-							Type:          schema.TypeList,
-							Description:   "Parameters for a new disk that will be created alongside the new instance. Either `initialize_params` or `disk_id` must be set. Either `image_id` or `snapshot_id` must be specified.",
-							Optional:      true,
-							Computed:      true,
-							ForceNew:      true,
-							MaxItems:      1,
-							ConflictsWith: []string{"boot_disk.disk_id"},
+							Type:        schema.TypeList,
+							Description: "Parameters for a new disk that will be created alongside the new instance. Either `initialize_params` or `disk_id` must be set. Either `image_id` or `snapshot_id` must be specified.",
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							MaxItems:    1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"name": {
@@ -181,21 +182,19 @@ func resourceYandexComputeInstance() *schema.Resource {
 									},
 
 									"image_id": {
-										Type:          schema.TypeString,
-										Description:   "A disk image to initialize this disk from.",
-										Optional:      true,
-										Computed:      true,
-										ForceNew:      true,
-										ConflictsWith: []string{"boot_disk.initialize_params.snapshot_id"},
+										Type:        schema.TypeString,
+										Description: "A disk image to initialize this disk from.",
+										Optional:    true,
+										Computed:    true,
+										ForceNew:    true,
 									},
 
 									"snapshot_id": {
-										Type:          schema.TypeString,
-										Description:   "A snapshot to initialize this disk from.",
-										Optional:      true,
-										Computed:      true,
-										ForceNew:      true,
-										ConflictsWith: []string{"boot_disk.initialize_params.image_id"},
+										Type:        schema.TypeString,
+										Description: "A snapshot to initialize this disk from.",
+										Optional:    true,
+										Computed:    true,
+										ForceNew:    true,
 									},
 
 									"kms_key_id": {
@@ -740,6 +739,53 @@ func resourceYandexComputeInstance() *schema.Resource {
 	}
 }
 
+// resourceYandexComputeInstanceValidateBootDisk checks mutually exclusive boot disk arguments on every plan.
+// The check is not done with ConflictsWith, since all of these arguments are filled on read and the config
+// generated by `terraform plan -generate-config-out` for an imported instance must stay valid. Only the raw
+// config is checked, so the values read into the state do not conflict.
+func resourceYandexComputeInstanceValidateBootDisk(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return validateComputeInstanceBootDiskConfig(diff.GetRawConfig().GetAttr("boot_disk"))
+}
+
+// validateComputeInstanceBootDiskConfig checks the raw config of the boot_disk block.
+func validateComputeInstanceBootDiskConfig(bootDisk cty.Value) error {
+	if !bootDisk.IsKnown() || bootDisk.IsNull() || len(bootDisk.AsValueSlice()) == 0 {
+		return nil
+	}
+
+	bootDiskElem := bootDisk.AsValueSlice()[0]
+	if !bootDiskElem.IsKnown() || bootDiskElem.IsNull() {
+		return nil
+	}
+
+	bootDiskAttrs := bootDiskElem.AsValueMap()
+	diskID := bootDiskAttrs["disk_id"]
+	initializeParams := bootDiskAttrs["initialize_params"]
+	hasInitializeParams := !initializeParams.IsNull() && (!initializeParams.IsKnown() || len(initializeParams.AsValueSlice()) > 0)
+	if isRawStringSet(diskID) && hasInitializeParams {
+		return fmt.Errorf("only one of `boot_disk.0.disk_id` or `boot_disk.0.initialize_params` can be specified")
+	}
+	if !hasInitializeParams || !initializeParams.IsKnown() {
+		return nil
+	}
+
+	paramsElem := initializeParams.AsValueSlice()[0]
+	if !paramsElem.IsKnown() || paramsElem.IsNull() {
+		return nil
+	}
+
+	paramsAttrs := paramsElem.AsValueMap()
+	if isRawStringSet(paramsAttrs["image_id"]) && isRawStringSet(paramsAttrs["snapshot_id"]) {
+		return fmt.Errorf("only one of `boot_disk.0.initialize_params.0.image_id` or `boot_disk.0.initialize_params.0.snapshot_id` can be specified")
+	}
+	return nil
+}
+
+// isRawStringSet reports whether a string attribute of the raw config is set to a non-empty or unknown value.
+func isRawStringSet(v cty.Value) bool {
+	return !v.IsNull() && (!v.IsKnown() || v.AsString() != "")
+}
+
 func resourceYandexComputeInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
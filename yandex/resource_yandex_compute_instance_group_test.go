@@ -304,9 +304,9 @@ func TestAccComputeInstanceGroup_full(t *testing.T) {
 	fsName2 := acctest.RandomWithPrefix("tf-test")
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckComputeInstanceGroupDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckComputeInstanceGroupDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccComputeInstanceGroupConfigFull(name, saName, sgName, fsName1, fsName2),
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -265,9 +266,9 @@ func TestAccComputeInstance_SecurityGroups(t *testing.T) {
 	var instanceName = fmt.Sprintf("instance-test-%s", acctest.RandString(10))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckComputeInstanceDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckComputeInstanceDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccComputeInstance_SecurityGroups(instanceName),
@@ -568,9 +569,9 @@ func TestAccComputeInstance_update(t *testing.T) {
 	var instanceName = fmt.Sprintf("instance-test-%s", acctest.RandString(10))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckComputeInstanceDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckComputeInstanceDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccComputeInstance_basic(instanceName),
@@ -731,9 +732,9 @@ func TestAccComputeInstance_stopInstanceToUpdateAttachDetachNetworkIfaces(t *tes
 	var instanceName = fmt.Sprintf("instance-test-%s", acctest.RandString(10))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckComputeInstanceDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckComputeInstanceDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccComputeInstance_stopInstanceToUpdate_attach_detach_NetworkInterfaces(instanceName),
@@ -1084,6 +1085,87 @@ func TestAccComputeInstance_nat(t *testing.T) {
 	})
 }
 
+func TestValidateComputeInstanceBootDiskConfig(t *testing.T) {
+	initializeParams := func(imageID, snapshotID cty.Value) cty.Value {
+		return cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			"image_id":    imageID,
+			"snapshot_id": snapshotID,
+		})})
+	}
+	bootDisk := func(diskID, params cty.Value) cty.Value {
+		return cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			"disk_id":           diskID,
+			"initialize_params": params,
+		})})
+	}
+	noParams := cty.ListValEmpty(cty.Object(map[string]cty.Type{
+		"image_id":    cty.String,
+		"snapshot_id": cty.String,
+	}))
+	null := cty.NullVal(cty.String)
+
+	cases := []struct {
+		name     string
+		bootDisk cty.Value
+		err      string
+	}{
+		{
+			name:     "disk_id",
+			bootDisk: bootDisk(cty.StringVal("disk"), noParams),
+		},
+		{
+			name:     "image_id",
+			bootDisk: bootDisk(null, initializeParams(cty.StringVal("image"), null)),
+		},
+		{
+			name:     "snapshot_id",
+			bootDisk: bootDisk(null, initializeParams(null, cty.StringVal("snapshot"))),
+		},
+		{
+			name:     "empty disk_id with image_id",
+			bootDisk: bootDisk(cty.StringVal(""), initializeParams(cty.StringVal("image"), cty.StringVal(""))),
+		},
+		{
+			name:     "unknown initialize_params",
+			bootDisk: bootDisk(null, cty.UnknownVal(noParams.Type())),
+		},
+		{
+			name:     "no boot_disk",
+			bootDisk: cty.NullVal(bootDisk(null, noParams).Type()),
+		},
+		{
+			name:     "disk_id with initialize_params",
+			bootDisk: bootDisk(cty.StringVal("disk"), initializeParams(cty.StringVal("image"), null)),
+			err:      "only one of `boot_disk.0.disk_id` or `boot_disk.0.initialize_params` can be specified",
+		},
+		{
+			name:     "unknown disk_id with initialize_params",
+			bootDisk: bootDisk(cty.UnknownVal(cty.String), initializeParams(null, null)),
+			err:      "only one of `boot_disk.0.disk_id` or `boot_disk.0.initialize_params` can be specified",
+		},
+		{
+			name:     "image_id with snapshot_id",
+			bootDisk: bootDisk(null, initializeParams(cty.StringVal("image"), cty.StringVal("snapshot"))),
+			err:      "only one of `boot_disk.0.initialize_params.0.image_id` or `boot_disk.0.initialize_params.0.snapshot_id` can be specified",
+		},
+		{
+			name:     "image_id with unknown snapshot_id",
+			bootDisk: bootDisk(null, initializeParams(cty.StringVal("image"), cty.UnknownVal(cty.String))),
+			err:      "only one of `boot_disk.0.initialize_params.0.image_id` or `boot_disk.0.initialize_params.0.snapshot_id` can be specified",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateComputeInstanceBootDiskConfig(tc.bootDisk)
+			if tc.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.err)
+			}
+		})
+	}
+}
+
 func TestComputeInstancePlacementPolicyRequest(t *testing.T) {
 	rawInstanceID := "test-instance-id"
 	rawInstance := map[string]interface{}{
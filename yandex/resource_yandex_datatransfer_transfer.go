@@ -2,9 +2,12 @@
 package yandex
 
 import (
+	context "context"
 	fmt "fmt"
 	log "log"
+	time "time"
 
+	retry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	schema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	datatransfer "github.com/yandex-cloud/go-genproto/yandex/cloud/datatransfer/v1"
@@ -32,6 +35,14 @@ const (
 	dontActivateMode  = "dont_activate"
 )
 
+const (
+	// possible values of the field `desired_state`.
+	datatransferTransferStateActive = "active"
+	datatransferTransferStatePaused = "paused"
+
+	yandexDatatransferTransferDefaultTimeout = 60 * time.Minute
+)
+
 func resourceYandexDatatransferTransfer() *schema.Resource {
 	return &schema.Resource{
 		Description: "Manages a Data Transfer transfer. For more information, see [the official documentation](https://yandex.cloud/docs/data-transfer/).",
@@ -44,6 +55,11 @@ func resourceYandexDatatransferTransfer() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexDatatransferTransferDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexDatatransferTransferDefaultTimeout),
+		},
+
 		SchemaVersion: 1,
 
 		Schema: map[string]*schema.Schema{
@@ -52,6 +68,29 @@ func resourceYandexDatatransferTransfer() *schema.Resource {
 				Description: "Error description if transfer has any errors.",
 				Computed:    true,
 			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "Status of the transfer. One of `CREATING`, `CREATED`, `RUNNING`, `STOPPING`, `STOPPED`, `ERROR`, `SNAPSHOTTING`, `DONE`.",
+				Computed:    true,
+			},
+			"snapshot_done": {
+				Type:        schema.TypeBool,
+				Description: "Whether the initial snapshot of the transfer is done, i.e. the transfer status is `DONE` for a `SNAPSHOT_ONLY` transfer or `RUNNING` for an incremental one.",
+				Computed:    true,
+			},
+			"desired_state": {
+				Type:          schema.TypeString,
+				Description:   "The state the transfer is kept in: `active` to activate the transfer, `paused` to deactivate it. Unlike `on_create_activate_mode`, it is applied on update too, and a transfer which is activated or deactivated outside of Terraform is shown as a change. If not set, the transfer is activated according to `on_create_activate_mode` on create only.",
+				Optional:      true,
+				ValidateFunc:  validation.StringInSlice([]string{datatransferTransferStateActive, datatransferTransferStatePaused}, false),
+				ConflictsWith: []string{"on_create_activate_mode"},
+			},
+			"wait_for_snapshot": {
+				Type:        schema.TypeBool,
+				Description: "Wait until the initial snapshot is done after the transfer is activated by Terraform, so the resources which depend on the transfer can use the copied data. The waiting is limited by the `create` and `update` timeouts. The default is `false`.",
+				Optional:    true,
+				Default:     false,
+			},
 			"on_create_activate_mode": {
 				Type:         schema.TypeString,
 				Description:  "Activation action on create a new incremental transfer. It is not part of the transfer parameter and is used only on create. One of `sync_activate`, `async_activate`, `dont_activate`. The default is `sync_activate`.",
@@ -801,18 +840,34 @@ func resourceYandexDatatransferTransferCreateAndActivate(d *schema.ResourceData,
 		return fmt.Errorf("cannot create transfer: %w", err)
 	}
 
-	if transfer.Type != datatransfer.TransferType_SNAPSHOT_ONLY {
+	activated := false
+	switch desiredState := d.Get("desired_state").(string); {
+	case desiredState == datatransferTransferStateActive:
+		if err := activateTransfer(config, transfer.Id, true); err != nil {
+			return fmt.Errorf("cannot activate transfer %q: %w", transfer.Id, err)
+		}
+		activated = true
+	case desiredState == datatransferTransferStatePaused:
+		log.Printf("activating skipped by desired_state param: %s", desiredState)
+	case transfer.Type != datatransfer.TransferType_SNAPSHOT_ONLY:
 		activateType := d.Get("on_create_activate_mode").(string)
 		if activateType == asyncActivateMode || activateType == syncActivateMode || activateType == internalMessageActivateMode {
 			syncMode := activateType == syncActivateMode
 			if err := activateTransfer(config, transfer.Id, syncMode); err != nil {
 				return fmt.Errorf("cannot activate transfer %q: %w", transfer.Id, err)
 			}
+			activated = true
 		} else {
 			log.Printf("activating skipped by on_create_activate_mode param: %s", activateType)
 		}
 	}
 
+	if activated && d.Get("wait_for_snapshot").(bool) {
+		if err := waitDatatransferTransferSnapshot(config, transfer.Id, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
 	return resourceYandexDatatransferTransferRead(d, meta)
 }
 
@@ -899,6 +954,21 @@ func resourceYandexDatatransferTransferRead(d *schema.ResourceData, meta interfa
 		log.Printf("[ERROR] failed set field activate_mode: %s", err)
 		return err
 	}
+	if err := d.Set("status", resp.GetStatus().String()); err != nil {
+		log.Printf("[ERROR] failed set field status: %s", err)
+		return err
+	}
+	if err := d.Set("snapshot_done", isDatatransferTransferSnapshotDone(resp.GetType(), resp.GetStatus())); err != nil {
+		log.Printf("[ERROR] failed set field snapshot_done: %s", err)
+		return err
+	}
+	// desired_state is tracked only when it is managed, the transient and failed statuses keep the prior value.
+	if state := datatransferTransferState(resp.GetStatus()); d.Get("desired_state").(string) != "" && state != "" {
+		if err := d.Set("desired_state", state); err != nil {
+			log.Printf("[ERROR] failed set field desired_state: %s", err)
+			return err
+		}
+	}
 
 	transformation, err := flattenDatatransferTransferTransformation(d, resp.GetTransformation())
 	if err != nil {
@@ -953,23 +1023,162 @@ func resourceYandexDatatransferTransferUpdate(d *schema.ResourceData, meta inter
 	updatePath := generateDatatransferFieldMasks(d, datatransferUpdateTransferRequestFieldsRoot)
 	req.UpdateMask = &fieldmaskpb.FieldMask{Paths: updatePath}
 
-	md := new(metadata.MD)
-	op, err := config.sdk.WrapOperation(config.sdk.DataTransfer().Transfer().Update(ctx, req, grpc.Header(md)))
-	if traceHeader := md.Get("x-server-trace-id"); len(traceHeader) > 0 {
-		log.Printf("[DEBUG] Update Transfer x-server-trace-id: %s", traceHeader[0])
+	// the update may change only the state of the transfer, which is not a part of the transfer parameters.
+	if len(updatePath) > 0 {
+		md := new(metadata.MD)
+		op, err := config.sdk.WrapOperation(config.sdk.DataTransfer().Transfer().Update(ctx, req, grpc.Header(md)))
+		if traceHeader := md.Get("x-server-trace-id"); len(traceHeader) > 0 {
+			log.Printf("[DEBUG] Update Transfer x-server-trace-id: %s", traceHeader[0])
+		}
+		if traceHeader := md.Get("x-server-request-id"); len(traceHeader) > 0 {
+			log.Printf("[DEBUG] Update Transfer x-server-request-id: %s", traceHeader[0])
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := op.Wait(ctx); err != nil {
+			return fmt.Errorf("error while waiting operation to complete: %s", err)
+		}
 	}
-	if traceHeader := md.Get("x-server-request-id"); len(traceHeader) > 0 {
-		log.Printf("[DEBUG] Update Transfer x-server-request-id: %s", traceHeader[0])
+
+	if desiredState := d.Get("desired_state").(string); desiredState != "" && d.HasChange("desired_state") {
+		modifier := &datatransferTransferStateManager{Config: config}
+		activated, err := setDatatransferTransferState(config.Context(), modifier, d.Id(), desiredState, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+		if activated && d.Get("wait_for_snapshot").(bool) {
+			if err := waitDatatransferTransferSnapshot(config, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceYandexDatatransferTransferRead(d, meta)
+}
+
+// datatransferTransferState returns the value of `desired_state` which corresponds to the transfer status,
+// or an empty string for the transient and failed statuses. A finished snapshot-only transfer is active,
+// since activating it again would copy the data once more.
+func datatransferTransferState(status datatransfer.TransferStatus) string {
+	switch status {
+	case datatransfer.TransferStatus_RUNNING, datatransfer.TransferStatus_SNAPSHOTTING, datatransfer.TransferStatus_DONE:
+		return datatransferTransferStateActive
+	case datatransfer.TransferStatus_CREATED, datatransfer.TransferStatus_STOPPED:
+		return datatransferTransferStatePaused
+	default:
+		return ""
+	}
+}
+
+// isDatatransferTransferTransient reports whether the transfer is being created or stopped. Such a transfer
+// can be neither activated nor deactivated until the status settles.
+func isDatatransferTransferTransient(status datatransfer.TransferStatus) bool {
+	return status == datatransfer.TransferStatus_CREATING || status == datatransfer.TransferStatus_STOPPING
+}
+
+// isDatatransferTransferSnapshotDone reports whether the initial snapshot of the transfer is done. The incremental
+// transfers switch to RUNNING after the snapshot, the snapshot-only ones become DONE.
+func isDatatransferTransferSnapshotDone(transferType datatransfer.TransferType, status datatransfer.TransferStatus) bool {
+	if transferType == datatransfer.TransferType_SNAPSHOT_ONLY {
+		return status == datatransfer.TransferStatus_DONE
+	}
+	return status == datatransfer.TransferStatus_RUNNING
+}
+
+type datatransferTransferStateModifier interface {
+	GetTransfer(ctx context.Context, transferID string) (*datatransfer.Transfer, error)
+	ActivateTransfer(ctx context.Context, transferID string) error
+	DeactivateTransfer(ctx context.Context, transferID string) error
+}
+
+type datatransferTransferStateManager struct {
+	Config *Config
+}
+
+func (m *datatransferTransferStateManager) GetTransfer(ctx context.Context, transferID string) (*datatransfer.Transfer, error) {
+	return m.Config.sdk.DataTransfer().Transfer().Get(ctx, &datatransfer.GetTransferRequest{TransferId: transferID})
+}
+
+func (m *datatransferTransferStateManager) ActivateTransfer(_ context.Context, transferID string) error {
+	return activateTransfer(m.Config, transferID, true)
+}
+
+func (m *datatransferTransferStateManager) DeactivateTransfer(_ context.Context, transferID string) error {
+	return deactivateTransfer(m.Config, transferID)
+}
+
+// setDatatransferTransferState activates or deactivates the transfer unless it is already in the state.
+// A transfer which is being created or stopped is waited for first. It reports whether the transfer
+// has been activated.
+func setDatatransferTransferState(ctx context.Context, modifier datatransferTransferStateModifier, transferID string, state string, timeout time.Duration) (bool, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"transient"},
+		Target:  []string{"settled"},
+		Refresh: func() (interface{}, string, error) {
+			transfer, err := modifier.GetTransfer(ctx, transferID)
+			if err != nil {
+				return nil, "", err
+			}
+			if isDatatransferTransferTransient(transfer.GetStatus()) {
+				log.Printf("[DEBUG] Waiting for transfer %q to settle, status is %s", transferID, transfer.GetStatus())
+				return transfer, "transient", nil
+			}
+			return transfer, "settled", nil
+		},
+		Timeout: timeout,
 	}
+	result, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return err
+		return false, fmt.Errorf("error while waiting for transfer %q to settle: %w", transferID, err)
+	}
+	transfer := result.(*datatransfer.Transfer)
+	if datatransferTransferState(transfer.GetStatus()) == state {
+		log.Printf("[DEBUG] Transfer %q is already %s, status is %s", transferID, state, transfer.GetStatus())
+		return false, nil
 	}
 
-	if err := op.Wait(ctx); err != nil {
-		return fmt.Errorf("error while waiting operation to complete: %s", err)
+	if state == datatransferTransferStatePaused {
+		if err := modifier.DeactivateTransfer(ctx, transferID); err != nil {
+			return false, fmt.Errorf("cannot deactivate transfer %q: %w", transferID, err)
+		}
+		return false, nil
 	}
 
-	return resourceYandexDatatransferTransferRead(d, meta)
+	if err := modifier.ActivateTransfer(ctx, transferID); err != nil {
+		return false, fmt.Errorf("cannot activate transfer %q: %w", transferID, err)
+	}
+	return true, nil
+}
+
+// waitDatatransferTransferSnapshot waits until the initial snapshot of the activated transfer is done.
+func waitDatatransferTransferSnapshot(config *Config, transferID string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for the snapshot of transfer %q", transferID)
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"done"},
+		Refresh: func() (interface{}, string, error) {
+			transfer, err := config.sdk.DataTransfer().Transfer().Get(config.Context(), &datatransfer.GetTransferRequest{TransferId: transferID})
+			if err != nil {
+				return nil, "", err
+			}
+			if transfer.GetStatus() == datatransfer.TransferStatus_ERROR {
+				return nil, "", fmt.Errorf("transfer %q failed: %s", transferID, transfer.GetWarning())
+			}
+			if isDatatransferTransferSnapshotDone(transfer.GetType(), transfer.GetStatus()) {
+				return transfer, "done", nil
+			}
+			return transfer, "pending", nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(config.Context()); err != nil {
+		return fmt.Errorf("error while waiting for the snapshot of transfer %q: %w", transferID, err)
+	}
+	return nil
 }
 
 var datatransferUpdateTransferRequestFieldsRoot = &fieldTreeNode{
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/datatransfer/v1"
 	"google.golang.org/grpc/codes"
 )
@@ -976,3 +977,112 @@ resource "yandex_datatransfer_endpoint" "metrika_source" {
 }
 `, name, description)
 }
+
+func TestUnitDataTransferTransferState(t *testing.T) {
+	t.Parallel()
+
+	for transferStatus, expected := range map[datatransfer.TransferStatus]string{
+		datatransfer.TransferStatus_CREATING:     "",
+		datatransfer.TransferStatus_CREATED:      datatransferTransferStatePaused,
+		datatransfer.TransferStatus_RUNNING:      datatransferTransferStateActive,
+		datatransfer.TransferStatus_STOPPING:     "",
+		datatransfer.TransferStatus_STOPPED:      datatransferTransferStatePaused,
+		datatransfer.TransferStatus_ERROR:        "",
+		datatransfer.TransferStatus_SNAPSHOTTING: datatransferTransferStateActive,
+		datatransfer.TransferStatus_DONE:         datatransferTransferStateActive,
+	} {
+		assert.Equal(t, expected, datatransferTransferState(transferStatus), transferStatus.String())
+	}
+}
+
+type fakeDatatransferTransferStateModifier struct {
+	statuses []datatransfer.TransferStatus
+	calls    []string
+}
+
+func (m *fakeDatatransferTransferStateModifier) GetTransfer(_ context.Context, transferID string) (*datatransfer.Transfer, error) {
+	status := m.statuses[0]
+	if len(m.statuses) > 1 {
+		m.statuses = m.statuses[1:]
+	}
+	m.calls = append(m.calls, "get "+status.String())
+	return &datatransfer.Transfer{Id: transferID, Status: status}, nil
+}
+
+func (m *fakeDatatransferTransferStateModifier) ActivateTransfer(_ context.Context, transferID string) error {
+	m.calls = append(m.calls, "activate "+transferID)
+	return nil
+}
+
+func (m *fakeDatatransferTransferStateModifier) DeactivateTransfer(_ context.Context, transferID string) error {
+	m.calls = append(m.calls, "deactivate "+transferID)
+	return nil
+}
+
+func TestUnitDataTransferSetTransferState(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		statuses          []datatransfer.TransferStatus
+		state             string
+		expectedActivated bool
+		expectedCalls     []string
+	}{
+		{
+			name:              "activate stopped transfer",
+			statuses:          []datatransfer.TransferStatus{datatransfer.TransferStatus_STOPPED},
+			state:             datatransferTransferStateActive,
+			expectedActivated: true,
+			expectedCalls:     []string{"get STOPPED", "activate transfer"},
+		},
+		{
+			name:              "activate stopping transfer",
+			statuses:          []datatransfer.TransferStatus{datatransfer.TransferStatus_STOPPING, datatransfer.TransferStatus_STOPPED},
+			state:             datatransferTransferStateActive,
+			expectedActivated: true,
+			expectedCalls:     []string{"get STOPPING", "get STOPPED", "activate transfer"},
+		},
+		{
+			name:          "pause stopping transfer",
+			statuses:      []datatransfer.TransferStatus{datatransfer.TransferStatus_STOPPING, datatransfer.TransferStatus_STOPPED},
+			state:         datatransferTransferStatePaused,
+			expectedCalls: []string{"get STOPPING", "get STOPPED"},
+		},
+		{
+			name:          "pause running transfer",
+			statuses:      []datatransfer.TransferStatus{datatransfer.TransferStatus_RUNNING},
+			state:         datatransferTransferStatePaused,
+			expectedCalls: []string{"get RUNNING", "deactivate transfer"},
+		},
+		{
+			name:          "keep active transfer",
+			statuses:      []datatransfer.TransferStatus{datatransfer.TransferStatus_SNAPSHOTTING},
+			state:         datatransferTransferStateActive,
+			expectedCalls: []string{"get SNAPSHOTTING"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			modifier := &fakeDatatransferTransferStateModifier{statuses: tt.statuses}
+
+			activated, err := setDatatransferTransferState(context.Background(), modifier, "transfer", tt.state, time.Minute)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedActivated, activated)
+			assert.Equal(t, tt.expectedCalls, modifier.calls)
+		})
+	}
+}
+
+func TestUnitDataTransferTransferSnapshotDone(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, isDatatransferTransferSnapshotDone(datatransfer.TransferType_SNAPSHOT_ONLY, datatransfer.TransferStatus_DONE))
+	assert.False(t, isDatatransferTransferSnapshotDone(datatransfer.TransferType_SNAPSHOT_ONLY, datatransfer.TransferStatus_SNAPSHOTTING))
+	assert.False(t, isDatatransferTransferSnapshotDone(datatransfer.TransferType_SNAPSHOT_ONLY, datatransfer.TransferStatus_RUNNING))
+	assert.True(t, isDatatransferTransferSnapshotDone(datatransfer.TransferType_SNAPSHOT_AND_INCREMENT, datatransfer.TransferStatus_RUNNING))
+	assert.False(t, isDatatransferTransferSnapshotDone(datatransfer.TransferType_SNAPSHOT_AND_INCREMENT, datatransfer.TransferStatus_SNAPSHOTTING))
+	assert.True(t, isDatatransferTransferSnapshotDone(datatransfer.TransferType_INCREMENT_ONLY, datatransfer.TransferStatus_RUNNING))
+	assert.False(t, isDatatransferTransferSnapshotDone(datatransfer.TransferType_INCREMENT_ONLY, datatransfer.TransferStatus_STOPPED))
+}
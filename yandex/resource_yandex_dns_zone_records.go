@@ -0,0 +1,373 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
+)
+
+const (
+	yandexDnsZoneRecordsDefaultTimeout = 30 * time.Minute
+
+	// dnsZoneRecordsBatchSize limits the number of record sets changed by a single UpsertRecordSets call.
+	dnsZoneRecordsBatchSize = 1000
+)
+
+func resourceYandexDnsZoneRecords() *schema.Resource {
+	return &schema.Resource{
+		Description: "Authoritatively manages all DNS record sets of a DNS Zone within Yandex Cloud. Changes are applied in batches, so it is suitable for zones with thousands of records.\n\n" +
+			"~> Record sets of the zone which are not listed in the resource are **deleted**, including ones created outside of Terraform. Do not use it together with `yandex_dns_recordset` for the same zone.\n",
+		Create: resourceYandexDnsZoneRecordsCreate,
+		Read:   resourceYandexDnsZoneRecordsRead,
+		Update: resourceYandexDnsZoneRecordsUpdate,
+		Delete: resourceYandexDnsZoneRecordsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexDnsZoneRecordsDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexDnsZoneRecordsDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexDnsZoneRecordsDefaultTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the DNS Zone whose record sets are managed.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"recordset": {
+				Type:        schema.TypeSet,
+				Description: "A record set of the zone. Names without the trailing dot are relative to the zone.",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Description:  "The DNS name of the record set. Use `@` for the zone apex.",
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 254),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Description:  "The DNS record set type.",
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 20),
+							StateFunc: func(v interface{}) string {
+								return strings.ToUpper(v.(string))
+							},
+						},
+						"ttl": {
+							Type:         schema.TypeInt,
+							Description:  "The time-to-live of the record set (seconds).",
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 2147483647),
+						},
+						"data": {
+							Type:        schema.TypeSet,
+							Description: "The string data for the records in the record set.",
+							Required:    true,
+							MinItems:    1,
+							MaxItems:    100,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 1024),
+							},
+							Set: schema.HashString,
+						},
+					},
+				},
+			},
+
+			"ignore_apex_ns_soa": {
+				Type:        schema.TypeBool,
+				Description: "Do not manage the SOA and NS record sets of the zone apex, which are created by Cloud DNS. Default is `true`. These record sets are never deleted on destroy.",
+				Optional:    true,
+				Default:     true,
+			},
+		},
+	}
+}
+
+func resourceYandexDnsZoneRecordsCreate(d *schema.ResourceData, meta interface{}) error {
+	zoneID := d.Get("zone_id").(string)
+	ignoreApexNsSoa := d.Get("ignore_apex_ns_soa").(bool)
+	if err := applyDnsZoneRecords(d, meta, schema.TimeoutCreate, expandDnsZoneRecords(d), ignoreApexNsSoa); err != nil {
+		return err
+	}
+	d.SetId(zoneID)
+
+	return resourceYandexDnsZoneRecordsRead(d, meta)
+}
+
+func resourceYandexDnsZoneRecordsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sdk := getSDK(config)
+
+	if _, ok := d.GetOk("zone_id"); !ok {
+		// The resource is being imported.
+		d.Set("zone_id", d.Id())
+		d.Set("ignore_apex_ns_soa", true)
+	}
+
+	zone, err := sdk.DNS().DnsZone().Get(config.Context(), &dns.GetDnsZoneRequest{
+		DnsZoneId: d.Id(),
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("DnsZone %q", d.Id()))
+	}
+
+	current, err := readDnsZoneRecords(config.Context(), config, d.Id(), zone.Zone, d.Get("ignore_apex_ns_soa").(bool))
+	if err != nil {
+		return err
+	}
+
+	// Keep the names as they are written in the configuration.
+	names := make(map[string]string)
+	for _, raw := range d.Get("recordset").(*schema.Set).List() {
+		name := raw.(map[string]interface{})["name"].(string)
+		names[dnsRecordSetFQDN(name, zone.Zone)] = name
+	}
+
+	recordSets := make([]interface{}, 0, len(current))
+	for _, rs := range current {
+		name := rs.Name
+		if configured, ok := names[name]; ok {
+			name = configured
+		}
+		recordSets = append(recordSets, map[string]interface{}{
+			"name": name,
+			"type": rs.Type,
+			"ttl":  int(rs.Ttl),
+			"data": convertStringArrToInterface(rs.Data),
+		})
+	}
+
+	return d.Set("recordset", recordSets)
+}
+
+func resourceYandexDnsZoneRecordsUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChanges("recordset", "ignore_apex_ns_soa") {
+		ignoreApexNsSoa := d.Get("ignore_apex_ns_soa").(bool)
+		if err := applyDnsZoneRecords(d, meta, schema.TimeoutUpdate, expandDnsZoneRecords(d), ignoreApexNsSoa); err != nil {
+			return err
+		}
+	}
+
+	return resourceYandexDnsZoneRecordsRead(d, meta)
+}
+
+func resourceYandexDnsZoneRecordsDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Deleting record sets of DnsZone %q", d.Id())
+
+	// The SOA and NS record sets of the zone apex can not be deleted, so they are kept even when they are managed.
+	if err := applyDnsZoneRecords(d, meta, schema.TimeoutDelete, nil, true); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting record sets of DnsZone %q", d.Id())
+	return nil
+}
+
+func expandDnsZoneRecords(d *schema.ResourceData) []*dns.RecordSet {
+	var recordSets []*dns.RecordSet
+	for _, raw := range d.Get("recordset").(*schema.Set).List() {
+		rs := raw.(map[string]interface{})
+		recordSets = append(recordSets, &dns.RecordSet{
+			Name: rs["name"].(string),
+			Type: strings.ToUpper(rs["type"].(string)),
+			Ttl:  int64(rs["ttl"].(int)),
+			Data: convertStringSet(rs["data"].(*schema.Set)),
+		})
+	}
+	return recordSets
+}
+
+// applyDnsZoneRecords makes the record sets of the zone match desired using batched upserts.
+// The SOA and NS record sets of the zone apex are left as they are if ignoreApexNsSoa is set.
+func applyDnsZoneRecords(d *schema.ResourceData, meta interface{}, timeout string, desired []*dns.RecordSet, ignoreApexNsSoa bool) error {
+	config := meta.(*Config)
+	sdk := getSDK(config)
+	zoneID := d.Get("zone_id").(string)
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(timeout))
+	defer cancel()
+
+	zone, err := sdk.DNS().DnsZone().Get(ctx, &dns.GetDnsZoneRequest{
+		DnsZoneId: zoneID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error while requesting API to get DnsZone %q: %s", zoneID, err)
+	}
+
+	for _, rs := range desired {
+		rs.Name = dnsRecordSetFQDN(rs.Name, zone.Zone)
+	}
+	current, err := readDnsZoneRecords(ctx, config, zoneID, zone.Zone, ignoreApexNsSoa)
+	if err != nil {
+		return err
+	}
+
+	deletions, replacements := diffDnsRecordSets(current, desired)
+	for _, req := range batchDnsRecordSetUpserts(zoneID, deletions, replacements, dnsZoneRecordsBatchSize) {
+		log.Printf("[DEBUG] Upserting record sets of DnsZone %q: %d deletions, %d replacements",
+			zoneID, len(req.Deletions), len(req.Replacements))
+
+		op, err := sdk.WrapOperation(sdk.DNS().DnsZone().UpsertRecordSets(ctx, req))
+		if err != nil {
+			return fmt.Errorf("Error while requesting API to upsert record sets of DnsZone %q: %s", zoneID, err)
+		}
+		if err := op.Wait(ctx); err != nil {
+			return fmt.Errorf("Error upserting record sets of DnsZone %q: %s", zoneID, err)
+		}
+	}
+
+	return nil
+}
+
+// readDnsZoneRecords lists the record sets of the zone managed by the resource.
+func readDnsZoneRecords(ctx context.Context, config *Config, zoneID, zone string, ignoreApexNsSoa bool) ([]*dns.RecordSet, error) {
+	recordSets, err := listDnsZoneRecordSets(ctx, config, zoneID)
+	if err != nil {
+		return nil, err
+	}
+	if !ignoreApexNsSoa {
+		return recordSets, nil
+	}
+	return withoutApexNsSoa(recordSets, zone), nil
+}
+
+// withoutApexNsSoa filters out the SOA and NS record sets of the zone apex, which are created by Cloud DNS.
+func withoutApexNsSoa(recordSets []*dns.RecordSet, zone string) []*dns.RecordSet {
+	managed := recordSets[:0]
+	for _, rs := range recordSets {
+		if rs.Name == zone && (rs.Type == "SOA" || rs.Type == "NS") {
+			continue
+		}
+		managed = append(managed, rs)
+	}
+	return managed
+}
+
+func listDnsZoneRecordSets(ctx context.Context, config *Config, zoneID string) ([]*dns.RecordSet, error) {
+	var (
+		recordSets []*dns.RecordSet
+		pageToken  string
+	)
+	for {
+		resp, err := getSDK(config).DNS().DnsZone().ListRecordSets(ctx, &dns.ListDnsZoneRecordSetsRequest{
+			DnsZoneId: zoneID,
+			PageSize:  defaultListSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error listing record sets of DnsZone %q: %s", zoneID, err)
+		}
+		recordSets = append(recordSets, resp.RecordSets...)
+
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+	return recordSets, nil
+}
+
+// diffDnsRecordSets returns the record sets to delete from current and the record sets to replace
+// with desired ones. Record sets are matched by name and type.
+func diffDnsRecordSets(current, desired []*dns.RecordSet) (deletions, replacements []*dns.RecordSet) {
+	key := func(rs *dns.RecordSet) string {
+		return rs.Name + " " + strings.ToUpper(rs.Type)
+	}
+
+	desiredByKey := make(map[string]*dns.RecordSet, len(desired))
+	for _, rs := range desired {
+		desiredByKey[key(rs)] = rs
+	}
+	currentByKey := make(map[string]*dns.RecordSet, len(current))
+	for _, rs := range current {
+		currentByKey[key(rs)] = rs
+		if _, ok := desiredByKey[key(rs)]; !ok {
+			deletions = append(deletions, rs)
+		}
+	}
+	for _, rs := range desired {
+		if existing, ok := currentByKey[key(rs)]; ok && equalDnsRecordSets(existing, rs) {
+			continue
+		}
+		replacements = append(replacements, rs)
+	}
+
+	return deletions, replacements
+}
+
+func equalDnsRecordSets(a, b *dns.RecordSet) bool {
+	if a.Ttl != b.Ttl || len(a.Data) != len(b.Data) {
+		return false
+	}
+	aData := append([]string(nil), a.Data...)
+	bData := append([]string(nil), b.Data...)
+	sort.Strings(aData)
+	sort.Strings(bData)
+	for i := range aData {
+		if aData[i] != bData[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// batchDnsRecordSetUpserts splits the changes into requests of at most batchSize record sets each.
+func batchDnsRecordSetUpserts(zoneID string, deletions, replacements []*dns.RecordSet, batchSize int) []*dns.UpsertRecordSetsRequest {
+	var requests []*dns.UpsertRecordSetsRequest
+	current := &dns.UpsertRecordSetsRequest{DnsZoneId: zoneID}
+	size := 0
+
+	flush := func() {
+		if size > 0 {
+			requests = append(requests, current)
+			current = &dns.UpsertRecordSetsRequest{DnsZoneId: zoneID}
+			size = 0
+		}
+	}
+
+	for _, rs := range deletions {
+		current.Deletions = append(current.Deletions, rs)
+		if size++; size == batchSize {
+			flush()
+		}
+	}
+	for _, rs := range replacements {
+		current.Replacements = append(current.Replacements, rs)
+		if size++; size == batchSize {
+			flush()
+		}
+	}
+	flush()
+
+	return requests
+}
+
+// dnsRecordSetFQDN qualifies the name of a record set with the zone unless it ends with a dot.
+func dnsRecordSetFQDN(name, zone string) string {
+	switch {
+	case name == "@":
+		return zone
+	case strings.HasSuffix(name, "."):
+		return name
+	default:
+		return name + "." + zone
+	}
+}
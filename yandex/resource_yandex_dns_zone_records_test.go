@@ -0,0 +1,156 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
+)
+
+func TestDiffDnsRecordSets(t *testing.T) {
+	current := []*dns.RecordSet{
+		{Name: "a.example.com.", Type: "A", Ttl: 300, Data: []string{"192.0.2.1", "192.0.2.2"}},
+		{Name: "b.example.com.", Type: "A", Ttl: 300, Data: []string{"192.0.2.3"}},
+		{Name: "c.example.com.", Type: "TXT", Ttl: 300, Data: []string{"c"}},
+	}
+	desired := []*dns.RecordSet{
+		{Name: "a.example.com.", Type: "A", Ttl: 300, Data: []string{"192.0.2.2", "192.0.2.1"}},
+		{Name: "b.example.com.", Type: "A", Ttl: 600, Data: []string{"192.0.2.3"}},
+		{Name: "d.example.com.", Type: "A", Ttl: 300, Data: []string{"192.0.2.4"}},
+	}
+
+	deletions, replacements := diffDnsRecordSets(current, desired)
+	assert.Equal(t, []*dns.RecordSet{current[2]}, deletions)
+	assert.Equal(t, []*dns.RecordSet{desired[1], desired[2]}, replacements)
+}
+
+func TestBatchDnsRecordSetUpserts(t *testing.T) {
+	var deletions, replacements []*dns.RecordSet
+	for i := 0; i < 3; i++ {
+		deletions = append(deletions, &dns.RecordSet{Name: fmt.Sprintf("d%d.example.com.", i), Type: "A"})
+	}
+	for i := 0; i < 4; i++ {
+		replacements = append(replacements, &dns.RecordSet{Name: fmt.Sprintf("r%d.example.com.", i), Type: "A"})
+	}
+
+	requests := batchDnsRecordSetUpserts("zone", deletions, replacements, 3)
+	assert.Len(t, requests, 3)
+	assert.Equal(t, deletions, requests[0].Deletions)
+	assert.Empty(t, requests[0].Replacements)
+	assert.Equal(t, replacements[:3], requests[1].Replacements)
+	assert.Equal(t, replacements[3:], requests[2].Replacements)
+	for _, req := range requests {
+		assert.Equal(t, "zone", req.DnsZoneId)
+	}
+
+	assert.Empty(t, batchDnsRecordSetUpserts("zone", nil, nil, 3))
+}
+
+func TestWithoutApexNsSoa(t *testing.T) {
+	recordSets := []*dns.RecordSet{
+		{Name: "example.com.", Type: "SOA"},
+		{Name: "example.com.", Type: "NS"},
+		{Name: "example.com.", Type: "MX"},
+		{Name: "sub.example.com.", Type: "NS"},
+	}
+
+	assert.Equal(t, []*dns.RecordSet{
+		{Name: "example.com.", Type: "MX"},
+		{Name: "sub.example.com.", Type: "NS"},
+	}, withoutApexNsSoa(recordSets, "example.com."))
+}
+
+func TestExpandDnsZoneRecordsUpperCasesType(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceYandexDnsZoneRecords().Schema, map[string]interface{}{
+		"zone_id": "zone",
+		"recordset": []interface{}{
+			map[string]interface{}{
+				"name": "www",
+				"type": "cname",
+				"ttl":  300,
+				"data": []interface{}{"example.net."},
+			},
+		},
+	})
+
+	recordSets := expandDnsZoneRecords(d)
+	assert.Len(t, recordSets, 1)
+	assert.Equal(t, "CNAME", recordSets[0].Type)
+}
+
+func TestDnsRecordSetFQDN(t *testing.T) {
+	assert.Equal(t, "example.com.", dnsRecordSetFQDN("@", "example.com."))
+	assert.Equal(t, "www.example.com.", dnsRecordSetFQDN("www", "example.com."))
+	assert.Equal(t, "www.example.net.", dnsRecordSetFQDN("www.example.net.", "example.com."))
+}
+
+func TestAccDNSZoneRecords_basic(t *testing.T) {
+	t.Parallel()
+
+	zoneName := acctest.RandomWithPrefix("tf-dns-zone")
+	fqdn := acctest.RandomWithPrefix("tf-test") + ".dnstest.test."
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDnsZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSZoneRecordsConfig(zoneName, fqdn, "192.168.0.1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("yandex_dns_zone_records.records", "id", "yandex_dns_zone.zone1", "id"),
+					resource.TestCheckResourceAttr("yandex_dns_zone_records.records", "recordset.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("yandex_dns_zone_records.records", "recordset.*", map[string]string{
+						"name":   "srv." + fqdn,
+						"type":   "A",
+						"ttl":    "200",
+						"data.#": "1",
+					}),
+				),
+			},
+			{
+				Config: testAccDNSZoneRecordsConfig(zoneName, fqdn, "192.168.0.2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("yandex_dns_zone_records.records", "recordset.*.data.*", "192.168.0.2"),
+				),
+			},
+			{
+				ResourceName:      "yandex_dns_zone_records.records",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDNSZoneRecordsConfig(name, fqdn, address string) string {
+	return fmt.Sprintf(`
+resource "yandex_dns_zone" "zone1" {
+  name   = "%[1]s"
+  zone   = "%[2]s"
+  public = true
+}
+
+resource "yandex_dns_zone_records" "records" {
+  zone_id = yandex_dns_zone.zone1.id
+
+  recordset {
+    name = "srv.%[2]s"
+    type = "A"
+    ttl  = 200
+    data = ["%[3]s"]
+  }
+
+  recordset {
+    name = "www.%[2]s"
+    type = "CNAME"
+    ttl  = 300
+    data = ["srv.%[2]s"]
+  }
+}
+`, name, fqdn, address)
+}
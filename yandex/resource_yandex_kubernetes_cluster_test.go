@@ -130,9 +130,9 @@ func TestAccKubernetesClusterZonal_basic(t *testing.T) {
 	var cluster k8s.Cluster
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckKubernetesClusterDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckKubernetesClusterDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccKubernetesClusterZonalConfig_basic(clusterResource),
@@ -155,9 +155,9 @@ func TestAccKubernetesClusterZonalNoVersion_basic(t *testing.T) {
 	var cluster k8s.Cluster
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckKubernetesClusterDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckKubernetesClusterDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccKubernetesClusterZonalConfig_basic(clusterResource),
@@ -183,9 +183,9 @@ func TestAccKubernetesClusterZonalSecurityGroups_basic(t *testing.T) {
 	var cluster k8s.Cluster
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckKubernetesClusterDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckKubernetesClusterDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccKubernetesClusterZonalConfig_basic(clusterResource),
@@ -217,9 +217,9 @@ func TestAccKubernetesClusterZonalDailyMaintenance_basic(t *testing.T) {
 	var cluster k8s.Cluster
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckKubernetesClusterDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckKubernetesClusterDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccKubernetesClusterZonalConfig_basic(clusterResource),
@@ -241,9 +241,9 @@ func TestAccKubernetesClusterZonalWeeklyMaintenance_basic(t *testing.T) {
 	var cluster k8s.Cluster
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckKubernetesClusterDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckKubernetesClusterDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccKubernetesClusterZonalConfig_basic(clusterResource),
@@ -264,9 +264,9 @@ func TestAccKubernetesClusterRegional_basic(t *testing.T) {
 	var cluster k8s.Cluster
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckKubernetesClusterDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckKubernetesClusterDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccKubernetesClusterRegionalConfig_basic(clusterResource),
@@ -296,9 +296,9 @@ func TestAccKubernetesClusterRegional_externalIPv6Address(t *testing.T) {
 	defer mutexKV.Unlock(clusterResource.SubnetResourceNameC)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckKubernetesClusterDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckKubernetesClusterDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccKubernetesClusterRegionalConfig_basic(clusterResource),
@@ -354,9 +354,9 @@ func TestAccKubernetesClusterZonal_update(t *testing.T) {
 	var cluster k8s.Cluster
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckKubernetesClusterDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckKubernetesClusterDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccKubernetesClusterZonalConfig_basic(clusterResource),
@@ -445,9 +445,9 @@ func TestAccKubernetesClusterRegional_update(t *testing.T) {
 	var cluster k8s.Cluster
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckKubernetesClusterDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckKubernetesClusterDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccKubernetesClusterRegionalConfig_basic(clusterResource),
@@ -493,9 +493,9 @@ func TestAccKubernetesClusterZonal_networkImplementationCilium(t *testing.T) {
 	var cluster k8s.Cluster
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckKubernetesClusterDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckKubernetesClusterDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccKubernetesClusterZonalConfig_basic(clusterResource),
@@ -518,9 +518,9 @@ func TestAccKubernetesClusterZonal_masterLogging_defaultDestination(t *testing.T
 	var cluster k8s.Cluster
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckKubernetesClusterDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckKubernetesClusterDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccKubernetesClusterZonalConfig_basic(clusterResource),
@@ -542,9 +542,9 @@ func TestAccKubernetesClusterZonal_masterLogging_toPrecreatedLogGroup(t *testing
 	var cluster k8s.Cluster
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckKubernetesClusterDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckKubernetesClusterDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccKubernetesClusterZonalConfig_basic(clusterResource),
@@ -566,9 +566,9 @@ func TestAccKubernetesClusterRegional_masterLogging_defaultDestination(t *testin
 	var cluster k8s.Cluster
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckKubernetesClusterDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckKubernetesClusterDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccKubernetesClusterRegionalConfig_basic(clusterResource),
@@ -590,9 +590,9 @@ func TestAccKubernetesClusterRegional_masterLogging_toPrecreatedLogGroup(t *test
 	var cluster k8s.Cluster
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckKubernetesClusterDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckKubernetesClusterDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccKubernetesClusterRegionalConfig_basic(clusterResource),
@@ -102,9 +102,9 @@ func TestAccMDBElasticsearchCluster_basic(t *testing.T) {
 	elasticsearchDesc2 := "Elasticsearch Cluster Terraform Test Updated"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckMDBElasticsearchClusterDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckMDBElasticsearchClusterDestroy,
 		Steps: []resource.TestStep{
 			// Create Elasticsearch Cluster
 			{
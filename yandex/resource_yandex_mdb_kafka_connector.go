@@ -3,13 +3,13 @@ package yandex
 import (
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
 
 	"google.golang.org/genproto/protobuf/field_mask"
 )
@@ -241,7 +241,7 @@ func resourceYandexMDBKafkaConnectorCreate(d *schema.ResourceData, meta interfac
 		return fmt.Errorf("error while requesting API to create Kafka connector: %s", err)
 	}
 
-	conectorName := constructResourceId(req.ClusterId, req.ConnectorSpec.Name)
+	conectorName := resourceid.Construct(req.ClusterId, req.ConnectorSpec.Name)
 	d.SetId(conectorName)
 
 	err = op.Wait(ctx)
@@ -263,13 +263,11 @@ func resourceYandexMDBKafkaConnectorRead(d *schema.ResourceData, meta interface{
 	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
 	defer cancel()
 
-	parts := strings.SplitN(d.Id(), ":", 2)
-	if len(parts) != 2 {
-		return fmt.Errorf("invalid connector resource id format: %q", d.Id())
+	clusterID, connectorName, err := resourceid.Deconstruct(d.Id())
+	if err != nil {
+		return fmt.Errorf("invalid connector resource id format: %w", err)
 	}
 
-	clusterID := parts[0]
-	connectorName := parts[1]
 	conn, err := config.sdk.MDB().Kafka().Connector().Get(ctx, &kafka.GetConnectorRequest{
 		ClusterId:     clusterID,
 		ConnectorName: connectorName,
@@ -12,6 +12,7 @@ import (
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
 	"google.golang.org/genproto/protobuf/field_mask"
 )
 
@@ -108,7 +109,7 @@ func resourceYandexMDBKafkaTopicCreate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("error while requesting API to create Kafka topic: %s", err)
 	}
 
-	topicID := constructResourceId(req.ClusterId, req.TopicSpec.Name)
+	topicID := resourceid.Construct(req.ClusterId, req.TopicSpec.Name)
 	d.SetId(topicID)
 
 	err = op.Wait(ctx)
@@ -176,13 +177,11 @@ func resourceYandexMDBKafkaTopicRead(d *schema.ResourceData, meta interface{}) e
 	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
 	defer cancel()
 
-	parts := strings.SplitN(d.Id(), ":", 2)
-	if len(parts) != 2 {
-		return fmt.Errorf("invalid topic resource id format: %q", d.Id())
+	clusterID, topicName, err := resourceid.Deconstruct(d.Id())
+	if err != nil {
+		return fmt.Errorf("invalid topic resource id format: %w", err)
 	}
 
-	clusterID := parts[0]
-	topicName := parts[1]
 	topic, err := config.sdk.MDB().Kafka().Topic().Get(ctx, &kafka.GetTopicRequest{
 		ClusterId: clusterID,
 		TopicName: topicName,
@@ -10,7 +10,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
@@ -329,6 +332,34 @@ func TestAccMDBKafkaTopic(t *testing.T) {
 	})
 }
 
+func TestAccMDBKafkaTopic_identity(t *testing.T) {
+	t.Parallel()
+	clusterName := acctest.RandomWithPrefix("tf-kafka")
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBKafkaTopicConfigStep1(clusterName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("yandex_mdb_kafka_topic.events", map[string]knownvalue.Check{
+						"cluster_id": knownvalue.NotNull(),
+						"name":       knownvalue.StringExact("events"),
+					}),
+				},
+			},
+			{
+				ResourceName:    "yandex_mdb_kafka_topic.events",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
 func mdbKafkaTopicImportStep(name string) resource.TestStep {
 	return resource.TestStep{
 		ResourceName:      name,
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
 	"google.golang.org/genproto/protobuf/field_mask"
 )
 
@@ -83,7 +84,7 @@ func resourceYandexMDBKafkaUserCreate(d *schema.ResourceData, meta interface{})
 	if err = createKafkaUser(ctx, config, d, userSpec); err != nil {
 		return err
 	}
-	userID := constructResourceId(clusterID, userSpec.Name)
+	userID := resourceid.Construct(clusterID, userSpec.Name)
 	d.SetId(userID)
 	return resourceYandexMDBKafkaUserRead(d, meta)
 }
@@ -118,7 +119,7 @@ func resourceYandexMDBKafkaUserRead(d *schema.ResourceData, meta interface{}) er
 	config := meta.(*Config)
 	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
 	defer cancel()
-	clusterID, userName, err := deconstructResourceId(d.Id())
+	clusterID, userName, err := resourceid.Deconstruct(d.Id())
 	if err != nil {
 		return err
 	}
@@ -686,9 +686,9 @@ func TestAccMDBMongoDBCluster_6_0(t *testing.T) {
 	folderID := getExampleFolderID()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckVPCNetworkDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckVPCNetworkDestroy,
 		Steps: []resource.TestStep{
 			// Create MongoDB Cluster
 			{
@@ -1053,8 +1053,8 @@ func TestAccMDBMongoDBCluster_6_0NotShardedV0(t *testing.T) {
 		DiskTypeId:       s2Small26hdd.DiskTypeId,
 	}
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
 		CheckDestroy: resource.ComposeTestCheckFunc(
 			testAccCheckMDBMongoDBClusterDestroy,
 			testAccCheckVPCNetworkDestroy,
@@ -1144,8 +1144,8 @@ func TestAccMDBMongoDBCluster_6_0ShardedCfgV0(t *testing.T) {
 	}
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
 		CheckDestroy: resource.ComposeTestCheckFunc(
 			testAccCheckMDBMongoDBClusterDestroy,
 			testAccCheckVPCNetworkDestroy,
@@ -1286,8 +1286,8 @@ func TestAccMDBMongoDBCluster_6_0ShardedInfraV0(t *testing.T) {
 	}
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
 		CheckDestroy: resource.ComposeTestCheckFunc(
 			testAccCheckMDBMongoDBClusterDestroy,
 			testAccCheckVPCNetworkDestroy,
@@ -1371,8 +1371,8 @@ func TestAccMDBMongoDBCluster_6_0NotShardedV1(t *testing.T) {
 		DiskTypeId:       s2Small26hdd.DiskTypeId,
 	}
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
 		CheckDestroy: resource.ComposeTestCheckFunc(
 			testAccCheckMDBMongoDBClusterDestroy,
 			testAccCheckVPCNetworkDestroy,
@@ -1529,8 +1529,8 @@ func TestAccMDBMongoDBCluster_6_0ShardedCfgV1(t *testing.T) {
 	}
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
 		CheckDestroy: resource.ComposeTestCheckFunc(
 			testAccCheckMDBMongoDBClusterDestroy,
 			testAccCheckVPCNetworkDestroy,
@@ -1731,8 +1731,8 @@ func TestAccMDBMongoDBCluster_6_0ShardedInfraV1(t *testing.T) {
 	}
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
 		CheckDestroy: resource.ComposeTestCheckFunc(
 			testAccCheckMDBMongoDBClusterDestroy,
 			testAccCheckVPCNetworkDestroy,
@@ -1916,8 +1916,8 @@ func TestAccMDBMongoDBCluster_enableSharding(t *testing.T) {
 	}
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
 		CheckDestroy: resource.ComposeTestCheckFunc(
 			testAccCheckMDBMongoDBClusterDestroy,
 			testAccCheckVPCNetworkDestroy,
@@ -2010,8 +2010,8 @@ func TestAccMDBMongoDBCluster_restore(t *testing.T) {
 	folderID := getExampleFolderID()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
 		CheckDestroy: resource.ComposeTestCheckFunc(
 			testAccCheckMDBMongoDBClusterDestroy,
 			testAccCheckVPCNetworkDestroy,
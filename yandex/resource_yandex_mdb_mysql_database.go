@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mysql/v1"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
 )
 
 const (
@@ -72,7 +73,7 @@ func resourceYandexMDBMySQLDatabaseCreate(d *schema.ResourceData, meta interface
 		return config.sdk.MDB().MySQL().Database().Create(ctx, request)
 	})
 
-	databaseID := constructResourceId(request.ClusterId, request.DatabaseSpec.Name)
+	databaseID := resourceid.Construct(request.ClusterId, request.DatabaseSpec.Name)
 	d.SetId(databaseID)
 
 	if err != nil {
@@ -96,7 +97,7 @@ func resourceYandexMDBMySQLDatabaseRead(d *schema.ResourceData, meta interface{}
 	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
 	defer cancel()
 
-	clusterID, dbname, err := deconstructResourceId(d.Id())
+	clusterID, dbname, err := resourceid.Deconstruct(d.Id())
 	if err != nil {
 		return err
 	}
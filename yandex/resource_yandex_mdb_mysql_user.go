@@ -8,6 +8,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mysql/v1"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/writeonly"
 	"google.golang.org/genproto/protobuf/field_mask"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
@@ -40,7 +42,7 @@ func resourceYandexMDBMySQLUser() *schema.Resource {
 
 		SchemaVersion: 0,
 
-		Schema: map[string]*schema.Schema{
+		Schema: writeonly.SDKAddSchema(map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
 				Description: "The ID of the MySQL cluster.",
@@ -99,11 +101,11 @@ func resourceYandexMDBMySQLUser() *schema.Resource {
 			},
 			"generate_password": {
 				Type:        schema.TypeBool,
-				Description: "Generate password using Connection Manager. Allowed values: `true` or `false`. It's used only during user creation and is ignored during updating.\n\n~> **Must specify either password or generate_password**.\n",
+				Description: "Generate password using Connection Manager. Allowed values: `true` or `false`. It's used only during user creation and is ignored during updating.\n\n~> **Must specify either password, password_wo or generate_password**.\n",
 				Optional:    true,
 				Default:     false,
 			},
-		},
+		}, "password"),
 	}
 }
 
@@ -171,7 +173,7 @@ func resourceYandexMDBMySQLUserCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	if !isValidMySQLPasswordConfiguration(userSpec) {
-		return fmt.Errorf("must specify either password or generate_password")
+		return fmt.Errorf("must specify either password, password_wo or generate_password")
 	}
 
 	request := &mysql.CreateUserRequest{
@@ -183,7 +185,7 @@ func resourceYandexMDBMySQLUserCreate(d *schema.ResourceData, meta interface{})
 		return config.sdk.MDB().MySQL().User().Create(ctx, request)
 	})
 
-	userID := constructResourceId(clusterID, userSpec.Name)
+	userID := resourceid.Construct(clusterID, userSpec.Name)
 	d.SetId(userID)
 
 	if err != nil {
@@ -212,6 +214,12 @@ func expandMySQLUserSpec(d *schema.ResourceData) (*mysql.UserSpec, error) {
 		user.Password = v.(string)
 	}
 
+	password, err := writeonly.SDKResolve(d, "password", user.Password)
+	if err != nil {
+		return nil, err
+	}
+	user.Password = password
+
 	if v, ok := d.GetOk("permission"); ok {
 		permissions, err := expandMysqlUserPermissions(v.(*schema.Set))
 		if err != nil {
@@ -254,7 +262,7 @@ func resourceYandexMDBMySQLUserRead(d *schema.ResourceData, meta interface{}) er
 	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
 	defer cancel()
 
-	clusterID, username, err := deconstructResourceId(d.Id())
+	clusterID, username, err := resourceid.Deconstruct(d.Id())
 	if err != nil {
 		return err
 	}
@@ -299,7 +307,7 @@ func resourceYandexMDBMySQLUserUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	if !isValidMySQLPasswordConfiguration(user) {
-		return fmt.Errorf("must specify either password or generate_password")
+		return fmt.Errorf("must specify either password, password_wo or generate_password")
 	}
 
 	clusterID := d.Get("cluster_id").(string)
@@ -13,6 +13,7 @@ import (
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
 )
 
 const (
@@ -132,7 +133,7 @@ func resourceYandexMDBPostgreSQLDatabaseCreate(d *schema.ResourceData, meta inte
 		return config.sdk.MDB().PostgreSQL().Database().Create(ctx, request)
 	})
 
-	databaseID := constructResourceId(request.ClusterId, request.DatabaseSpec.Name)
+	databaseID := resourceid.Construct(request.ClusterId, request.DatabaseSpec.Name)
 	d.SetId(databaseID)
 
 	if err != nil {
@@ -191,7 +192,7 @@ func resourceYandexMDBPostgreSQLDatabaseRead(d *schema.ResourceData, meta interf
 	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
 	defer cancel()
 
-	clusterID, dbname, err := deconstructResourceId(d.Id())
+	clusterID, dbname, err := resourceid.Deconstruct(d.Id())
 	if err != nil {
 		return err
 	}
@@ -264,7 +265,7 @@ func resourceYandexMDBPostgreSQLDatabaseUpdate(d *schema.ResourceData, meta inte
 		return fmt.Errorf("updating database for PostgreSQL Cluster %q failed: %s", clusterID, err)
 	}
 
-	databaseID := constructResourceId(clusterID, newName.(string))
+	databaseID := resourceid.Construct(clusterID, newName.(string))
 	d.SetId(databaseID)
 	return resourceYandexMDBPostgreSQLDatabaseRead(d, meta)
 }
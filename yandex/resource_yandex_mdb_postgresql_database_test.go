@@ -22,8 +22,8 @@ func TestAccMDBPostgreSQLDatabase_full(t *testing.T) {
 	t.Parallel()
 	clusterName := acctest.RandomWithPrefix("tf-postgresql-database")
 	resource.Test(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
-		Providers: testAccProviders,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccMDBPostgreSQLDatabaseConfigStep1(clusterName),
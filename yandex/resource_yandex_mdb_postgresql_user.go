@@ -16,6 +16,8 @@ import (
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/writeonly"
 )
 
 const (
@@ -46,7 +48,7 @@ func resourceYandexMDBPostgreSQLUser() *schema.Resource {
 
 		SchemaVersion: 0,
 
-		Schema: map[string]*schema.Schema{
+		Schema: writeonly.SDKAddSchema(map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
 				Description: "The ID of the PostgreSQL cluster.",
@@ -127,11 +129,11 @@ func resourceYandexMDBPostgreSQLUser() *schema.Resource {
 			},
 			"generate_password": {
 				Type:        schema.TypeBool,
-				Description: "Generate password using Connection Manager. Allowed values: true or false. It's used only during user creation and is ignored during updating.\n\n~> **Must specify either password or generate_password**.\n",
+				Description: "Generate password using Connection Manager. Allowed values: true or false. It's used only during user creation and is ignored during updating.\n\n~> **Must specify either password, password_wo or generate_password**.\n",
 				Optional:    true,
 				Default:     false,
 			},
-		},
+		}, "password"),
 	}
 }
 
@@ -148,7 +150,7 @@ func resourceYandexMDBPostgreSQLUserCreate(d *schema.ResourceData, meta interfac
 	}
 
 	if !isValidPGPasswordConfiguration(userSpec) {
-		return fmt.Errorf("must specify either password or generate_password")
+		return fmt.Errorf("must specify either password, password_wo or generate_password")
 	}
 
 	request := &postgresql.CreateUserRequest{
@@ -160,7 +162,7 @@ func resourceYandexMDBPostgreSQLUserCreate(d *schema.ResourceData, meta interfac
 		return config.sdk.MDB().PostgreSQL().User().Create(ctx, request)
 	})
 
-	userID := constructResourceId(clusterID, userSpec.Name)
+	userID := resourceid.Construct(clusterID, userSpec.Name)
 	d.SetId(userID)
 
 	if err != nil {
@@ -188,6 +190,12 @@ func expandPgUserSpec(d *schema.ResourceData) (*postgresql.UserSpec, error) {
 		user.Password = v.(string)
 	}
 
+	password, err := writeonly.SDKResolve(d, "password", user.Password)
+	if err != nil {
+		return nil, err
+	}
+	user.Password = password
+
 	if v, ok := d.GetOkExists("login"); ok {
 		user.Login = &wrappers.BoolValue{Value: v.(bool)}
 	}
@@ -240,7 +248,7 @@ func resourceYandexMDBPostgreSQLUserRead(d *schema.ResourceData, meta interface{
 	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
 	defer cancel()
 
-	clusterID, username, err := deconstructResourceId(d.Id())
+	clusterID, username, err := resourceid.Deconstruct(d.Id())
 	if err != nil {
 		return err
 	}
@@ -291,7 +299,7 @@ func resourceYandexMDBPostgreSQLUserUpdate(d *schema.ResourceData, meta interfac
 	}
 
 	if !isValidPGPasswordConfiguration(user) {
-		return fmt.Errorf("must specify either password or generate_password")
+		return fmt.Errorf("must specify either password, password_wo or generate_password")
 	}
 
 	updatePath := []string{}
@@ -322,6 +330,10 @@ func resourceYandexMDBPostgreSQLUserUpdate(d *schema.ResourceData, meta interfac
 		}
 	}
 
+	if writeonly.SDKVersionChanged(d, "password") && !d.HasChange("password") {
+		updatePath = append(updatePath, "password")
+	}
+
 	if user.DeletionProtection != nil {
 		updatePath = append(updatePath, "deletion_protection")
 	}
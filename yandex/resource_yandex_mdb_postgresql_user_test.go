@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/stretchr/testify/assert"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
 )
@@ -26,8 +27,8 @@ func TestAccMDBPostgreSQLUser_full(t *testing.T) {
 	t.Parallel()
 	clusterName := acctest.RandomWithPrefix("tf-postgresql-user")
 	resource.Test(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
-		Providers: testAccProviders,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccMDBPostgreSQLUserConfigStep1(clusterName),
@@ -121,8 +122,8 @@ func TestAccMDBPostgreSQLUserIncorrectGrants(t *testing.T) {
 	t.Parallel()
 	clusterName := acctest.RandomWithPrefix("tf-postgresql-user")
 	resource.Test(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
-		Providers: testAccProviders,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccMDBPostgreSQLUserConfigStep0(clusterName) + `
@@ -144,6 +145,37 @@ resource "yandex_mdb_postgresql_user" "alice" {
 	})
 }
 
+// Test that a PostgreSQL User can be created and updated with write-only password
+func TestAccMDBPostgreSQLUser_passwordWO(t *testing.T) {
+	t.Parallel()
+	clusterName := acctest.RandomWithPrefix("tf-postgresql-user")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBPostgreSQLUserConfigPasswordWO(clusterName, "mysecureP@ssw0rd", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(pgUserResourceNameAlice, "name", "alice"),
+					resource.TestCheckNoResourceAttr(pgUserResourceNameAlice, "password"),
+					resource.TestCheckNoResourceAttr(pgUserResourceNameAlice, "password_wo"),
+					resource.TestCheckResourceAttr(pgUserResourceNameAlice, "password_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccMDBPostgreSQLUserConfigPasswordWO(clusterName, "myN3wsecureP@ssw0rd", 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(pgUserResourceNameAlice, "password_wo"),
+					resource.TestCheckResourceAttr(pgUserResourceNameAlice, "password_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func mdbPostgreSQLUserImportStep(name string) resource.TestStep {
 	return resource.TestStep{
 		ResourceName:      name,
@@ -485,3 +517,14 @@ resource "yandex_mdb_postgresql_user" "charlie" {
 	conn_limit = 0
 }`
 }
+
+// Create user with write-only password
+func testAccMDBPostgreSQLUserConfigPasswordWO(name, password string, version int) string {
+	return testAccMDBPostgreSQLUserConfigStep0(name) + fmt.Sprintf(`
+resource "yandex_mdb_postgresql_user" "alice" {
+	cluster_id          = yandex_mdb_postgresql_cluster.foo.id
+	name                = "alice"
+	password_wo         = "%s"
+	password_wo_version = %d
+}`, password, version)
+}
@@ -119,9 +119,9 @@ func TestAccMDBRedisCluster_full_networkssd(t *testing.T) {
 	for _, version := range []string{"7.2"} {
 		//updateVersion := "7.2"
 		resource.Test(t, resource.TestCase{
-			PreCheck:     func() { testAccPreCheck(t) },
-			Providers:    testAccProviders,
-			CheckDestroy: testAccCheckVPCNetworkDestroy,
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccMuxProviderFactories,
+			CheckDestroy:             testAccCheckVPCNetworkDestroy,
 			Steps: []resource.TestStep{
 				// Create Redis Cluster
 				{
@@ -287,9 +287,9 @@ func TestAccMDBRedisCluster_enable_sharding(t *testing.T) {
 	for _, version := range []string{"7.2"} {
 
 		resource.Test(t, resource.TestCase{
-			PreCheck:     func() { testAccPreCheck(t) },
-			Providers:    testAccProviders,
-			CheckDestroy: testAccCheckVPCNetworkDestroy,
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccMuxProviderFactories,
+			CheckDestroy:             testAccCheckVPCNetworkDestroy,
 			Steps: []resource.TestStep{
 				// Create Redis Cluster
 				{
@@ -389,9 +389,9 @@ func TestAccMDBRedisCluster_full_localssd(t *testing.T) {
 			announceHostnamesChanged = false
 		}
 		resource.Test(t, resource.TestCase{
-			PreCheck:     func() { testAccPreCheck(t) },
-			Providers:    testAccProviders,
-			CheckDestroy: testAccCheckVPCNetworkDestroy,
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccMuxProviderFactories,
+			CheckDestroy:             testAccCheckVPCNetworkDestroy,
 			Steps: []resource.TestStep{
 				// Create Redis Cluster
 				{
@@ -519,9 +519,9 @@ func TestAccMDBRedisCluster_sharded(t *testing.T) {
 
 	for _, version := range []string{"7.2"} {
 		resource.Test(t, resource.TestCase{
-			PreCheck:     func() { testAccPreCheck(t) },
-			Providers:    testAccProviders,
-			CheckDestroy: testAccCheckVPCNetworkDestroy,
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccMuxProviderFactories,
+			CheckDestroy:             testAccCheckVPCNetworkDestroy,
 			Steps: []resource.TestStep{
 				// Create Redis Cluster
 				{
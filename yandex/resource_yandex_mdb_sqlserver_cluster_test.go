@@ -100,9 +100,9 @@ func TestAccMDBSQLServerCluster_full(t *testing.T) {
 	folderID := getExampleFolderID()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckMDBSQLServerClusterDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckMDBSQLServerClusterDestroy,
 		Steps: []resource.TestStep{
 			//Create SQLServer Cluster
 			{
@@ -200,9 +200,9 @@ func TestAccMDBSQLServerCluster_ha2(t *testing.T) {
 	SQLServerDesc := "SQLServer Cluster Terraform Test 2 hosts"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckMDBSQLServerClusterDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckMDBSQLServerClusterDestroy,
 		Steps: []resource.TestStep{
 			//Create SQLServer Cluster
 			{
@@ -225,9 +225,9 @@ func TestAccMDBSQLServerCluster_ha3(t *testing.T) {
 	folderID := getExampleFolderID()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckMDBSQLServerClusterDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckMDBSQLServerClusterDestroy,
 		Steps: []resource.TestStep{
 			//Create SQLServer Cluster
 			{
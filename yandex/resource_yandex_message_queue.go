@@ -3,6 +3,7 @@ package yandex
 import (
 	"fmt"
 	"log"
+	"net/http"
 	"net/url"
 	"regexp"
 	"strconv"
@@ -18,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/iamtoken"
 )
 
 const defaultYMQRegion = "ru-central1"
@@ -138,12 +140,12 @@ func resourceYandexMessageQueue() *schema.Resource {
 			// Credentials
 			"access_key": {
 				Type:        schema.TypeString,
-				Description: "The [access key](https://yandex.cloud/docs/iam/operations/sa/create-access-key) to use when applying changes. If omitted, `ymq_access_key` specified in provider config is used. For more information see [documentation](https://yandex.cloud/docs/message-queue/quickstart).",
+				Description: "The [access key](https://yandex.cloud/docs/iam/operations/sa/create-access-key) to use when applying changes. If omitted, `ymq_access_key` specified in provider config is used. If neither is specified, the IAM token of the provider credentials is used. For more information see [documentation](https://yandex.cloud/docs/message-queue/quickstart).",
 				Optional:    true,
 			},
 			"secret_key": {
 				Type:        schema.TypeString,
-				Description: "The [secret key](https://yandex.cloud/docs/iam/operations/sa/create-access-key) to use when applying changes. If omitted, `ymq_secret_key` specified in provider config is used. For more information see [documentation](https://yandex.cloud/docs/message-queue/quickstart).",
+				Description: "The [secret key](https://yandex.cloud/docs/iam/operations/sa/create-access-key) to use when applying changes. If omitted, `ymq_secret_key` specified in provider config is used. If neither is specified, the IAM token of the provider credentials is used. For more information see [documentation](https://yandex.cloud/docs/message-queue/quickstart).",
 				Optional:    true,
 				Sensitive:   true,
 			},
@@ -684,7 +686,7 @@ func getKeysForYMQClient(d *schema.ResourceData, meta interface{}) (accessKey, s
 	} else { // Keys are in provider
 		providerConfig := meta.(*Config)
 		if providerConfig.YMQAccessKey == "" || providerConfig.YMQSecretKey == "" {
-			err = fmt.Errorf("Message queue access and secret keys should not be empty. They must be specified either in message queue resource or in provider")
+			log.Printf("[DEBUG] Message queue access and secret keys are not specified, use IAM token of the provider")
 			return
 		}
 		accessKey, secretKey = providerConfig.YMQAccessKey, providerConfig.YMQSecretKey
@@ -702,13 +704,29 @@ func newYMQClientConfigFromKeys(accessKey, secretKey string, providerConfig *Con
 	}
 }
 
+// newYMQClientConfigWithIAMToken returns config of the client authenticated with the cached IAM token of the provider.
+func newYMQClientConfigWithIAMToken(providerConfig *Config) *aws.Config {
+	return &aws.Config{
+		Credentials: credentials.AnonymousCredentials,
+		Endpoint:    aws.String(providerConfig.YMQEndpoint),
+		Region:      aws.String(providerConfig.Region),
+		HTTPClient: &http.Client{
+			Transport: iamtoken.NewTransport(providerConfig.getIAMToken),
+		},
+	}
+}
+
 func newYMQClientConfig(d *schema.ResourceData, meta interface{}) (config *aws.Config, err error) {
 	providerConfig := meta.(*Config)
 	accessKey, secretKey, err := getKeysForYMQClient(d, meta)
 	if err != nil {
 		return
 	}
-	config = newYMQClientConfigFromKeys(accessKey, secretKey, providerConfig)
+	if accessKey == "" {
+		config = newYMQClientConfigWithIAMToken(providerConfig)
+	} else {
+		config = newYMQClientConfigFromKeys(accessKey, secretKey, providerConfig)
+	}
 	if v, ok := d.GetOk("region_id"); ok {
 		log.Printf("[DEBUG] Use custom region: %s", v.(string))
 		config.WithRegion(v.(string))
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"regexp"
 	"testing"
@@ -12,13 +14,87 @@ import (
 	"golang.org/x/net/context"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/iamtoken"
 )
 
+func TestYMQClientConfig(t *testing.T) {
+	var authorization, subjectToken string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		subjectToken = r.Header.Get(iamtoken.SubjectTokenHeader)
+		fmt.Fprint(w, `<ListQueuesResponse><ListQueuesResult></ListQueuesResult></ListQueuesResponse>`)
+	}))
+	defer server.Close()
+
+	cases := []struct {
+		name             string
+		providerKeys     bool
+		raw              map[string]interface{}
+		wantCredentials  bool
+		wantSubjectToken string
+	}{
+		{
+			name:             "IAM token of the provider",
+			raw:              map[string]interface{}{"name": "queue"},
+			wantSubjectToken: "iam-token",
+		},
+		{
+			name:            "keys of the provider",
+			providerKeys:    true,
+			raw:             map[string]interface{}{"name": "queue"},
+			wantCredentials: true,
+		},
+		{
+			name: "keys of the resource",
+			raw: map[string]interface{}{
+				"name":       "queue",
+				"access_key": "resource-access-key",
+				"secret_key": "resource-secret-key",
+			},
+			wantCredentials: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			authorization, subjectToken = "", ""
+			providerConfig := &Config{
+				YMQEndpoint: server.URL,
+				Region:      "ru-central1",
+				iamTokenSource: iamtoken.NewSource(func(ctx context.Context) (string, time.Time, error) {
+					return "iam-token", time.Now().Add(12 * time.Hour), nil
+				}, iamtoken.DefaultRefreshMargin),
+			}
+			if tc.providerKeys {
+				providerConfig.YMQAccessKey = "provider-access-key"
+				providerConfig.YMQSecretKey = "provider-secret-key"
+			}
+
+			config, err := newYMQClientConfig(schema.TestResourceDataRaw(t, resourceYandexMessageQueue().Schema, tc.raw), providerConfig)
+			require.NoError(t, err)
+			if !tc.wantCredentials {
+				assert.Equal(t, credentials.AnonymousCredentials, config.Credentials)
+			}
+
+			svc, err := newYMQClientFromConfig(config)
+			require.NoError(t, err)
+			_, err = svc.ListQueues(&sqs.ListQueuesInput{})
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.wantCredentials, authorization != "")
+			assert.Equal(t, tc.wantSubjectToken, subjectToken)
+		})
+	}
+}
+
 func TestAccMessageQueue_basic(t *testing.T) {
 	var queueAttributes, tags map[string]*string
 
@@ -35,7 +35,7 @@ var storageClassSet = []string{
 
 func resourceYandexStorageBucket() *schema.Resource {
 	return &schema.Resource{
-		Description:   "Allows management of [Yandex Cloud Storage Bucket](https://yandex.cloud/docs/storage/concepts/bucket).\n\n~> By default, for authentication, you need to use [IAM token](https://yandex.cloud/docs/iam/concepts/authorization/iam-token) with the necessary permissions.\n\n~> Alternatively, you can provide [static access keys](https://yandex.cloud/docs/iam/concepts/authorization/access-key) (Access and Secret). To generate these keys, you will need a Service Account with the appropriate permissions.\n\n~> For extended API usage, such as setting the `max_size`, `folder_id`, `anonymous_access_flags`, `default_storage_class`, and `https` parameters for a bucket, only the default authorization method will be used. This means the `IAM` token from the `provider` block will be applied.\nThis can be confusing in cases where a separate service account is used for managing buckets because, in such scenarios,buckets may be accessed by two different accounts, each with potentially different permissions for the buckets.\n\n~> In case you are using IAM token from UserAccount, you are needed to explicitly specify `folder_id` in the resource, as it cannot be identified from such type of account. In case you are using IAM token from ServiceAccount or static access keys, `folder_id` does not need to be specified unless you want to create the resource in a different folder than the account folder.\n\n~> Terraform will import this resource with `force_destroy` set to `false` in state. If you've set it to `true` in config, run `terraform apply` to update the value set in state. If you delete this resource before updating the value, objects in the bucket will not be destroyed.\n",
+		Description:   "Allows management of [Yandex Cloud Storage Bucket](https://yandex.cloud/docs/storage/concepts/bucket).\n\n~> By default, for authentication, you need to use [IAM token](https://yandex.cloud/docs/iam/concepts/authorization/iam-token) with the necessary permissions.\n\n~> Alternatively, you can provide [static access keys](https://yandex.cloud/docs/iam/concepts/authorization/access-key) (Access and Secret). To generate these keys, you will need a Service Account with the appropriate permissions.\n\n~> For extended API usage, such as setting the `max_size`, `folder_id`, `anonymous_access_flags`, `default_storage_class`, and `https` parameters for a bucket, only the default authorization method will be used. This means the `IAM` token from the `provider` block will be applied.\nThis can be confusing in cases where a separate service account is used for managing buckets because, in such scenarios,buckets may be accessed by two different accounts, each with potentially different permissions for the buckets.\n\n~> In case you are using IAM token from UserAccount, you are needed to explicitly specify `folder_id` in the resource, as it cannot be identified from such type of account. In case you are using IAM token from ServiceAccount or static access keys, `folder_id` does not need to be specified unless you want to create the resource in a different folder than the account folder.\n\n~> Terraform will import this resource with `force_destroy` set to `false` in state. If you've set it to `true` in config, run `terraform apply` to update the value set in state. If you delete this resource before updating the value, objects in the bucket will not be destroyed.\n\n~> The `grant`, `policy`, `cors_rule`, `website`, `object_lock_configuration`, `logging`, `lifecycle_rule` and `server_side_encryption_configuration` arguments are refreshed only when they are set in the configuration, so they can be managed by standalone resources like `yandex_storage_bucket_policy` instead. Do not use both for the same bucket.\n",
 		CreateContext: resourceYandexStorageBucketCreate,
 		ReadContext:   resourceYandexStorageBucketRead,
 		UpdateContext: resourceYandexStorageBucketUpdate,
@@ -1077,6 +1077,13 @@ func resourceYandexStorageBucketReadBasic(ctx context.Context, d *schema.Resourc
 
 	bucketName := d.Id()
 	acl := d.Get("acl").(string)
+	// Configurations which are not set inline may be managed by standalone resources
+	// like yandex_storage_bucket_policy, so they are read only on import.
+	importing := d.Get("bucket").(string) == ""
+	inline := func(key string) bool {
+		_, ok := d.GetOk(key)
+		return importing || ok
+	}
 	bucket, err := s3Client.GetBucket(ctx, bucketName, config.StorageEndpoint, acl)
 	if err != nil {
 		if errors.Is(err, s3.ErrBucketNotFound) {
@@ -1092,50 +1099,66 @@ func resourceYandexStorageBucketReadBasic(ctx context.Context, d *schema.Resourc
 		d.Set("bucket", bucketName)
 	}
 	d.Set("bucket_domain_name", bucket.DomainName)
-	if err := d.Set("policy", bucket.Policy); err != nil {
-		return fmt.Errorf("error setting policy: %w", err)
+	if inline("policy") {
+		if err := d.Set("policy", bucket.Policy); err != nil {
+			return fmt.Errorf("error setting policy: %w", err)
+		}
 	}
-	if err := d.Set("cors_rule", bucket.CORSRules); err != nil {
-		return fmt.Errorf("error setting cors_rule: %w", err)
+	if inline("cors_rule") {
+		if err := d.Set("cors_rule", bucket.CORSRules); err != nil {
+			return fmt.Errorf("error setting cors_rule: %w", err)
+		}
 	}
 	if bucket.Website != nil {
-		if err := d.Set("website", bucket.Website.RawData); err != nil {
-			return fmt.Errorf("error setting website: %w", err)
-		}
 		if err := d.Set("website_endpoint", bucket.Website.Endpoint); err != nil {
 			return fmt.Errorf("error setting website_endpoint: %w", err)
 		}
 		if err := d.Set("website_domain", bucket.Website.Domain); err != nil {
 			return fmt.Errorf("error setting website_domain: %w", err)
 		}
-	} else {
-		if err := d.Set("website", nil); err != nil {
-			return fmt.Errorf("error resetting website: %w", err)
-		}
 	}
-	if bucket.Grants != nil {
-		if err := d.Set("grant", schema.NewSet(grantHash, bucket.Grants)); err != nil {
-			return fmt.Errorf("error setting Storage Bucket `grant` %w", err)
+	if inline("website") {
+		var website []map[string]interface{}
+		if bucket.Website != nil {
+			website = bucket.Website.RawData
 		}
-	} else {
-		if err := d.Set("grant", nil); err != nil {
-			return fmt.Errorf("error resetting Storage Bucket `grant` %w", err)
+		if err := d.Set("website", website); err != nil {
+			return fmt.Errorf("error setting website: %w", err)
+		}
+	}
+	if inline("grant") {
+		if bucket.Grants != nil {
+			if err := d.Set("grant", schema.NewSet(grantHash, bucket.Grants)); err != nil {
+				return fmt.Errorf("error setting Storage Bucket `grant` %w", err)
+			}
+		} else {
+			if err := d.Set("grant", nil); err != nil {
+				return fmt.Errorf("error resetting Storage Bucket `grant` %w", err)
+			}
 		}
 	}
 	if err := d.Set("versioning", bucket.Versioning); err != nil {
 		return fmt.Errorf("error setting versioning: %w", err)
 	}
-	if err := d.Set("object_lock_configuration", bucket.ObjectLock); err != nil {
-		return fmt.Errorf("error setting object lock configuration: %w", err)
+	if inline("object_lock_configuration") {
+		if err := d.Set("object_lock_configuration", bucket.ObjectLock); err != nil {
+			return fmt.Errorf("error setting object lock configuration: %w", err)
+		}
 	}
-	if err := d.Set("logging", bucket.Logging); err != nil {
-		return fmt.Errorf("error setting logging: %w", err)
+	if inline("logging") {
+		if err := d.Set("logging", bucket.Logging); err != nil {
+			return fmt.Errorf("error setting logging: %w", err)
+		}
 	}
-	if err := d.Set("lifecycle_rule", bucket.Lifecycle); err != nil {
-		return fmt.Errorf("error setting lifecycle_rule: %w", err)
+	if inline("lifecycle_rule") {
+		if err := d.Set("lifecycle_rule", bucket.Lifecycle); err != nil {
+			return fmt.Errorf("error setting lifecycle_rule: %w", err)
+		}
 	}
-	if err := d.Set("server_side_encryption_configuration", bucket.Encryption); err != nil {
-		return fmt.Errorf("error setting server_side_encryption_configuration: %w", err)
+	if inline("server_side_encryption_configuration") {
+		if err := d.Set("server_side_encryption_configuration", bucket.Encryption); err != nil {
+			return fmt.Errorf("error setting server_side_encryption_configuration: %w", err)
+		}
 	}
 	if err := d.Set("tags", s3.TagsToRaw(bucket.Tags)); err != nil {
 		return fmt.Errorf("error setting S3 Bucket tags: %w", err)
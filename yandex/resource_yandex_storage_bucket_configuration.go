@@ -0,0 +1,322 @@
+package yandex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/storage/s3"
+)
+
+// storageBucketConfiguration describes a part of the bucket configuration which is managed
+// by a standalone resource instead of the inline argument of yandex_storage_bucket.
+type storageBucketConfiguration struct {
+	// attribute is the name of the argument in both yandex_storage_bucket and the standalone resource.
+	attribute   string
+	description string
+	// computed lists the computed attributes of yandex_storage_bucket set by read.
+	computed []string
+	// read sets the configuration into d and reports whether it is present in the bucket.
+	read   func(ctx context.Context, s3Client *s3.Client, d *schema.ResourceData) (bool, error)
+	update func(ctx context.Context, s3Client *s3.Client, d *schema.ResourceData) error
+	delete func(ctx context.Context, s3Client *s3.Client, bucket string) error
+}
+
+const storageBucketConfigurationNote = "\n\n~> Do not use this resource together with the `%s` argument of `yandex_storage_bucket` for the same bucket. Otherwise, the resources will overwrite each other's configuration.\n"
+
+func resourceYandexStorageBucketPolicy() *schema.Resource {
+	return resourceYandexStorageBucketConfiguration(storageBucketConfiguration{
+		attribute:   "policy",
+		description: "Allows management of the [policy](https://yandex.cloud/docs/storage/concepts/policy) of a Yandex Cloud Storage Bucket.",
+		read: func(ctx context.Context, s3Client *s3.Client, d *schema.ResourceData) (bool, error) {
+			policy, err := s3Client.GetBucketPolicy(ctx, d.Id())
+			if err != nil {
+				return false, err
+			}
+			return policy != "", d.Set("policy", policy)
+		},
+		update: resourceYandexStorageBucketPolicyUpdate,
+		delete: func(ctx context.Context, s3Client *s3.Client, bucket string) error {
+			return s3Client.UpdateBucketPolicy(ctx, bucket, "")
+		},
+	})
+}
+
+func resourceYandexStorageBucketCORSConfiguration() *schema.Resource {
+	return resourceYandexStorageBucketConfiguration(storageBucketConfiguration{
+		attribute:   "cors_rule",
+		description: "Allows management of the [CORS configuration](https://yandex.cloud/docs/storage/concepts/cors) of a Yandex Cloud Storage Bucket.",
+		read: func(ctx context.Context, s3Client *s3.Client, d *schema.ResourceData) (bool, error) {
+			rules, err := s3Client.GetBucketCORSRules(ctx, d.Id())
+			if err != nil {
+				return false, err
+			}
+			return len(rules) > 0, d.Set("cors_rule", rules)
+		},
+		update: resourceYandexStorageBucketCORSUpdate,
+		delete: func(ctx context.Context, s3Client *s3.Client, bucket string) error {
+			return s3Client.UpdateBucketCORS(ctx, bucket, nil)
+		},
+	})
+}
+
+func resourceYandexStorageBucketLifecycleConfiguration() *schema.Resource {
+	return resourceYandexStorageBucketConfiguration(storageBucketConfiguration{
+		attribute:   "lifecycle_rule",
+		description: "Allows management of the [lifecycle configuration](https://yandex.cloud/docs/storage/concepts/lifecycles) of a Yandex Cloud Storage Bucket.",
+		read: func(ctx context.Context, s3Client *s3.Client, d *schema.ResourceData) (bool, error) {
+			rules, err := s3Client.GetBucketLifecycle(ctx, d.Id())
+			if err != nil {
+				return false, err
+			}
+			return len(rules) > 0, d.Set("lifecycle_rule", rules)
+		},
+		update: resourceYandexStorageBucketLifecycleUpdate,
+		delete: func(ctx context.Context, s3Client *s3.Client, bucket string) error {
+			return s3Client.UpdateBucketLifecycle(ctx, bucket, nil)
+		},
+	})
+}
+
+func resourceYandexStorageBucketWebsiteConfiguration() *schema.Resource {
+	return resourceYandexStorageBucketConfiguration(storageBucketConfiguration{
+		attribute:   "website",
+		description: "Allows management of the [static website hosting](https://yandex.cloud/docs/storage/concepts/hosting) configuration of a Yandex Cloud Storage Bucket.",
+		computed:    []string{"website_endpoint", "website_domain"},
+		read: func(ctx context.Context, s3Client *s3.Client, d *schema.ResourceData) (bool, error) {
+			website, err := s3Client.GetBucketWebsite(ctx, d.Id())
+			if err != nil || website == nil {
+				return false, err
+			}
+			d.Set("website_endpoint", website.Endpoint)
+			d.Set("website_domain", website.Domain)
+			return true, d.Set("website", website.RawData)
+		},
+		update: resourceYandexStorageBucketWebsiteUpdate,
+		delete: func(ctx context.Context, s3Client *s3.Client, bucket string) error {
+			return s3Client.UpdateBucketWebsite(ctx, bucket, nil)
+		},
+	})
+}
+
+func resourceYandexStorageBucketVersioning() *schema.Resource {
+	return resourceYandexStorageBucketConfiguration(storageBucketConfiguration{
+		attribute:   "versioning",
+		description: "Allows management of the [versioning](https://yandex.cloud/docs/storage/concepts/versioning) state of a Yandex Cloud Storage Bucket. Versioning is suspended when the resource is destroyed.",
+		read: func(ctx context.Context, s3Client *s3.Client, d *schema.ResourceData) (bool, error) {
+			versioning, err := s3Client.GetBucketVersioning(ctx, d.Id())
+			if err != nil {
+				return false, err
+			}
+			return true, d.Set("versioning", versioning)
+		},
+		update: resourceYandexStorageBucketVersioningUpdate,
+		delete: func(ctx context.Context, s3Client *s3.Client, bucket string) error {
+			return s3Client.UpdateBucketVersioning(ctx, bucket, s3.VersioningDisabled)
+		},
+	})
+}
+
+func resourceYandexStorageBucketLogging() *schema.Resource {
+	return resourceYandexStorageBucketConfiguration(storageBucketConfiguration{
+		attribute:   "logging",
+		description: "Allows management of the [action logging](https://yandex.cloud/docs/storage/concepts/server-logs) of a Yandex Cloud Storage Bucket.",
+		read: func(ctx context.Context, s3Client *s3.Client, d *schema.ResourceData) (bool, error) {
+			logging, err := s3Client.GetBucketLogging(ctx, d.Id())
+			if err != nil {
+				return false, err
+			}
+			return len(logging) > 0, d.Set("logging", logging)
+		},
+		update: resourceYandexStorageBucketLoggingUpdate,
+		delete: func(ctx context.Context, s3Client *s3.Client, bucket string) error {
+			return s3Client.UpdateBucketLogging(ctx, bucket, s3.LoggingStatus{Enabled: false})
+		},
+	})
+}
+
+func resourceYandexStorageBucketServerSideEncryptionConfiguration() *schema.Resource {
+	return resourceYandexStorageBucketConfiguration(storageBucketConfiguration{
+		attribute:   "server_side_encryption_configuration",
+		description: "Allows management of the [default encryption](https://yandex.cloud/docs/storage/concepts/encryption) of a Yandex Cloud Storage Bucket.",
+		read: func(ctx context.Context, s3Client *s3.Client, d *schema.ResourceData) (bool, error) {
+			encryption, err := s3Client.GetBucketServerSideEncryption(ctx, d.Id())
+			if err != nil {
+				return false, err
+			}
+			return len(encryption) > 0, d.Set("server_side_encryption_configuration", encryption)
+		},
+		update: resourceYandexStorageBucketServerSideEncryptionConfigurationUpdate,
+		delete: func(ctx context.Context, s3Client *s3.Client, bucket string) error {
+			return s3Client.UpdateBucketServerSideEncryption(ctx, bucket, nil)
+		},
+	})
+}
+
+func resourceYandexStorageBucketObjectLockConfiguration() *schema.Resource {
+	return resourceYandexStorageBucketConfiguration(storageBucketConfiguration{
+		attribute:   "object_lock_configuration",
+		description: "Allows management of the [object lock](https://yandex.cloud/docs/storage/concepts/object-lock) configuration of a Yandex Cloud Storage Bucket.\n\n~> Object lock cannot be disabled once it is enabled, so only the default retention rule is removed when the resource is destroyed.",
+		read: func(ctx context.Context, s3Client *s3.Client, d *schema.ResourceData) (bool, error) {
+			lock, err := s3Client.GetBucketObjectLock(ctx, d.Id())
+			if err != nil {
+				return false, err
+			}
+			return len(lock) > 0, d.Set("object_lock_configuration", lock)
+		},
+		update: resourceYandexStorageBucketObjectLockConfigurationUpdate,
+		delete: func(ctx context.Context, s3Client *s3.Client, bucket string) error {
+			return s3Client.UpdateBucketObjectLock(ctx, bucket, s3.ObjectLock{Enabled: true})
+		},
+	})
+}
+
+func resourceYandexStorageBucketGrant() *schema.Resource {
+	resource := resourceYandexStorageBucketConfiguration(storageBucketConfiguration{
+		attribute:   "grant",
+		description: "Allows management of the [ACL grants](https://yandex.cloud/docs/storage/concepts/acl) of a Yandex Cloud Storage Bucket. The ACL is reset to `private` when the resource is destroyed.\n\n~> To manage grants, service account with `storage.admin` role should be used.",
+		read: func(ctx context.Context, s3Client *s3.Client, d *schema.ResourceData) (bool, error) {
+			grants, err := s3Client.GetBucketGrants(ctx, d.Id(), "")
+			if err != nil {
+				return false, err
+			}
+			return len(grants) > 0, d.Set("grant", schema.NewSet(grantHash, grants))
+		},
+		update: resourceYandexStorageBucketGrantsUpdate,
+		delete: func(ctx context.Context, s3Client *s3.Client, bucket string) error {
+			return s3Client.UpdateBucketACL(ctx, bucket, s3.BucketACLPrivate)
+		},
+	})
+	resource.Schema["grant"].Description = "An [ACL policy grant](https://yandex.cloud/docs/storage/concepts/acl#permissions-types)."
+
+	return resource
+}
+
+func resourceYandexStorageBucketConfiguration(c storageBucketConfiguration) *schema.Resource {
+	bucketSchema := resourceYandexStorageBucket().Schema
+
+	attribute := bucketSchema[c.attribute]
+	attribute.Optional = false
+	attribute.Computed = false
+	attribute.Required = true
+	attribute.ConflictsWith = nil
+	if attribute.Type == schema.TypeList || attribute.Type == schema.TypeSet {
+		attribute.MinItems = 1
+	}
+
+	resourceSchema := map[string]*schema.Schema{
+		"bucket": {
+			Type:        schema.TypeString,
+			Description: "The name of the bucket.",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"access_key": bucketSchema["access_key"],
+		"secret_key": bucketSchema["secret_key"],
+		c.attribute:  attribute,
+	}
+	for _, name := range c.computed {
+		computed := bucketSchema[name]
+		computed.Optional = false
+		resourceSchema[name] = computed
+	}
+
+	return &schema.Resource{
+		Description: c.description + fmt.Sprintf(storageBucketConfigurationNote, c.attribute),
+
+		CreateContext: c.createContext,
+		ReadContext:   c.readContext,
+		UpdateContext: c.updateContext,
+		DeleteContext: c.deleteContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: resourceSchema,
+	}
+}
+
+func (c storageBucketConfiguration) createContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	s3Client, err := getS3Client(ctx, d, config)
+	if err != nil {
+		return diag.Errorf("error getting storage client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	if err := c.update(ctx, s3Client, d); err != nil {
+		return diag.Errorf("error setting %s of Storage Bucket (%s): %s", c.attribute, bucket, err)
+	}
+	d.SetId(bucket)
+
+	return c.readContext(ctx, d, meta)
+}
+
+func (c storageBucketConfiguration) readContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	s3Client, err := getS3Client(ctx, d, config)
+	if err != nil {
+		return diag.Errorf("error getting storage client: %s", err)
+	}
+
+	bucket := d.Id()
+	if err := s3Client.HeadBucket(ctx, bucket); err != nil {
+		if errors.Is(err, s3.ErrBucketNotFound) {
+			log.Printf("[WARN] Storage Bucket (%s) not found, removing %s from state", bucket, c.attribute)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	found, err := c.read(ctx, s3Client, d)
+	if err != nil {
+		return diag.Errorf("error reading %s of Storage Bucket (%s): %s", c.attribute, bucket, err)
+	}
+	if !found {
+		log.Printf("[WARN] Storage Bucket (%s) has no %s, removing from state", bucket, c.attribute)
+		d.SetId("")
+		return nil
+	}
+	d.Set("bucket", bucket)
+
+	return nil
+}
+
+func (c storageBucketConfiguration) updateContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	s3Client, err := getS3Client(ctx, d, config)
+	if err != nil {
+		return diag.Errorf("error getting storage client: %s", err)
+	}
+
+	if d.HasChange(c.attribute) {
+		if err := c.update(ctx, s3Client, d); err != nil {
+			return diag.Errorf("error updating %s of Storage Bucket (%s): %s", c.attribute, d.Id(), err)
+		}
+	}
+
+	return c.readContext(ctx, d, meta)
+}
+
+func (c storageBucketConfiguration) deleteContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	s3Client, err := getS3Client(ctx, d, config)
+	if err != nil {
+		return diag.Errorf("error getting storage client: %s", err)
+	}
+
+	if err := c.delete(ctx, s3Client, d.Id()); err != nil {
+		if s3.IsErr(err, s3.NoSuchBucket) {
+			return nil
+		}
+		return diag.Errorf("error deleting %s of Storage Bucket (%s): %s", c.attribute, d.Id(), err)
+	}
+
+	return nil
+}
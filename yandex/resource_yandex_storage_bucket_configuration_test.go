@@ -0,0 +1,206 @@
+package yandex
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsS3 "github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccStorageBucketPolicy_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	bucketName := "yandex_storage_bucket.test"
+	resourceName := "yandex_storage_bucket_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckStorageBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucketPolicyConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageBucketExists(bucketName),
+					testAccCheckStorageBucketPolicy(resourceName, testAccStorageBucketPolicy(rInt)),
+					resource.TestCheckResourceAttr(bucketName, "policy", ""),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"access_key", "secret_key"},
+			},
+			{
+				Config: testAccStorageBucketConfigurationBucketOnly(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageBucketPolicy(bucketName, ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStorageBucketCORSConfiguration_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "yandex_storage_bucket_cors_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckStorageBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucketCORSConfigurationConfig(rInt, "https://www.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					wrapWithRetries(testAccCheckStorageBucketCors(resourceName, []*awsS3.CORSRule{
+						{
+							AllowedMethods: []*string{aws.String("GET")},
+							AllowedOrigins: []*string{aws.String("https://www.example.com")},
+						},
+					})),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.#", "1"),
+				),
+			},
+			{
+				Config: testAccStorageBucketCORSConfigurationConfig(rInt, "https://www.example.ru"),
+				Check: resource.ComposeTestCheckFunc(
+					wrapWithRetries(testAccCheckStorageBucketCors(resourceName, []*awsS3.CORSRule{
+						{
+							AllowedMethods: []*string{aws.String("GET")},
+							AllowedOrigins: []*string{aws.String("https://www.example.ru")},
+						},
+					})),
+				),
+			},
+			{
+				Config: testAccStorageBucketConfigurationBucketOnly(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					wrapWithRetries(testAccCheckStorageBucketCors("yandex_storage_bucket.test", nil)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStorageBucketVersioning_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "yandex_storage_bucket_versioning.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckStorageBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucketVersioningConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageBucketVersioning(resourceName, awsS3.BucketVersioningStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "versioning.0.enabled", "true"),
+				),
+			},
+			{
+				Config: testAccStorageBucketConfigurationBucketOnly(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageBucketVersioning("yandex_storage_bucket.test", awsS3.BucketVersioningStatusSuspended),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStorageBucketWebsiteConfiguration_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "yandex_storage_bucket_website_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckStorageBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucketWebsiteConfigurationConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					wrapWithRetries(testAccCheckStorageBucketWebsite(resourceName, "index.html", "error.html", "", "")),
+					resource.TestCheckResourceAttr(resourceName, "website_endpoint", testAccWebsiteEndpoint(rInt)),
+					resource.TestCheckResourceAttr("yandex_storage_bucket.test", "website.#", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"access_key", "secret_key"},
+			},
+		},
+	})
+}
+
+func testAccStorageBucketConfigurationBucketOnly(randInt int) string {
+	return newBucketConfigBuilder(randInt).
+		asAdmin().
+		render()
+}
+
+func testAccStorageBucketPolicyConfig(randInt int) string {
+	return testAccStorageBucketConfigurationBucketOnly(randInt) + `
+resource "yandex_storage_bucket_policy" "test" {
+	bucket = yandex_storage_bucket.test.bucket
+
+	access_key = yandex_iam_service_account_static_access_key.sa-key.access_key
+	secret_key = yandex_iam_service_account_static_access_key.sa-key.secret_key
+
+	policy = ` + strconv.Quote(testAccStorageBucketPolicy(randInt)) + `
+}
+`
+}
+
+func testAccStorageBucketCORSConfigurationConfig(randInt int, origin string) string {
+	return testAccStorageBucketConfigurationBucketOnly(randInt) + `
+resource "yandex_storage_bucket_cors_configuration" "test" {
+	bucket = yandex_storage_bucket.test.bucket
+
+	access_key = yandex_iam_service_account_static_access_key.sa-key.access_key
+	secret_key = yandex_iam_service_account_static_access_key.sa-key.secret_key
+
+	cors_rule {
+		allowed_methods = ["GET"]
+		allowed_origins = [` + strconv.Quote(origin) + `]
+	}
+}
+`
+}
+
+func testAccStorageBucketVersioningConfig(randInt int) string {
+	return testAccStorageBucketConfigurationBucketOnly(randInt) + `
+resource "yandex_storage_bucket_versioning" "test" {
+	bucket = yandex_storage_bucket.test.bucket
+
+	access_key = yandex_iam_service_account_static_access_key.sa-key.access_key
+	secret_key = yandex_iam_service_account_static_access_key.sa-key.secret_key
+
+	versioning {
+		enabled = true
+	}
+}
+`
+}
+
+func testAccStorageBucketWebsiteConfigurationConfig(randInt int) string {
+	return testAccStorageBucketConfigurationBucketOnly(randInt) + `
+resource "yandex_storage_bucket_website_configuration" "test" {
+	bucket = yandex_storage_bucket.test.bucket
+
+	access_key = yandex_iam_service_account_static_access_key.sa-key.access_key
+	secret_key = yandex_iam_service_account_static_access_key.sa-key.secret_key
+
+	website {
+		index_document = "index.html"
+		error_document = "error.html"
+	}
+}
+`
+}
@@ -0,0 +1,610 @@
+package yandex
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"math"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mitchellh/go-homedir"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/storage/s3"
+)
+
+const (
+	yandexStorageBucketDirectoryDefaultTimeout = 30 * time.Minute
+	storageBucketDirectoryDefaultConcurrency   = 4
+	// contentSniffLength is the number of bytes used to detect content type of files with unknown extensions.
+	contentSniffLength = 512
+)
+
+func resourceYandexStorageBucketDirectory() *schema.Resource {
+	objectSchema := resourceYandexStorageObject().Schema
+
+	return &schema.Resource{
+		Description: "Synchronizes a local directory with objects in a [Yandex Cloud Storage Bucket](https://yandex.cloud/docs/storage/concepts/bucket), e.g. to deploy a [static website](https://yandex.cloud/docs/storage/concepts/hosting).\n\n" +
+			"Only files whose content hash differs from the uploaded one are uploaded. Content types of objects are detected by file extensions or, for unknown extensions, by file content. " +
+			"Objects of files removed from the directory are deleted. Other objects under `prefix` are deleted only if `delete_orphaned` is enabled.\n\n" +
+			"~> Objects modified out of band are not detected, only missing objects are uploaded again.\n",
+
+		CreateContext: resourceYandexStorageBucketDirectoryCreate,
+		ReadContext:   resourceYandexStorageBucketDirectoryRead,
+		UpdateContext: resourceYandexStorageBucketDirectoryUpdate,
+		DeleteContext: resourceYandexStorageBucketDirectoryDelete,
+
+		CustomizeDiff: resourceYandexStorageBucketDirectoryCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexStorageBucketDirectoryDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexStorageBucketDirectoryDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexStorageBucketDirectoryDefaultTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Description: objectSchema["bucket"].Description,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"prefix": {
+				Type:        schema.TypeString,
+				Description: "The prefix prepended to the relative paths of files to build object keys, e.g. `site/`. By default, files are uploaded to the root of the bucket.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"source_dir": {
+				Type:        schema.TypeString,
+				Description: "The path to the local directory to upload.",
+				Required:    true,
+			},
+			"include": {
+				Type:        schema.TypeList,
+				Description: "Glob patterns of the file paths relative to `source_dir` to upload, e.g. `**/*.html`. `**` matches any number of directories. By default, all files are uploaded.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateStorageBucketDirectoryPattern,
+				},
+			},
+			"exclude": {
+				Type:        schema.TypeList,
+				Description: "Glob patterns of the file paths relative to `source_dir` which are not uploaded even if they match `include`.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateStorageBucketDirectoryPattern,
+				},
+			},
+			"cache_control": {
+				Type:        schema.TypeList,
+				Description: "Sets `Cache-Control` of objects by patterns of the file paths. The first matching rule is applied.",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pattern": {
+							Type:             schema.TypeString,
+							Description:      "Glob pattern of the file paths relative to `source_dir`.",
+							Required:         true,
+							ValidateDiagFunc: validateStorageBucketDirectoryPattern,
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Description: "The value of `Cache-Control`, e.g. `public, max-age=31536000, immutable`.",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"acl": {
+				Type:         schema.TypeString,
+				Description:  "The [predefined ACL](https://yandex.cloud/docs/storage/concepts/acl#predefined_acls) to apply to the objects. Defaults to `private`.",
+				Optional:     true,
+				Default:      "private",
+				ValidateFunc: validation.StringInSlice(bucketACLAllowedValues, false),
+			},
+			"delete_orphaned": {
+				Type:        schema.TypeBool,
+				Description: "Deletes objects under `prefix` which do not correspond to files of the directory. Use it with care when `prefix` is not set, since all other objects of the bucket are deleted.",
+				Optional:    true,
+				Default:     false,
+			},
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Description:  "The number of files uploaded in parallel.",
+				Optional:     true,
+				Default:      storageBucketDirectoryDefaultConcurrency,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			// Credentials
+			"access_key": {
+				Type:        schema.TypeString,
+				Description: objectSchema["access_key"].Description,
+				Optional:    true,
+			},
+			"secret_key": {
+				Type:        schema.TypeString,
+				Description: objectSchema["secret_key"].Description,
+				Optional:    true,
+				Sensitive:   true,
+			},
+
+			// Computed
+			"files": {
+				Type:        schema.TypeMap,
+				Description: "The MD5 hashes of the uploaded files by their paths relative to `source_dir`.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func validateStorageBucketDirectoryPattern(v interface{}, path cty.Path) diag.Diagnostics {
+	if !doublestar.ValidatePattern(v.(string)) {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("invalid glob pattern %q", v),
+			AttributePath: path,
+		}}
+	}
+	return nil
+}
+
+// storageBucketDirectoryFile is a local file of the synchronized directory.
+type storageBucketDirectoryFile struct {
+	// Path is the path relative to the directory with slash separators, it is the object key without prefix.
+	Path         string
+	MD5          string
+	ContentType  string
+	CacheControl string
+}
+
+type storageBucketDirectoryCacheControl struct {
+	Pattern string
+	Value   string
+}
+
+type storageBucketDirectoryConfig struct {
+	SourceDir    string
+	Include      []string
+	Exclude      []string
+	CacheControl []storageBucketDirectoryCacheControl
+}
+
+func expandStorageBucketDirectoryConfig(d interface{ Get(string) interface{} }) storageBucketDirectoryConfig {
+	config := storageBucketDirectoryConfig{
+		SourceDir: d.Get("source_dir").(string),
+		Include:   expandStringSlice(d.Get("include").([]interface{})),
+		Exclude:   expandStringSlice(d.Get("exclude").([]interface{})),
+	}
+	for _, raw := range d.Get("cache_control").([]interface{}) {
+		rule := raw.(map[string]interface{})
+		config.CacheControl = append(config.CacheControl, storageBucketDirectoryCacheControl{
+			Pattern: rule["pattern"].(string),
+			Value:   rule["value"].(string),
+		})
+	}
+	return config
+}
+
+// matches reports whether the file should be uploaded according to include and exclude patterns.
+func (c *storageBucketDirectoryConfig) matches(path string) bool {
+	if len(c.Include) > 0 && !matchAnyPattern(c.Include, path) {
+		return false
+	}
+	return !matchAnyPattern(c.Exclude, path)
+}
+
+func (c *storageBucketDirectoryConfig) cacheControl(path string) string {
+	for _, rule := range c.CacheControl {
+		if matchAnyPattern([]string{rule.Pattern}, path) {
+			return rule.Value
+		}
+	}
+	return ""
+}
+
+func matchAnyPattern(patterns []string, path string) bool {
+	for _, pattern := range patterns {
+		// Patterns are validated in the schema.
+		if ok, _ := doublestar.Match(pattern, path); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// scan walks the source directory and returns the files to upload by their relative paths.
+func (c *storageBucketDirectoryConfig) scan() (map[string]*storageBucketDirectoryFile, error) {
+	root, err := homedir.Expand(c.SourceDir)
+	if err != nil {
+		return nil, fmt.Errorf("error expanding homedir in source_dir (%s): %w", c.SourceDir, err)
+	}
+
+	files := make(map[string]*storageBucketDirectoryFile)
+	err = filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if !c.matches(rel) {
+			return nil
+		}
+
+		file := &storageBucketDirectoryFile{
+			Path:         rel,
+			CacheControl: c.cacheControl(rel),
+		}
+		if file.MD5, file.ContentType, err = hashStorageBucketDirectoryFile(path); err != nil {
+			return err
+		}
+		files[rel] = file
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading source_dir (%s): %w", c.SourceDir, err)
+	}
+	return files, nil
+}
+
+// hashStorageBucketDirectoryFile returns the MD5 hash of the file content and its content type. The content type
+// is detected by the file extension and, if the extension is unknown, by the first bytes of the content.
+func hashStorageBucketDirectoryFile(path string) (string, string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", "", err
+	}
+	defer file.Close()
+
+	head := make([]byte, contentSniffLength)
+	n, err := io.ReadFull(file, head)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return "", "", err
+	}
+	head = head[:n]
+
+	hash := md5.New()
+	hash.Write(head)
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", "", err
+	}
+
+	contentType := mime.TypeByExtension(filepath.Ext(path))
+	if contentType == "" {
+		contentType = http.DetectContentType(head)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), contentType, nil
+}
+
+func storageBucketDirectoryHashes(files map[string]*storageBucketDirectoryFile) map[string]string {
+	hashes := make(map[string]string, len(files))
+	for path, file := range files {
+		hashes[path] = file.MD5
+	}
+	return hashes
+}
+
+func resourceYandexStorageBucketDirectoryCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"source_dir", "include", "exclude", "cache_control"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("files")
+		}
+	}
+
+	config := expandStorageBucketDirectoryConfig(d)
+	files, err := config.scan()
+	if err != nil {
+		return err
+	}
+
+	hashes := storageBucketDirectoryHashes(files)
+	old := make(map[string]string)
+	for path, hash := range d.Get("files").(map[string]interface{}) {
+		old[path] = hash.(string)
+	}
+	if reflect.DeepEqual(old, hashes) {
+		return nil
+	}
+	return d.SetNew("files", hashes)
+}
+
+func resourceYandexStorageBucketDirectoryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	s3Client, err := getS3Client(ctx, d, config)
+	if err != nil {
+		return diag.Errorf("error getting storage client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+	d.SetId(bucket + "/" + prefix)
+
+	directory := expandStorageBucketDirectoryConfig(d)
+	files, err := directory.scan()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	uploaded, err := uploadStorageBucketDirectoryFiles(ctx, d, s3Client, directory, files)
+	d.Set("files", storageBucketDirectoryHashes(uploaded))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("delete_orphaned").(bool) {
+		if err := deleteStorageBucketDirectoryOrphans(ctx, s3Client, bucket, prefix, files); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceYandexStorageBucketDirectoryRead(ctx, d, meta)
+}
+
+func resourceYandexStorageBucketDirectoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	s3Client, err := getS3Client(ctx, d, config)
+	if err != nil {
+		return diag.Errorf("error getting storage client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+	remote, err := listStorageBucketDirectoryKeys(ctx, s3Client, bucket, prefix)
+	if err != nil {
+		if s3.IsErr(err, s3.NoSuchBucket) {
+			log.Printf("[WARN] Storage bucket (%s) not found, removing directory from state", bucket)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	// Missing objects are removed from the state to be uploaded again, and orphaned objects
+	// are added with empty hashes to be deleted.
+	files := make(map[string]string)
+	for path, hash := range d.Get("files").(map[string]interface{}) {
+		if remote[path] {
+			files[path] = hash.(string)
+		}
+	}
+	if d.Get("delete_orphaned").(bool) {
+		for path := range remote {
+			if _, ok := files[path]; !ok {
+				files[path] = ""
+			}
+		}
+	}
+
+	if err := d.Set("files", files); err != nil {
+		return diag.Errorf("error setting files: %s", err)
+	}
+	return nil
+}
+
+func resourceYandexStorageBucketDirectoryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	s3Client, err := getS3Client(ctx, d, config)
+	if err != nil {
+		return diag.Errorf("error getting storage client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+
+	directory := expandStorageBucketDirectoryConfig(d)
+	files, err := directory.scan()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	oldRaw, _ := d.GetChange("files")
+	old := oldRaw.(map[string]interface{})
+
+	// Changes of ACL and Cache-Control are applied by uploading all files again.
+	uploadAll := d.HasChanges("acl", "cache_control")
+	changed := make(map[string]*storageBucketDirectoryFile)
+	for path, file := range files {
+		if uploadAll || old[path] != file.MD5 {
+			changed[path] = file
+		}
+	}
+	var orphans []string
+	for path := range old {
+		if _, ok := files[path]; !ok {
+			orphans = append(orphans, prefix+path)
+		}
+	}
+
+	uploaded, uploadErr := uploadStorageBucketDirectoryFiles(ctx, d, s3Client, directory, changed)
+	hashes := make(map[string]string)
+	for path, hash := range old {
+		if _, ok := changed[path]; !ok {
+			hashes[path] = hash.(string)
+		}
+	}
+	for path, file := range uploaded {
+		hashes[path] = file.MD5
+	}
+	if uploadErr == nil {
+		sort.Strings(orphans)
+		if err := s3Client.DeleteObjects(ctx, bucket, orphans); err != nil {
+			uploadErr = err
+		} else {
+			for _, key := range orphans {
+				delete(hashes, strings.TrimPrefix(key, prefix))
+			}
+		}
+	}
+	d.Set("files", hashes)
+	if uploadErr != nil {
+		return diag.FromErr(uploadErr)
+	}
+
+	return resourceYandexStorageBucketDirectoryRead(ctx, d, meta)
+}
+
+func resourceYandexStorageBucketDirectoryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	s3Client, err := getS3Client(ctx, d, config)
+	if err != nil {
+		return diag.Errorf("error getting storage client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+
+	keys := make([]string, 0, len(d.Get("files").(map[string]interface{})))
+	for path := range d.Get("files").(map[string]interface{}) {
+		keys = append(keys, prefix+path)
+	}
+	sort.Strings(keys)
+
+	if err := s3Client.DeleteObjects(ctx, bucket, keys); err != nil {
+		if s3.IsErr(err, s3.NoSuchBucket) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// uploadStorageBucketDirectoryFiles uploads the files with at most `upload_concurrency` parallel uploads.
+// It returns the files uploaded successfully, even if some uploads failed.
+func uploadStorageBucketDirectoryFiles(
+	ctx context.Context,
+	d *schema.ResourceData,
+	client *s3.Client,
+	directory storageBucketDirectoryConfig,
+	files map[string]*storageBucketDirectoryFile,
+) (map[string]*storageBucketDirectoryFile, error) {
+	root, err := homedir.Expand(directory.SourceDir)
+	if err != nil {
+		return nil, fmt.Errorf("error expanding homedir in source_dir (%s): %w", directory.SourceDir, err)
+	}
+
+	// ResourceData is not safe for concurrent use, so the attributes are read before the uploads start.
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+	acl := d.Get("acl").(string)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+		uploaded = make(map[string]*storageBucketDirectoryFile, len(files))
+		slots    = make(chan struct{}, d.Get("upload_concurrency").(int))
+	)
+	for _, file := range files {
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(file *storageBucketDirectoryFile) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			log.Printf("[DEBUG] Uploading %s to storage object %q", file.Path, prefix+file.Path)
+			_, err := client.CreateObject(ctx, s3.CreationData{
+				Source: &s3.Source{
+					Type:  s3.SourceTypeFile,
+					Value: filepath.Join(root, filepath.FromSlash(file.Path)),
+				},
+				Bucket:      bucket,
+				Key:         prefix + file.Path,
+				ACL:         acl,
+				ContentType: file.ContentType,
+				// Files are uploaded in parallel, so parts of each file are uploaded sequentially.
+				Upload:         s3.UploadOptions{Concurrency: 1},
+				ObjectMetadata: s3.ObjectMetadata{CacheControl: file.CacheControl},
+			})
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = fmt.Errorf("error uploading %s: %w", file.Path, err)
+				}
+				cancel()
+				return
+			}
+			uploaded[file.Path] = file
+		}(file)
+	}
+	wg.Wait()
+
+	return uploaded, firstErr
+}
+
+// listStorageBucketDirectoryKeys returns the keys of objects under the prefix with the prefix trimmed.
+func listStorageBucketDirectoryKeys(ctx context.Context, client *s3.Client, bucket, prefix string) (map[string]bool, error) {
+	list, err := client.ListObjects(ctx, s3.ListObjectsInput{
+		Bucket:  bucket,
+		Prefix:  prefix,
+		MaxKeys: math.MaxInt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]bool, len(list.Objects))
+	for _, object := range list.Objects {
+		keys[strings.TrimPrefix(object.Key, prefix)] = true
+	}
+	return keys, nil
+}
+
+func deleteStorageBucketDirectoryOrphans(
+	ctx context.Context,
+	client *s3.Client,
+	bucket, prefix string,
+	files map[string]*storageBucketDirectoryFile,
+) error {
+	remote, err := listStorageBucketDirectoryKeys(ctx, client, bucket, prefix)
+	if err != nil {
+		return err
+	}
+
+	var orphans []string
+	for path := range remote {
+		if _, ok := files[path]; !ok {
+			orphans = append(orphans, prefix+path)
+		}
+	}
+	sort.Strings(orphans)
+	return client.DeleteObjects(ctx, bucket, orphans)
+}
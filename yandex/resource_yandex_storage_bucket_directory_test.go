@@ -0,0 +1,121 @@
+package yandex
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testAccStorageBucketDirectoryWriteFiles(t *testing.T, dir string, files map[string]string) {
+	for path, content := range files {
+		path = filepath.Join(dir, filepath.FromSlash(path))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+}
+
+func TestStorageBucketDirectoryScan(t *testing.T) {
+	dir := t.TempDir()
+	testAccStorageBucketDirectoryWriteFiles(t, dir, map[string]string{
+		"index.html":         "<html></html>",
+		"assets/app.js":      "console.log(1)",
+		"assets/app.js.map":  "{}",
+		"assets/img/logo":    "\x89PNG\r\n\x1a\n",
+		"drafts/index.html":  "draft",
+		"assets/style.css":   "body {}",
+		"assets/fonts/a.txt": "font",
+	})
+
+	config := storageBucketDirectoryConfig{
+		SourceDir: dir,
+		Include:   []string{"*.html", "assets/**"},
+		Exclude:   []string{"**/*.map", "assets/fonts/**"},
+		CacheControl: []storageBucketDirectoryCacheControl{
+			{Pattern: "assets/**/*.{js,css}", Value: "max-age=31536000"},
+			{Pattern: "**", Value: "no-cache"},
+		},
+	}
+	files, err := config.scan()
+	require.NoError(t, err)
+
+	paths := make([]string, 0, len(files))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	assert.ElementsMatch(t, []string{"index.html", "assets/app.js", "assets/img/logo", "assets/style.css"}, paths)
+
+	assert.Equal(t, "text/html; charset=utf-8", files["index.html"].ContentType)
+	assert.Equal(t, "text/css; charset=utf-8", files["assets/style.css"].ContentType)
+	assert.Equal(t, "image/png", files["assets/img/logo"].ContentType)
+
+	assert.Equal(t, "max-age=31536000", files["assets/app.js"].CacheControl)
+	assert.Equal(t, "no-cache", files["index.html"].CacheControl)
+
+	// md5 of "<html></html>"
+	assert.Equal(t, "c83301425b2ad1d496473a5ff3d9ecca", files["index.html"].MD5)
+}
+
+func TestAccStorageBucketDirectory_basic(t *testing.T) {
+	resourceName := "yandex_storage_bucket_directory.test"
+	rInt := acctest.RandInt()
+	dir := t.TempDir()
+	testAccStorageBucketDirectoryWriteFiles(t, dir, map[string]string{
+		"index.html":    "<html></html>",
+		"assets/app.js": "console.log(1)",
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckStorageObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucketDirectoryConfig(rInt, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "files.index.html"),
+					resource.TestCheckResourceAttrSet(resourceName, "files.assets/app.js"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccStorageBucketDirectoryWriteFiles(t, dir, map[string]string{"index.html": "<html>v2</html>"})
+					require.NoError(t, os.Remove(filepath.Join(dir, "assets", "app.js")))
+				},
+				Config: testAccStorageBucketDirectoryConfig(rInt, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "1"),
+					resource.TestCheckNoResourceAttr(resourceName, "files.assets/app.js"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStorageBucketDirectoryConfig(randInt int, dir string) string {
+	bucketConfig := newBucketConfigBuilder(randInt).asEditor().render()
+
+	return bucketConfig + fmt.Sprintf(`
+resource "yandex_storage_bucket_directory" "test" {
+	bucket = yandex_storage_bucket.test.bucket
+	prefix = "site/"
+
+	access_key = yandex_iam_service_account_static_access_key.sa-key.access_key
+	secret_key = yandex_iam_service_account_static_access_key.sa-key.secret_key
+
+	source_dir      = %[1]q
+	delete_orphaned = true
+
+	cache_control {
+		pattern = "**/*.js"
+		value   = "max-age=3600"
+	}
+}
+`, dir)
+}
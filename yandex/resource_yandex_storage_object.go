@@ -3,12 +3,14 @@ package yandex
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"strings"
 	"time"
 
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/storage/s3"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -68,7 +70,7 @@ func resourceYandexStorageObject() *schema.Resource {
 
 			"source_hash": {
 				Type:        schema.TypeString,
-				Description: "Used to trigger object update when the source content changes. So the only meaningful value is `filemd5(\"path/to/source\"). The value is only stored in state and not saved by Yandex Storage.",
+				Description: "Used to trigger object update when the source content changes. So the only meaningful value is `filemd5(\"path/to/source\"). The value is only stored in state and not saved by Yandex Storage. Unless it is set, changes of the `source` file are also detected by comparing its checksum with `etag` of the object, which reads the whole file on every plan.",
 				Optional:    true,
 			},
 
@@ -86,6 +88,20 @@ func resourceYandexStorageObject() *schema.Resource {
 				ConflictsWith: []string{"source", "content"},
 			},
 
+			"part_size": {
+				Type:         schema.TypeInt,
+				Description:  "The size of parts in bytes to upload the object content with. The content is streamed from `source`, so objects larger than the part size are uploaded with [multipart upload](https://yandex.cloud/docs/storage/concepts/multipart) and take about `part_size` * `upload_concurrency` bytes of memory. Default is 16 MiB. The part size is increased if the object does not fit into 10000 parts. Objects larger than 5 GiB are copied in parts of the same size to update their metadata.",
+				Optional:     true,
+				ValidateFunc: Int64Between(s3.MinPartSize, s3.MaxPartSize),
+			},
+
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Description:  "The number of parts uploaded in parallel with multipart upload. Default is `4`.",
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
 			"content_type": {
 				Type:        schema.TypeString,
 				Description: "A standard MIME type describing the format of the object data, e.g. `application/octet-stream`. All Valid MIME Types are valid for this input.",
@@ -93,6 +109,61 @@ func resourceYandexStorageObject() *schema.Resource {
 				Computed:    true,
 			},
 
+			"cache_control": {
+				Type:        schema.TypeString,
+				Description: "Specifies caching behavior along the request/reply chain, see [Cache-Control](https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Cache-Control).",
+				Optional:    true,
+			},
+
+			"content_disposition": {
+				Type:        schema.TypeString,
+				Description: "Specifies presentational information for the object, see [Content-Disposition](https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Content-Disposition).",
+				Optional:    true,
+			},
+
+			"content_encoding": {
+				Type:        schema.TypeString,
+				Description: "Specifies what content encodings have been applied to the object, e.g. `gzip`, see [Content-Encoding](https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Content-Encoding).",
+				Optional:    true,
+			},
+
+			"content_language": {
+				Type:        schema.TypeString,
+				Description: "The language the content is in, e.g. `en-US`, see [Content-Language](https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Content-Language).",
+				Optional:    true,
+			},
+
+			"expires": {
+				Type:             schema.TypeString,
+				Description:      "The date and time in RFC3339 format at which the object is no longer cacheable, see [Expires](https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Expires).",
+				Optional:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: shouldSuppressDiffForEqualTimes,
+			},
+
+			"metadata": {
+				Type:             schema.TypeMap,
+				Description:      "A map of user-defined metadata stored with the object in `x-amz-meta-*` headers. Keys must be in lower case.",
+				Optional:         true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				ValidateDiagFunc: validateStorageObjectMetadataKeys,
+			},
+
+			"storage_class": {
+				Type:         schema.TypeString,
+				Description:  "The [storage class](https://yandex.cloud/docs/storage/concepts/storage-class) of the object. One of `STANDARD`, `COLD` or `ICE`. Defaults to the default storage class of the bucket.",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(s3.ObjectStorageClassValues, false),
+			},
+
+			"kms_key_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the [KMS key](https://yandex.cloud/docs/kms/concepts/key) to encrypt the object with. Defaults to the encryption key of the bucket.",
+				Optional:    true,
+				Computed:    true,
+			},
+
 			"object_lock_legal_hold_status": {
 				Type:         schema.TypeString,
 				Description:  "Specifies a [legal hold status](https://yandex.cloud/docs/storage/concepts/object-lock#types) of an object. Requires `object_lock_configuration` to be enabled on a bucket.",
@@ -119,7 +190,21 @@ func resourceYandexStorageObject() *schema.Resource {
 				ValidateFunc: validation.IsRFC3339Time,
 			},
 			"tags": tagsSchema(),
+
+			"etag": {
+				Type:        schema.TypeString,
+				Description: "The entity tag of the object. If the object is overwritten out of band and its ETag does not match the content of `source`, `content` or `content_base64`, the content is uploaded again. The `source` file is not compared when `source_hash` is set.",
+				Computed:    true,
+			},
+
+			"version_id": {
+				Type:        schema.TypeString,
+				Description: "The version of the object if [versioning](https://yandex.cloud/docs/storage/concepts/versioning) is enabled for the bucket.",
+				Computed:    true,
+			},
 		},
+
+		CustomizeDiff: resourceYandexStorageObjectCustomizeDiff,
 	}
 }
 
@@ -130,48 +215,16 @@ func resourceYandexStorageObjectCreate(ctx context.Context, d *schema.ResourceDa
 		return diag.Errorf("error getting storage client: %s", err)
 	}
 
-	data := s3.CreationData{
-		Bucket: d.Get("bucket").(string),
-		Key:    d.Get("key").(string),
-		ACL:    d.Get("acl").(string),
+	data, err := expandStorageObjectCreationData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	if v, ok := d.GetOk("source"); ok {
-		data.Source = &s3.Source{
-			Type:  s3.SourceTypeFile,
-			Value: v.(string),
-		}
-	} else if v, ok := d.GetOk("content"); ok {
-		data.Source = &s3.Source{
-			Type:  s3.SourceTypeContent,
-			Value: v.(string),
-		}
-	} else if v, ok := d.GetOk("content_base64"); ok {
-		data.Source = &s3.Source{
-			Type:  s3.SourceTypeContentBase64,
-			Value: v.(string),
-		}
-	} else {
+	data.Source = expandStorageObjectSource(d)
+	if data.Source == nil {
 		return diag.Errorf("\"source\", \"content\", or \"content_base64\" field must be specified")
 	}
 
-	if v, ok := d.GetOk("content_type"); ok {
-		data.ContentType = v.(string)
-	}
-	if v, ok := d.GetOk("object_lock_legal_hold_status"); ok {
-		data.ObjectLockLegalHoldStatus = v.(string)
-	}
-	if v, ok := d.GetOk("object_lock_mode"); ok {
-		mode := v.(string)
-		untilDate, err := time.Parse(time.RFC3339, d.Get("object_lock_retain_until_date").(string))
-		if err != nil {
-			return diag.Errorf("error parsing object_lock_retain_until_date: %s", err)
-		}
-		data.ObjectRetention = &s3.ObjectRetention{
-			Mode:            mode,
-			RetainUntilDate: untilDate,
-		}
-	}
 	if v, ok := d.GetOk("tags"); ok {
 		data.Tags = s3.NewTags(v)
 	}
@@ -219,6 +272,23 @@ func resourceYandexStorageObjectRead(ctx context.Context, d *schema.ResourceData
 		d.Set("object_lock_mode", object.ObjectRetention.Mode)
 		d.Set("object_lock_retain_until_date", object.ObjectRetention.RetainUntilDate.Format(time.RFC3339))
 	}
+	d.Set("cache_control", object.CacheControl)
+	d.Set("content_disposition", object.ContentDisposition)
+	d.Set("content_encoding", object.ContentEncoding)
+	d.Set("content_language", object.ContentLanguage)
+	if object.Expires != nil {
+		d.Set("expires", object.Expires.Format(time.RFC3339))
+	} else {
+		d.Set("expires", "")
+	}
+	if err := d.Set("metadata", object.Metadata); err != nil {
+		return diag.Errorf("error setting S3 Storage Object metadata: %s", err)
+	}
+	d.Set("storage_class", object.StorageClass)
+	d.Set("kms_key_id", object.KMSKeyID)
+	d.Set("etag", object.ETag)
+	d.Set("version_id", object.VersionID)
+
 	err = d.Set("tags", s3.TagsToRaw(object.Tags))
 	if err != nil {
 		return diag.Errorf("error setting S3 Storage Object Tagging: %s", err)
@@ -244,6 +314,13 @@ func resourceYandexStorageObjectUpdate(ctx context.Context, d *schema.ResourceDa
 		return diag.Errorf("error getting storage client: %s", err)
 	}
 
+	// The copy of the object replaces its ACL and object lock settings too, so it is done before their handlers.
+	if d.HasChanges(storageObjectMetadataKeys...) {
+		if err := resourceYandexStorageObjectMetadataUpdate(ctx, s3Client, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	for name, handler := range changeHandlers {
 		if !d.HasChange(name) {
 			continue
@@ -269,14 +346,190 @@ func hasObjectContentChanged(d *schema.ResourceData) bool {
 		"source_hash",
 		"content",
 		"content_base64",
-		"content_type",
 	} {
 		if d.HasChange(key) {
 			return true
 		}
 	}
 
-	return false
+	// ETag changes without metadata changes only if the object has been overwritten out of band,
+	// see resourceYandexStorageObjectCustomizeDiff.
+	return d.HasChange("etag") && !d.HasChanges(storageObjectMetadataKeys...)
+}
+
+// expandStorageObjectSource returns the source of the object content or nil if it is not specified.
+func expandStorageObjectSource(d interface {
+	GetOk(string) (interface{}, bool)
+}) *s3.Source {
+	if v, ok := d.GetOk("source"); ok {
+		return &s3.Source{
+			Type:  s3.SourceTypeFile,
+			Value: v.(string),
+		}
+	}
+	if v, ok := d.GetOk("content"); ok {
+		return &s3.Source{
+			Type:  s3.SourceTypeContent,
+			Value: v.(string),
+		}
+	}
+	if v, ok := d.GetOk("content_base64"); ok {
+		return &s3.Source{
+			Type:  s3.SourceTypeContentBase64,
+			Value: v.(string),
+		}
+	}
+	return nil
+}
+
+// resourceYandexStorageObjectCustomizeDiff detects content of the object overwritten out of band.
+// ETag of the object is compared with the checksum of the source calculated the way the source is uploaded.
+func resourceYandexStorageObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	// Both the new content and the copy of the object made to update its metadata change its ETag and version.
+	if d.HasChanges(storageObjectMetadataKeys...) {
+		return setStorageObjectVersionComputed(d)
+	}
+	for _, key := range []string{"source", "source_hash", "content", "content_base64"} {
+		if !d.NewValueKnown(key) || d.HasChange(key) {
+			return setStorageObjectVersionComputed(d)
+		}
+	}
+
+	etag := d.Get("etag").(string)
+	source := expandStorageObjectSource(d)
+	// ETags of objects encrypted with KMS keys are not MD5 of the content.
+	if etag == "" || source == nil || d.Get("kms_key_id").(string) != "" {
+		return nil
+	}
+	// Hashing of a large file on every plan is avoided when its changes are tracked by source_hash.
+	if source.Type == s3.SourceTypeFile && d.Get("source_hash").(string) != "" {
+		return nil
+	}
+
+	// The part size the object has been uploaded with is compared, since it defines the ETag of multipart uploads.
+	partSize, _ := d.GetChange("part_size")
+	checksum, err := source.Checksum(s3.UploadOptions{PartSize: int64(partSize.(int))})
+	if err != nil {
+		log.Printf("[WARN] Unable to calculate checksum of storage object %q source: %s", d.Get("key").(string), err)
+		return nil
+	}
+	if checksum.Matches(etag) {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Storage object %q has ETag %s, expected %s, its content will be uploaded again", d.Get("key").(string), etag, checksum.ETag)
+	return setStorageObjectVersionComputed(d)
+}
+
+func setStorageObjectVersionComputed(d *schema.ResourceDiff) error {
+	if err := d.SetNewComputed("etag"); err != nil {
+		return err
+	}
+	return d.SetNewComputed("version_id")
+}
+
+// storageObjectMetadataKeys are attributes updated by copying the object to itself.
+var storageObjectMetadataKeys = []string{
+	"content_type",
+	"cache_control",
+	"content_disposition",
+	"content_encoding",
+	"content_language",
+	"expires",
+	"metadata",
+	"storage_class",
+	"kms_key_id",
+}
+
+// expandStorageObjectCreationData returns attributes of the object except its content.
+func expandStorageObjectCreationData(d *schema.ResourceData) (s3.CreationData, error) {
+	data := s3.CreationData{
+		Bucket:      d.Get("bucket").(string),
+		Key:         d.Get("key").(string),
+		ACL:         d.Get("acl").(string),
+		ContentType: d.Get("content_type").(string),
+		ObjectMetadata: s3.ObjectMetadata{
+			CacheControl:       d.Get("cache_control").(string),
+			ContentDisposition: d.Get("content_disposition").(string),
+			ContentEncoding:    d.Get("content_encoding").(string),
+			ContentLanguage:    d.Get("content_language").(string),
+			StorageClass:       d.Get("storage_class").(string),
+			KMSKeyID:           d.Get("kms_key_id").(string),
+		},
+		// The part size is used by the multipart copy of large objects too, so the copy keeps the ETag.
+		Upload: s3.UploadOptions{
+			PartSize:    int64(d.Get("part_size").(int)),
+			Concurrency: d.Get("upload_concurrency").(int),
+		},
+	}
+
+	if v, ok := d.GetOk("expires"); ok {
+		expires, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return data, fmt.Errorf("error parsing expires: %w", err)
+		}
+		data.Expires = &expires
+	}
+	if v, ok := d.GetOk("metadata"); ok {
+		data.Metadata = make(map[string]string)
+		for k, v := range v.(map[string]interface{}) {
+			data.Metadata[k] = v.(string)
+		}
+	}
+	if v, ok := d.GetOk("object_lock_legal_hold_status"); ok {
+		data.ObjectLockLegalHoldStatus = v.(string)
+	}
+	if v, ok := d.GetOk("object_lock_mode"); ok {
+		untilDate, err := time.Parse(time.RFC3339, d.Get("object_lock_retain_until_date").(string))
+		if err != nil {
+			return data, fmt.Errorf("error parsing object_lock_retain_until_date: %w", err)
+		}
+		data.ObjectRetention = &s3.ObjectRetention{
+			Mode:            v.(string),
+			RetainUntilDate: untilDate,
+		}
+	}
+	return data, nil
+}
+
+func validateStorageObjectMetadataKeys(v interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for k := range v.(map[string]interface{}) {
+		if k != strings.ToLower(k) {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Invalid metadata key",
+				Detail:        fmt.Sprintf("Metadata key %q must be in lower case, since the storage returns keys of user-defined metadata in lower case.", k),
+				AttributePath: path,
+			})
+		}
+	}
+	return diags
+}
+
+// shouldSuppressDiffForEqualTimes suppresses diff of RFC3339 times in different time zones.
+func shouldSuppressDiffForEqualTimes(_, old, new string, _ *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return oldTime.Equal(newTime)
+}
+
+func resourceYandexStorageObjectMetadataUpdate(ctx context.Context, s3Client *s3.Client, d *schema.ResourceData) error {
+	data, err := expandStorageObjectCreationData(d)
+	if err != nil {
+		return err
+	}
+	return s3Client.UpdateObjectMetadata(ctx, data)
 }
 
 func resourceYandexStorageObjectACLUpdate(ctx context.Context, s3Client *s3.Client, d *schema.ResourceData) error {
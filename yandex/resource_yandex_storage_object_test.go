@@ -267,6 +267,73 @@ func TestAccStorageObject_updateAcl(t *testing.T) {
 	})
 }
 
+func TestAccStorageObject_contentDrift(t *testing.T) {
+	var obj awsS3.GetObjectOutput
+	rInt := acctest.RandInt()
+	resourceName := "yandex_storage_object.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckStorageObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageObjectConfigContent(rInt, "some_bucket_content"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageObjectExists(resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "etag", "3aa092e6f0fe468e376603aaeb32b5b8"),
+					testAccStorageObjectOverwrite(resourceName, "changed out of band"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccStorageObjectConfigContent(rInt, "some_bucket_content"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageObjectExists(resourceName, &obj),
+					testAccCheckStorageObjectBody(&obj, "some_bucket_content"),
+					resource.TestCheckResourceAttr(resourceName, "etag", "3aa092e6f0fe468e376603aaeb32b5b8"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStorageObject_updateMetadata(t *testing.T) {
+	var obj awsS3.GetObjectOutput
+	rInt := acctest.RandInt()
+	resourceName := "yandex_storage_object.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckStorageObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageObjectConfigMetadata(rInt, "max-age=60", "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageObjectExists(resourceName, &obj),
+					testAccCheckStorageObjectBody(&obj, "some-content"),
+					resource.TestCheckResourceAttr(resourceName, "cache_control", "max-age=60"),
+					resource.TestCheckResourceAttr(resourceName, "content_disposition", "attachment; filename=\"test.txt\""),
+					resource.TestCheckResourceAttr(resourceName, "content_language", "en-US"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.build", "v1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class", "COLD"),
+				),
+			},
+			{
+				Config: testAccStorageObjectConfigMetadata(rInt, "no-cache", "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageObjectExists(resourceName, &obj),
+					testAccCheckStorageObjectBody(&obj, "some-content"),
+					resource.TestCheckResourceAttr(resourceName, "cache_control", "no-cache"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.build", "v2"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class", "COLD"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccStorageObject_ObjectLockNone(t *testing.T) {
 	var obj awsS3.GetObjectOutput
 	rInt := acctest.RandInt()
@@ -448,6 +515,33 @@ func testAccCheckStorageObjectExists(n string, obj *awsS3.GetObjectOutput) resou
 	}
 }
 
+// testAccStorageObjectOverwrite replaces content of the object bypassing Terraform.
+func testAccStorageObjectOverwrite(n, content string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		s3Client, err := getS3ClientByKeys(
+			context.TODO(),
+			rs.Primary.Attributes["access_key"],
+			rs.Primary.Attributes["secret_key"],
+			testAccProvider.Meta().(*Config),
+		)
+		if err != nil {
+			return err
+		}
+
+		_, err = s3Client.S3().PutObject(&awsS3.PutObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(rs.Primary.Attributes["key"]),
+			Body:   strings.NewReader(content),
+		})
+		return err
+	}
+}
+
 func testAccCheckStorageObjectBody(obj *awsS3.GetObjectOutput, want string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		body, err := ioutil.ReadAll(obj.Body)
@@ -680,6 +774,33 @@ resource "yandex_storage_object" "test" {
 	return bucketConfig + objectConfig
 }
 
+func testAccStorageObjectConfigMetadata(randInt int, cacheControl, build string) string {
+	bucketConfig := newBucketConfigBuilder(randInt).asEditor().render()
+
+	objectConfig := fmt.Sprintf(`
+resource "yandex_storage_object" "test" {
+	bucket = "${yandex_storage_bucket.test.bucket}"
+
+	access_key = yandex_iam_service_account_static_access_key.sa-key.access_key
+	secret_key = yandex_iam_service_account_static_access_key.sa-key.secret_key
+
+	key     = "test-key"
+	content = "some-content"
+
+	cache_control       = "%[1]s"
+	content_disposition = "attachment; filename=\"test.txt\""
+	content_language    = "en-US"
+	storage_class       = "COLD"
+
+	metadata = {
+		build = "%[2]s"
+	}
+}
+`, cacheControl, build)
+
+	return bucketConfig + objectConfig
+}
+
 func testAccStorageObjectAclPreConfig(randInt int) string {
 	bucketConfig := newBucketConfigBuilder(randInt).asAdmin().render()
 
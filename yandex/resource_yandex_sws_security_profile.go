@@ -2,6 +2,7 @@ package yandex
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"regexp"
@@ -37,6 +38,8 @@ func resourceYandexSmartwebsecuritySecurityProfile() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceYandexSmartwebsecuritySecurityProfileCustomizeDiff,
+
 		SchemaVersion: 1,
 
 		Schema: map[string]*schema.Schema{
@@ -1296,6 +1299,33 @@ func resourceYandexSmartwebsecuritySecurityProfile() *schema.Resource {
 	}
 }
 
+func resourceYandexSmartwebsecuritySecurityProfileCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	return validateSmartwebsecuritySecurityRulePriorities(diff.Get("security_rule").([]interface{}), diff.NewValueKnown)
+}
+
+// validateSmartwebsecuritySecurityRulePriorities checks that the security rules have distinct priorities,
+// since the API rejects the profile otherwise. The rules without a priority or with an unknown one are skipped.
+func validateSmartwebsecuritySecurityRulePriorities(rules []interface{}, known func(key string) bool) error {
+	var errs []error
+	byPriority := make(map[int]int)
+	for i, rule := range rules {
+		rule, ok := rule.(map[string]interface{})
+		if !ok || !known(fmt.Sprintf("security_rule.%d.priority", i)) {
+			continue
+		}
+		priority, _ := rule["priority"].(int)
+		if priority == 0 {
+			continue
+		}
+		if first, ok := byPriority[priority]; ok {
+			errs = append(errs, fmt.Errorf("security_rule.%d has the same priority %d as security_rule.%d, the priorities of the rules should be unique", i, priority, first))
+			continue
+		}
+		byPriority[priority] = i
+	}
+	return errors.Join(errs...)
+}
+
 func resourceYandexSmartwebsecuritySecurityProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/smartwebsecurity/v1"
 )
 
@@ -104,3 +105,33 @@ func sweepSecurityProfileOnce(conf *Config, id string) error {
 	})
 	return handleSweepOperation(ctx, conf, op, err)
 }
+
+func TestUnitSmartwebsecuritySecurityRulePriorities(t *testing.T) {
+	t.Parallel()
+
+	type M = map[string]interface{}
+	type S = []interface{}
+
+	known := func(string) bool { return true }
+
+	assert.NoError(t, validateSmartwebsecuritySecurityRulePriorities(S{
+		M{"name": "a", "priority": 1},
+		M{"name": "b", "priority": 2},
+		M{"name": "c", "priority": 0},
+		M{"name": "d", "priority": 0},
+	}, known))
+
+	err := validateSmartwebsecuritySecurityRulePriorities(S{
+		M{"name": "a", "priority": 10},
+		M{"name": "b", "priority": 20},
+		M{"name": "c", "priority": 10},
+	}, known)
+	assert.EqualError(t, err, "security_rule.2 has the same priority 10 as security_rule.0, the priorities of the rules should be unique")
+
+	unknown := func(key string) bool { return key != "security_rule.2.priority" }
+	assert.NoError(t, validateSmartwebsecuritySecurityRulePriorities(S{
+		M{"name": "a", "priority": 10},
+		M{"name": "b", "priority": 20},
+		M{"name": "c", "priority": 10},
+	}, unknown))
+}
@@ -89,29 +89,6 @@ func yandexVPCSecurityGroupSchema() map[string]*schema.Schema {
 	}
 }
 
-func resourceYandexVPCSecurityGroup() *schema.Resource {
-	return &schema.Resource{
-		Description: "Manages a Default Security Group within the Yandex Cloud. For more information, see the official documentation of [security group](https://yandex.cloud/docs/vpc/concepts/security-groups) or [default security group](https://yandex.cloud/docs/vpc/concepts/security-groups#default-security-group).\n\n~> This resource is not intended for managing security group in general case. To manage normal security group use [yandex_vpc_security_group](vpc_security_group.html)\n\nWhen [network](https://yandex.cloud/docs/vpc/concepts/network) is created, a non-removable security group, called a *default security group*, is automatically attached to it. Life time of default security group cannot be controlled, so in fact the resource `yandex_vpc_default_security_group` does not create or delete any security groups, instead it simply takes or releases control of the default security group.\n\n~> When Terraform takes over management of the default security group, it **deletes** all info in it (including security group rules) and replace it with specified configuration. When Terraform drops the management (i.e. when resource is deleted from statefile and management), the state of the security group **remains the same** as it was before the deletion.\n\n~> Duplicating a resource (specifying same `network_id` for two different default security groups) will cause errors in the apply stage of your's configuration.\n",
-
-		Create: resourceYandexVPCSecurityGroupCreate,
-		Read:   resourceYandexVPCSecurityGroupRead,
-		Update: resourceYandexVPCSecurityGroupUpdate,
-		Delete: resourceYandexVPCSecurityGroupDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
-
-		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(yandexVPCSecurityGroupDefaultTimeout),
-			Update: schema.DefaultTimeout(yandexVPCSecurityGroupDefaultTimeout),
-			Delete: schema.DefaultTimeout(yandexVPCSecurityGroupDefaultTimeout),
-		},
-
-		SchemaVersion: 0,
-		Schema:        yandexVPCSecurityGroupSchema(),
-	}
-}
-
 func resourceYandexSecurityGroupRule() *schema.Resource {
 	return &schema.Resource{
 		Description: "~> Either one `port` argument or both `from_port` and `to_port` arguments can be specified.\n\n~> If `port` or `from_port`/`to_port` aren't specified or set by -1, ANY port will be sent.\n\n~> Can't use specified port if protocol is one of `ICMP` or `IPV6_ICMP`.\n",
@@ -189,69 +166,6 @@ func resourceYandexSecurityGroupRule() *schema.Resource {
 	}
 }
 
-func resourceYandexVPCSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*Config)
-
-	labels, err := expandLabels(d.Get("labels"))
-	if err != nil {
-		return fmt.Errorf("error expanding labels while creating security group: %s", err)
-	}
-
-	folderID, err := getFolderID(d, config)
-	if err != nil {
-		return fmt.Errorf("error getting folder ID while creating security group: %s", err)
-	}
-
-	rules, err := expandSecurityGroupRulesSpec(d)
-	if err != nil {
-		return fmt.Errorf("error getting rules while creating security group: %s", err)
-	}
-
-	req := vpc.CreateSecurityGroupRequest{
-		FolderId:    folderID,
-		Name:        d.Get("name").(string),
-		Description: d.Get("description").(string),
-		Labels:      labels,
-		NetworkId:   d.Get("network_id").(string),
-		RuleSpecs:   rules,
-	}
-
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
-	op, err := config.sdk.WrapOperation(config.sdk.VPC().SecurityGroup().Create(ctx, &req))
-	if err != nil {
-		return fmt.Errorf("error while requesting API to create security group: %s", err)
-	}
-
-	protoMetadata, err := op.Metadata()
-	if err != nil {
-		return fmt.Errorf("error while get security group create operation metadata: %s", err)
-	}
-
-	md, ok := protoMetadata.(*vpc.CreateSecurityGroupMetadata)
-	if !ok {
-		return fmt.Errorf("could not get SecurityGroup ID from create operation metadata")
-	}
-
-	d.SetId(md.SecurityGroupId)
-
-	err = op.Wait(ctx)
-	if err != nil {
-		return fmt.Errorf("error while waiting operation to create security group: %s", err)
-	}
-
-	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("security group creation failed: %s", err)
-	}
-
-	return resourceYandexVPCSecurityGroupRead(d, meta)
-}
-
-func resourceYandexVPCSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
-	return yandexVPCSecurityGroupRead(d, meta, d.Id())
-}
-
 func yandexVPCSecurityGroupRead(d *schema.ResourceData, meta interface{}, id string) error {
 	config := meta.(*Config)
 
@@ -352,7 +266,7 @@ func resourceYandexVPCSecurityGroupUpdate(d *schema.ResourceData, meta interface
 
 	d.Partial(false)
 
-	return resourceYandexVPCSecurityGroupRead(d, meta)
+	return yandexVPCSecurityGroupRead(d, meta, d.Id())
 }
 
 func resourceYandexVPCSecurityGroupUpdateRules(ctx context.Context, d *schema.ResourceData, config *Config) error {
@@ -485,34 +399,6 @@ func ruleChanged(r1 *vpc.SecurityGroupRule, r2 *vpc.SecurityGroupRuleSpec) bool
 	return false
 }
 
-func resourceYandexVPCSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*Config)
-
-	req := &vpc.DeleteSecurityGroupRequest{
-		SecurityGroupId: d.Id(),
-	}
-
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
-	op, err := config.sdk.WrapOperation(config.sdk.VPC().SecurityGroup().Delete(ctx, req))
-	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Security group %q", d.Id()))
-	}
-
-	err = op.Wait(ctx)
-	if err != nil {
-		return err
-	}
-
-	_, err = op.Response()
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
 var hashableRuleNames = []string{
 	"direction",
 	"port",
@@ -134,9 +134,9 @@ func TestAccYandexYDBDatabaseDedicated_basic(t *testing.T) {
 	ydbLocationId := ydbLocationId
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testYandexYDBDatabaseDedicatedDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testYandexYDBDatabaseDedicatedDestroy,
 		Steps: []resource.TestStep{
 			basicYandexYDBDatabaseDedicatedTestStep(databaseName, databaseDesc, deletionProtection, labelKey, labelValue, ydbLocationId, &database),
 		},
@@ -159,9 +159,9 @@ func TestAccYandexYDBDatabaseDedicated_update(t *testing.T) {
 	deletionProtectionUpdated := "false"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testYandexYDBDatabaseDedicatedDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testYandexYDBDatabaseDedicatedDestroy,
 		Steps: []resource.TestStep{
 			basicYandexYDBDatabaseDedicatedTestStep(databaseName, databaseDesc, deletionProtection, labelKey, labelValue, ydbLocationId, &database),
 			basicYandexYDBDatabaseDedicatedTestStep(databaseNameUpdated, databaseDescUpdated, deletionProtectionUpdated, labelKeyUpdated, labelValueUpdated, ydbLocationId, &database),
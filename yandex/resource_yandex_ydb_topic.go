@@ -32,6 +32,18 @@ func ydbTimeouts() *schema.ResourceTimeout {
 	}
 }
 
+// ydbCredentials returns YDB credentials callback with the cached IAM token of the provider,
+// so YDB resources do not issue a new token for every operation.
+func ydbCredentials(config *Config) func(ctx context.Context) (auth.YdbCredentials, error) {
+	return func(ctx context.Context) (auth.YdbCredentials, error) {
+		token, err := config.getIAMToken(ctx)
+		if err != nil {
+			return auth.YdbCredentials{}, err
+		}
+		return auth.YdbCredentials{Token: token}, nil
+	}
+}
+
 func resourceYandexYDBTopic() *schema.Resource {
 	return &schema.Resource{
 		Description: "Manage a YDB Topic. For more information, see [the official documentation](https://yandex.cloud/docs/ydb/concepts/#ydb).",
@@ -50,49 +62,21 @@ func resourceYandexYDBTopic() *schema.Resource {
 }
 
 func resourceYandexYDBTopicCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
-		config := meta.(*Config)
-		token, err := config.sdk.CreateIAMToken(ctx)
-		if err != nil {
-			return auth.YdbCredentials{}, err
-		}
-		return auth.YdbCredentials{Token: token.IamToken}, nil
-	}
+	cb := ydbCredentials(meta.(*Config))
 	return topic.ResourceCreateFunc(cb)(ctx, d, meta)
 }
 
 func resourceYandexYDBTopicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
-		config := meta.(*Config)
-		token, err := config.sdk.CreateIAMToken(ctx)
-		if err != nil {
-			return auth.YdbCredentials{}, err
-		}
-		return auth.YdbCredentials{Token: token.IamToken}, nil
-	}
+	cb := ydbCredentials(meta.(*Config))
 	return topic.ResourceReadFunc(cb)(ctx, d, meta)
 }
 
 func resourceYandexYDBTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
-		config := meta.(*Config)
-		token, err := config.sdk.CreateIAMToken(ctx)
-		if err != nil {
-			return auth.YdbCredentials{}, err
-		}
-		return auth.YdbCredentials{Token: token.IamToken}, nil
-	}
+	cb := ydbCredentials(meta.(*Config))
 	return topic.ResourceUpdateFunc(cb)(ctx, d, meta)
 }
 
 func resourceYandexYDBTopicDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
-		config := meta.(*Config)
-		token, err := config.sdk.CreateIAMToken(ctx)
-		if err != nil {
-			return auth.YdbCredentials{}, err
-		}
-		return auth.YdbCredentials{Token: token.IamToken}, nil
-	}
+	cb := ydbCredentials(meta.(*Config))
 	return topic.ResourceDeleteFunc(cb)(ctx, d, meta)
 }
@@ -19,7 +19,7 @@ func getS3ClientByKeys(ctx context.Context, accessKey, secretKey string, c *Conf
 	}
 	// iamToken is not needed here, since we cannot specify it in the resource.
 	// Otherwise, defaultS3Client must be initialised.
-	return s3.NewClient(ctx, accessKey, secretKey, "", c.StorageEndpoint)
+	return s3.NewClient(ctx, accessKey, secretKey, nil, c.StorageEndpoint)
 }
 
 func getS3Client(ctx context.Context, d *schema.ResourceData, c *Config) (*s3.Client, error) {
@@ -1282,25 +1282,6 @@ func securityRuleDescriptionToRuleSpec(dir string, v interface{}) (*vpc.Security
 	return sr, nil
 }
 
-func expandSecurityGroupRulesSpec(d *schema.ResourceData) ([]*vpc.SecurityGroupRuleSpec, error) {
-
-	securityRules := make([]*vpc.SecurityGroupRuleSpec, 0)
-
-	for _, dir := range []string{"egress", "ingress"} {
-		if v, ok := d.GetOk(dir); ok {
-			for _, rule := range v.(*schema.Set).List() {
-				if r, err := securityRuleDescriptionToRuleSpec(dir, rule); err == nil {
-					securityRules = append(securityRules, r)
-				} else {
-					return securityRules, err
-				}
-			}
-		}
-	}
-
-	return securityRules, nil
-}
-
 func securityRuleCidrsFromMap(res map[string]interface{}) (*vpc.CidrBlocks, bool) {
 	var v4Blocks interface{} = nil
 	var v6Blocks interface{} = nil
@@ -1008,21 +1008,6 @@ func splitFieldPath(path string) []string {
 	return paths
 }
 
-func constructResourceId(clusterID string, resourceName string) string {
-	return fmt.Sprintf("%s:%s", clusterID, resourceName)
-}
-
-func deconstructResourceId(resourceID string) (string, string, error) {
-	parts := strings.SplitN(resourceID, ":", 2)
-	if len(parts) != 2 {
-		return "", "", fmt.Errorf("invalid resource id format: %q", resourceID)
-	}
-
-	clusterID := parts[0]
-	resourceName := parts[1]
-	return clusterID, resourceName, nil
-}
-
 func expandEnum(keyName string, value string, enumValues map[string]int32) (*int32, error) {
 	if val, ok := enumValues[value]; ok {
 		return &val, nil
@@ -89,6 +89,26 @@ func IntGreater(min int) schema.SchemaValidateFunc {
 	}
 }
 
+// Int64Between returns a SchemaValidateFunc which tests if the provided value
+// is of type int and is between min and max (inclusive). Unlike validation.IntBetween
+// the bounds are int64, so they may exceed the range of int on 32-bit platforms.
+func Int64Between(min, max int64) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (_ []string, errors []error) {
+		v, ok := i.(int)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %s to be int", k))
+			return nil, errors
+		}
+
+		if int64(v) < min || int64(v) > max {
+			errors = append(errors, fmt.Errorf("expected %s to be in the range (%d - %d), got %d", k, min, max, v))
+			return nil, errors
+		}
+
+		return nil, errors
+	}
+}
+
 // FloatAtLeast returns a SchemaValidateFunc which tests if the provided value
 // is of type float64 and is greater than provided min (not inclusive)
 func FloatGreater(min float64) schema.SchemaValidateFunc {
@@ -93,6 +93,50 @@ func TestIntGreater(t *testing.T) {
 	}
 }
 
+func TestInt64Between(t *testing.T) {
+	testCases := []struct {
+		val         interface{}
+		f           schema.SchemaValidateFunc
+		expectedErr *regexp.Regexp
+	}{
+		{
+			val: 5,
+			f:   Int64Between(5, 5*1024*1024*1024),
+		},
+		{
+			val:         "5",
+			f:           Int64Between(5, 5*1024*1024*1024),
+			expectedErr: regexp.MustCompile("expected type of test_property to be int"),
+		},
+		{
+			val:         4,
+			f:           Int64Between(5, 5*1024*1024*1024),
+			expectedErr: regexp.MustCompile(`expected test_property to be in the range \(5 - 5368709120\), got 4`),
+		},
+		{
+			val:         11,
+			f:           Int64Between(5, 10),
+			expectedErr: regexp.MustCompile(`expected test_property to be in the range \(5 - 10\), got 11`),
+		},
+	}
+
+	for i, tc := range testCases {
+		_, errs := tc.f(tc.val, "test_property")
+
+		if len(errs) == 0 && tc.expectedErr == nil {
+			continue
+		}
+
+		if len(errs) != 0 && tc.expectedErr == nil {
+			t.Fatalf("expected test case %d to produce no errors, got %v", i, errs)
+		}
+
+		if !matchErr(errs, tc.expectedErr) {
+			t.Fatalf("expected test case %d to produce error matching \"%s\", got %v", i, tc.expectedErr, errs)
+		}
+	}
+}
+
 func TestFloatGreater(t *testing.T) {
 	testCases := []struct {
 		val         interface{}